@@ -1,14 +1,23 @@
 package leaseweb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 )
 
-const ABUSE_API_VERSION = "v1"
+var ABUSE_REPORT_STATUSES = []string{"OPEN", "WAITING", "CLOSED"}
+
+const (
+	ABUSE_API_VERSION        = "v1"
+	ABUSE_REPORT_PAGE_LIMIT  = 50
+	ABUSE_MESSAGE_PAGE_LIMIT = 50
+)
 
 type AbuseApi struct{}
 
@@ -17,12 +26,12 @@ type AbuseReport struct {
 	Subject             string               `json:"subject"`
 	Status              string               `json:"status"`
 	Reopened            bool                 `json:"reopened"`
-	ReportedAt          string               `json:"reportedAt"`
-	UpdatedAt           string               `json:"updatedAt"`
+	ReportedAt          Time                 `json:"reportedAt"`
+	UpdatedAt           Time                 `json:"updatedAt"`
 	Notifier            string               `json:"notifier"`
 	CustomerId          string               `json:"customerId"`
 	LegalEntityId       string               `json:"legalEntityId"`
-	Deadline            string               `json:"deadline"`
+	Deadline            Time                 `json:"deadline"`
 	Body                string               `json:"body"`
 	DetectedIpAddresses []string             `json:"detectedIpAddresses"`
 	DetectedDomainNames []DetectedDomainName `json:"detectedDomainNames"`
@@ -49,7 +58,7 @@ type AbuseReports struct {
 
 type AbuseMessage struct {
 	PostedBy   string     `json:"postedBy"`
-	PostedAt   string     `json:"postedAt"`
+	PostedAt   Time       `json:"postedAt"`
 	Body       string     `json:"body"`
 	Attachment Attachment `json:"attachment"`
 }
@@ -115,7 +124,7 @@ func (aba AbuseApi) GetAbuseReportMessages(abuseReportId string, args ...int) (*
 		v.Add("limit", fmt.Sprint(args[1]))
 	}
 
-	path := aba.getPath("/reports/" + abuseReportId + "/messages" + v.Encode())
+	path := aba.getPath("/reports/" + abuseReportId + "/messages?" + v.Encode())
 	result := &AbuseMessages{}
 	if err := doRequest(http.MethodGet, path, result); err != nil {
 		return nil, err
@@ -125,7 +134,7 @@ func (aba AbuseApi) GetAbuseReportMessages(abuseReportId string, args ...int) (*
 
 func (aba AbuseApi) CreateNewAbuseReportMessage(abuseReportId string, body string) ([]string, error) {
 	var result []string
-	payload := map[string]string{body: body}
+	payload := map[string]string{"body": body}
 	path := aba.getPath("/reports/" + abuseReportId + "/messages")
 	if err := doRequest(http.MethodPost, path, &result, payload); err != nil {
 		return nil, err
@@ -148,8 +157,82 @@ func (aba AbuseApi) ResolveAbuseReport(abuseReportId string, resolutions []strin
 	return doRequest(http.MethodPost, path, nil, payload)
 }
 
-// TODO
-// func (aba AbuseApi) GetAbuseReportMessageAttachments() {}
+func (aba AbuseApi) GetAbuseReportAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*Download, error) {
+	path := aba.getPath("/reports/" + abuseReportId + "/attachments/" + attachmentId)
+	return doDownload(ctx, path, 0)
+}
+
+func (aba AbuseApi) GetAbuseReportMessageAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*Download, error) {
+	path := aba.getPath("/reports/" + abuseReportId + "/messageAttachments/" + attachmentId)
+	return doDownload(ctx, path, 0)
+}
+
+func (aba AbuseApi) SaveAbuseReportAttachments(ctx context.Context, abuseReportId string, dir string) ([]DownloadedFile, error) {
+	report, err := aba.GetAbuseReport(abuseReportId)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := listAllAbuseReportMessages(aba, abuseReportId)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	var result []DownloadedFile
+	used := map[string]bool{}
+	save := func(endpoint string, attachment Attachment) error {
+		path := filepath.Join(dir, abuseAttachmentFilename(used, attachment))
+		file, err := downloadFile(ctx, aba.getPath(endpoint+attachment.Id), path, DownloadFileOptions{Resume: true})
+		if err != nil {
+			return err
+		}
+		result = append(result, *file)
+		return nil
+	}
 
-// TODO
-// func (aba AbuseApi) GetAbuseReportAttachments() {}
+	for _, attachment := range report.Attachments {
+		if err = save("/reports/"+abuseReportId+"/attachments/", attachment); err != nil {
+			return result, err
+		}
+	}
+	for _, message := range messages {
+		if message.Attachment.Id == "" {
+			continue
+		}
+		if err = save("/reports/"+abuseReportId+"/messageAttachments/", message.Attachment); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func listAllAbuseReports(service AbuseService, statuses []string) ([]AbuseReport, error) {
+	return listAllPages(ABUSE_REPORT_PAGE_LIMIT, func(offset, limit int) ([]AbuseReport, Metadata, error) {
+		reports, err := service.ListAbuseReports(offset, statuses, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return reports.AbuseReports, reports.Metadata, nil
+	})
+}
+
+func listAllAbuseReportMessages(service AbuseService, abuseReportId string) ([]AbuseMessage, error) {
+	return listAllPages(ABUSE_MESSAGE_PAGE_LIMIT, func(offset, limit int) ([]AbuseMessage, Metadata, error) {
+		messages, err := service.GetAbuseReportMessages(abuseReportId, offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return messages.Messages, messages.Metadata, nil
+	})
+}
+
+func abuseAttachmentFilename(used map[string]bool, attachment Attachment) string {
+	name := sanitizeFilename(attachment.Filename, attachment.Id)
+	if used[name] {
+		name = attachment.Id + "-" + name
+	}
+	used[name] = true
+	return name
+}
@@ -0,0 +1,263 @@
+package leaseweb
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ABUSE_EXPORT_FORMAT_JSONL = "jsonl"
+	ABUSE_EXPORT_FORMAT_CSV   = "csv"
+)
+
+var ABUSE_EXPORT_CSV_HEADER = []string{
+	"id", "subject", "status", "reopened", "reportedAt", "updatedAt", "deadline", "notifier",
+	"customerId", "legalEntityId", "detectedIpAddresses", "detectedDomainNames", "attachments",
+	"totalMessagesCount", "body", "messages",
+}
+
+type AbuseExportOptions struct {
+	Service         AbuseService
+	Format          string
+	Statuses        []string
+	From            time.Time
+	To              time.Time
+	Attachments     io.Writer
+	AttachmentsPath string
+}
+
+type AbuseExportRecord struct {
+	AbuseReport
+	Messages []AbuseMessage `json:"messages"`
+}
+
+func ExportAbuseReports(ctx context.Context, w io.Writer, options AbuseExportOptions) (int, error) {
+	if options.Service == nil {
+		options.Service = AbuseApi{}
+	}
+	if options.Format == "" {
+		options.Format = ABUSE_EXPORT_FORMAT_JSONL
+	}
+	if len(options.Statuses) == 0 {
+		options.Statuses = ABUSE_REPORT_STATUSES
+	}
+
+	var write func(record AbuseExportRecord) error
+	var flush func() error
+	switch options.Format {
+	case ABUSE_EXPORT_FORMAT_JSONL:
+		encoder := json.NewEncoder(w)
+		write = func(record AbuseExportRecord) error { return encoder.Encode(record) }
+		flush = func() error { return nil }
+	case ABUSE_EXPORT_FORMAT_CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(ABUSE_EXPORT_CSV_HEADER); err != nil {
+			return 0, err
+		}
+		write = func(record AbuseExportRecord) error { return cw.Write(record.csvRow()) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	default:
+		return 0, fmt.Errorf("unsupported abuse export format %q", options.Format)
+	}
+
+	var bundle *abuseAttachmentBundle
+	switch {
+	case options.Attachments != nil && options.AttachmentsPath != "":
+		return 0, fmt.Errorf("abuse export accepts either Attachments or AttachmentsPath, not both")
+	case options.Attachments != nil:
+		bundle = newAbuseAttachmentBundle(options.Attachments)
+	case options.AttachmentsPath != "":
+		var err error
+		if bundle, err = createAbuseAttachmentBundle(options.AttachmentsPath); err != nil {
+			return 0, err
+		}
+	}
+	if bundle != nil {
+		defer bundle.abort()
+	}
+
+	reports, err := listAllAbuseReports(options.Service, options.Statuses)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, listed := range reports {
+		reportedAt := listed.ReportedAt.Time
+		if (!options.From.IsZero() && reportedAt.Before(options.From)) || (!options.To.IsZero() && !reportedAt.Before(options.To)) {
+			continue
+		}
+
+		report, err := options.Service.GetAbuseReport(listed.Id)
+		if err != nil {
+			return count, err
+		}
+		messages, err := listAllAbuseReportMessages(options.Service, report.Id)
+		if err != nil {
+			return count, err
+		}
+		record := AbuseExportRecord{AbuseReport: *report, Messages: messages}
+		if err = write(record); err != nil {
+			return count, err
+		}
+		if bundle != nil {
+			if err = bundle.add(ctx, options.Service, record); err != nil {
+				return count, err
+			}
+		}
+		count++
+	}
+
+	if err = flush(); err != nil {
+		return count, err
+	}
+	if bundle != nil {
+		return count, bundle.close()
+	}
+	return count, nil
+}
+
+func (aer AbuseExportRecord) csvRow() []string {
+	var domainNames, attachments, messages []string
+	for _, domainName := range aer.DetectedDomainNames {
+		domainNames = append(domainNames, domainName.Name)
+	}
+	for _, attachment := range aer.Attachments {
+		attachments = append(attachments, attachment.Filename)
+	}
+	for _, message := range aer.Messages {
+		messages = append(messages, fmt.Sprintf("[%s] %s: %s", message.PostedAt, message.PostedBy, message.Body))
+	}
+
+	return []string{
+		aer.Id,
+		aer.Subject,
+		aer.Status,
+		strconv.FormatBool(aer.Reopened),
+		aer.ReportedAt.String(),
+		aer.UpdatedAt.String(),
+		aer.Deadline.String(),
+		aer.Notifier,
+		aer.CustomerId,
+		aer.LegalEntityId,
+		strings.Join(aer.DetectedIpAddresses, ";"),
+		strings.Join(domainNames, ";"),
+		strings.Join(attachments, ";"),
+		strconv.Itoa(aer.TotalMessagesCount),
+		aer.Body,
+		strings.Join(messages, "\n"),
+	}
+}
+
+type abuseAttachmentBundle struct {
+	gw     *gzip.Writer
+	tw     *tar.Writer
+	file   *os.File
+	path   string
+	closed bool
+}
+
+func newAbuseAttachmentBundle(w io.Writer) *abuseAttachmentBundle {
+	gw := gzip.NewWriter(w)
+	return &abuseAttachmentBundle{gw: gw, tw: tar.NewWriter(gw)}
+}
+
+func createAbuseAttachmentBundle(path string) (*abuseAttachmentBundle, error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	bundle := newAbuseAttachmentBundle(file)
+	bundle.file = file
+	bundle.path = path
+	return bundle, nil
+}
+
+func (aab *abuseAttachmentBundle) add(ctx context.Context, service AbuseService, record AbuseExportRecord) error {
+	used := map[string]bool{}
+	for _, attachment := range record.Attachments {
+		download, err := service.GetAbuseReportAttachments(ctx, record.Id, attachment.Id)
+		if err != nil {
+			return err
+		}
+		if err = aab.write(record.Id+"/"+abuseAttachmentFilename(used, attachment), record.ReportedAt.Time, download); err != nil {
+			return err
+		}
+	}
+	for _, message := range record.Messages {
+		if message.Attachment.Id == "" {
+			continue
+		}
+		download, err := service.GetAbuseReportMessageAttachments(ctx, record.Id, message.Attachment.Id)
+		if err != nil {
+			return err
+		}
+		if err = aab.write(record.Id+"/"+abuseAttachmentFilename(used, message.Attachment), message.PostedAt.Time, download); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (aab *abuseAttachmentBundle) write(name string, modTime time.Time, download *Download) error {
+	defer download.Close()
+	var content bytes.Buffer
+	if _, err := io.Copy(&content, download); err != nil {
+		return err
+	}
+
+	header := &tar.Header{Name: name, Mode: 0644, Size: int64(content.Len()), ModTime: modTime}
+	if err := aab.tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := aab.tw.Write(content.Bytes())
+	return err
+}
+
+func (aab *abuseAttachmentBundle) close() error {
+	aab.closed = true
+	err := aab.tw.Close()
+	if gzErr := aab.gw.Close(); err == nil {
+		err = gzErr
+	}
+	if aab.file == nil {
+		return err
+	}
+	if fileErr := aab.file.Close(); err == nil {
+		err = fileErr
+	}
+	if err == nil {
+		err = os.Rename(aab.file.Name(), aab.path)
+	}
+	if err != nil {
+		os.Remove(aab.file.Name())
+	}
+	return err
+}
+
+// abort leaves the tar and gzip trailers out so a failed export never looks
+// like a complete archive, and removes the temporary file if there is one.
+func (aab *abuseAttachmentBundle) abort() {
+	if aab.closed {
+		return
+	}
+	aab.closed = true
+	if aab.file != nil {
+		aab.file.Close()
+		os.Remove(aab.file.Name())
+	}
+}
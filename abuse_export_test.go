@@ -0,0 +1,274 @@
+package leaseweb
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testAbuseExportOptions(format string) AbuseExportOptions {
+	return AbuseExportOptions{
+		Format: format,
+		From:   time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestExportAbuseReportsJsonLines(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "OPEN,WAITING,CLOSED", r.URL.Query().Get("status"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "reports": [
+				{"id": "000001", "reportedAt": "2023-01-15T10:00:00+00:00"},
+				{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100"},
+				{"id": "000003", "reportedAt": "2023-03-15T10:00:00+00:00"}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000002": respondWith(`{"id": "000002", "subject": "Spam", "status": "CLOSED", "reportedAt": "2023-02-15T10:00:00+0100",
+			"detectedIpAddresses": ["192.0.2.10", "192.0.2.11"], "detectedDomainNames": [{"name": "example.com"}],
+			"attachments": [{"id": "a1", "mimeType": "text/plain", "filename": "headers.txt"}],
+			"totalMessagesCount": 2, "body": "Spam sent from your network"}`),
+		"GET /abuse/v1/reports/000002/messages": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "messages": [
+			{"postedBy": "CUSTOMER", "postedAt": "2023-02-16T10:00:00+00:00", "body": "Removed."},
+			{"postedBy": "ABUSE_AGENT", "postedAt": "2023-02-17T10:00:00+00:00", "body": "Thanks.", "attachment": {"id": "m1", "mimeType": "text/plain", "filename": "headers.txt"}}
+		]}`),
+	}))
+	defer teardown()
+
+	var out bytes.Buffer
+	count, err := ExportAbuseReports(context.Background(), &out, testAbuseExportOptions(ABUSE_EXPORT_FORMAT_JSONL))
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(count, 1)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(len(lines), 1)
+	record := AbuseExportRecord{}
+	assert.Nil(json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(record.Id, "000002")
+	assert.Equal(record.Subject, "Spam")
+	assert.Equal(len(record.Messages), 2)
+	assert.Equal(record.Messages[1].Attachment.Id, "m1")
+}
+
+func TestExportAbuseReportsCsv(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "OPEN,WAITING,CLOSED", r.URL.Query().Get("status"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "reports": [
+				{"id": "000001", "reportedAt": "2023-01-15T10:00:00+00:00"},
+				{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100"},
+				{"id": "000003", "reportedAt": "2023-03-15T10:00:00+00:00"}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000002": respondWith(`{"id": "000002", "subject": "Spam", "status": "CLOSED", "reportedAt": "2023-02-15T10:00:00+0100",
+			"detectedIpAddresses": ["192.0.2.10", "192.0.2.11"], "detectedDomainNames": [{"name": "example.com"}],
+			"attachments": [{"id": "a1", "mimeType": "text/plain", "filename": "headers.txt"}],
+			"totalMessagesCount": 2, "body": "Spam sent from your network"}`),
+		"GET /abuse/v1/reports/000002/messages": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "messages": [
+			{"postedBy": "CUSTOMER", "postedAt": "2023-02-16T10:00:00+00:00", "body": "Removed."},
+			{"postedBy": "ABUSE_AGENT", "postedAt": "2023-02-17T10:00:00+00:00", "body": "Thanks.", "attachment": {"id": "m1", "mimeType": "text/plain", "filename": "headers.txt"}}
+		]}`),
+	}))
+	defer teardown()
+
+	var out bytes.Buffer
+	count, err := ExportAbuseReports(context.Background(), &out, testAbuseExportOptions(ABUSE_EXPORT_FORMAT_CSV))
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(count, 1)
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	assert.Nil(err)
+	assert.Equal(len(rows), 2)
+	assert.Equal(rows[0], ABUSE_EXPORT_CSV_HEADER)
+	assert.Equal(rows[1][0], "000002")
+	assert.Equal(rows[1][10], "192.0.2.10;192.0.2.11")
+	assert.Equal(rows[1][11], "example.com")
+	assert.Equal(rows[1][12], "headers.txt")
+	assert.Equal(rows[1][13], "2")
+	assert.Equal(rows[1][15], "[2023-02-16T10:00:00Z] CUSTOMER: Removed.\n[2023-02-17T10:00:00Z] ABUSE_AGENT: Thanks.")
+}
+
+func TestExportAbuseReportsAttachments(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "OPEN,WAITING,CLOSED", r.URL.Query().Get("status"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "reports": [
+				{"id": "000001", "reportedAt": "2023-01-15T10:00:00+00:00"},
+				{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100"},
+				{"id": "000003", "reportedAt": "2023-03-15T10:00:00+00:00"}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000002": respondWith(`{"id": "000002", "subject": "Spam", "status": "CLOSED", "reportedAt": "2023-02-15T10:00:00+0100",
+			"detectedIpAddresses": ["192.0.2.10", "192.0.2.11"], "detectedDomainNames": [{"name": "example.com"}],
+			"attachments": [{"id": "a1", "mimeType": "text/plain", "filename": "headers.txt"}],
+			"totalMessagesCount": 2, "body": "Spam sent from your network"}`),
+		"GET /abuse/v1/reports/000002/messages": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "messages": [
+			{"postedBy": "CUSTOMER", "postedAt": "2023-02-16T10:00:00+00:00", "body": "Removed."},
+			{"postedBy": "ABUSE_AGENT", "postedAt": "2023-02-17T10:00:00+00:00", "body": "Thanks.", "attachment": {"id": "m1", "mimeType": "text/plain", "filename": "headers.txt"}}
+		]}`),
+		"GET /abuse/v1/reports/000002/attachments/a1":        respondWith("report attachment"),
+		"GET /abuse/v1/reports/000002/messageAttachments/m1": respondWith("message attachment"),
+	}))
+	defer teardown()
+
+	var out, bundle bytes.Buffer
+	options := testAbuseExportOptions(ABUSE_EXPORT_FORMAT_JSONL)
+	options.Attachments = &bundle
+	_, err := ExportAbuseReports(context.Background(), &out, options)
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	gr, err := gzip.NewReader(&bundle)
+	assert.Nil(err)
+	tr := tar.NewReader(gr)
+	files := map[string]string{}
+	modTimes := map[string]time.Time{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(err)
+		content, err := io.ReadAll(tr)
+		assert.Nil(err)
+		files[header.Name] = string(content)
+		modTimes[header.Name] = header.ModTime.UTC()
+	}
+	assert.Equal(files, map[string]string{
+		"000002/headers.txt":    "report attachment",
+		"000002/m1-headers.txt": "message attachment",
+	})
+	assert.Equal(modTimes, map[string]time.Time{
+		"000002/headers.txt":    time.Date(2023, 2, 15, 9, 0, 0, 0, time.UTC),
+		"000002/m1-headers.txt": time.Date(2023, 2, 17, 10, 0, 0, 0, time.UTC),
+	})
+}
+
+func TestExportAbuseReportsAttachmentsError(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "reports": [
+			{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100"}
+		]}`),
+		"GET /abuse/v1/reports/000002": respondWith(`{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100",
+			"attachments": [{"id": "a1", "mimeType": "text/plain", "filename": "headers.txt"}, {"id": "a2", "mimeType": "text/plain", "filename": "body.txt"}]}`),
+		"GET /abuse/v1/reports/000002/messages":       respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 0}, "messages": []}`),
+		"GET /abuse/v1/reports/000002/attachments/a1": respondWith("report attachment"),
+		"GET /abuse/v1/reports/000002/attachments/a2": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, `{"errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+		},
+	}))
+	defer teardown()
+
+	var bundle bytes.Buffer
+	options := testAbuseExportOptions(ABUSE_EXPORT_FORMAT_JSONL)
+	options.Attachments = &bundle
+	_, err := ExportAbuseReports(context.Background(), io.Discard, options)
+	assert := assert.New(t)
+	assert.Equal(err.Error(), "The API could not handle your request at this time.")
+
+	gr, err := gzip.NewReader(&bundle)
+	if err == nil {
+		_, err = io.ReadAll(tar.NewReader(gr))
+		if err == nil {
+			_, err = io.ReadAll(gr)
+		}
+	}
+	assert.NotNil(err)
+}
+
+func TestExportAbuseReportsAttachmentsPath(t *testing.T) {
+	routes := map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "OPEN,WAITING,CLOSED", r.URL.Query().Get("status"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "reports": [
+				{"id": "000001", "reportedAt": "2023-01-15T10:00:00+00:00"},
+				{"id": "000002", "reportedAt": "2023-02-15T10:00:00+0100"},
+				{"id": "000003", "reportedAt": "2023-03-15T10:00:00+00:00"}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000002": respondWith(`{"id": "000002", "subject": "Spam", "status": "CLOSED", "reportedAt": "2023-02-15T10:00:00+0100",
+			"detectedIpAddresses": ["192.0.2.10", "192.0.2.11"], "detectedDomainNames": [{"name": "example.com"}],
+			"attachments": [{"id": "a1", "mimeType": "text/plain", "filename": "headers.txt"}],
+			"totalMessagesCount": 2, "body": "Spam sent from your network"}`),
+		"GET /abuse/v1/reports/000002/messages": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "messages": [
+			{"postedBy": "CUSTOMER", "postedAt": "2023-02-16T10:00:00+00:00", "body": "Removed."},
+			{"postedBy": "ABUSE_AGENT", "postedAt": "2023-02-17T10:00:00+00:00", "body": "Thanks.", "attachment": {"id": "m1", "mimeType": "text/plain", "filename": "headers.txt"}}
+		]}`),
+		"GET /abuse/v1/reports/000002/attachments/a1":        respondWith("report attachment"),
+		"GET /abuse/v1/reports/000002/messageAttachments/m1": respondWith("message attachment"),
+	}
+	setup(serveRoutes(t, routes))
+	defer teardown()
+
+	dir := t.TempDir()
+	options := testAbuseExportOptions(ABUSE_EXPORT_FORMAT_JSONL)
+	options.AttachmentsPath = filepath.Join(dir, "attachments.tar.gz")
+	_, err := ExportAbuseReports(context.Background(), io.Discard, options)
+	assert := assert.New(t)
+	assert.Nil(err)
+	entries, err := os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Equal(len(entries), 1)
+	assert.Equal(entries[0].Name(), "attachments.tar.gz")
+
+	assert.Nil(os.Remove(options.AttachmentsPath))
+	routes["GET /abuse/v1/reports/000002/messageAttachments/m1"] = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+	}
+	_, err = ExportAbuseReports(context.Background(), io.Discard, options)
+	assert.Equal(err.Error(), "The API could not handle your request at this time.")
+	entries, err = os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Empty(entries)
+
+	options.Attachments = io.Discard
+	_, err = ExportAbuseReports(context.Background(), io.Discard, options)
+	assert.Equal(err.Error(), "abuse export accepts either Attachments or AttachmentsPath, not both")
+}
+
+func TestExportAbuseReportsUnsupportedFormat(t *testing.T) {
+	_, err := ExportAbuseReports(context.Background(), io.Discard, AbuseExportOptions{Format: "xml"})
+	assert.Equal(t, err.Error(), `unsupported abuse export format "xml"`)
+}
+
+func TestExportAbuseReportsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				count, err := ExportAbuseReports(context.Background(), io.Discard, AbuseExportOptions{})
+				assert.Equal(t, count, 0)
+				return nil, err
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -1,8 +1,14 @@
 package leaseweb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,23 +59,23 @@ func TestListAbuseReports(t *testing.T) {
 	assert.Equal(abuseReport1.Id, "000000")
 	assert.Equal(abuseReport1.Subject, "Report description 1")
 	assert.Equal(abuseReport1.Status, "OPEN")
-	assert.Equal(abuseReport1.ReportedAt, "2022-01-01T00:00:00+00:00")
-	assert.Equal(abuseReport1.UpdatedAt, "2022-02-01T00:00:00+00:00")
+	assert.Equal(abuseReport1.ReportedAt, MustTime("2022-01-01T00:00:00+00:00"))
+	assert.Equal(abuseReport1.UpdatedAt, MustTime("2022-02-01T00:00:00+00:00"))
 	assert.Equal(abuseReport1.Notifier, "notifier1@email.com")
 	assert.Equal(abuseReport1.CustomerId, "10000001")
 	assert.Equal(abuseReport1.LegalEntityId, "2000")
-	assert.Equal(abuseReport1.Deadline, "2022-03-01T00:00:00+00:00")
+	assert.Equal(abuseReport1.Deadline, MustTime("2022-03-01T00:00:00+00:00"))
 
 	abuseReport2 := response.AbuseReports[1]
 	assert.Equal(abuseReport2.Id, "000001")
 	assert.Equal(abuseReport2.Subject, "Report description 2")
 	assert.Equal(abuseReport2.Status, "CLOSED")
-	assert.Equal(abuseReport2.ReportedAt, "2022-03-01T00:00:00+00:00")
-	assert.Equal(abuseReport2.UpdatedAt, "2022-04-01T00:00:00+00:00")
+	assert.Equal(abuseReport2.ReportedAt, MustTime("2022-03-01T00:00:00+00:00"))
+	assert.Equal(abuseReport2.UpdatedAt, MustTime("2022-04-01T00:00:00+00:00"))
 	assert.Equal(abuseReport2.Notifier, "notifier2@email.com")
 	assert.Equal(abuseReport2.CustomerId, "10000001")
 	assert.Equal(abuseReport2.LegalEntityId, "2600")
-	assert.Equal(abuseReport2.Deadline, "2022-05-01T00:00:00+00:00")
+	assert.Equal(abuseReport2.Deadline, MustTime("2022-05-01T00:00:00+00:00"))
 }
 
 func TestListAbuseReportsPaginateAndPassStatuses(t *testing.T) {
@@ -107,12 +113,12 @@ func TestListAbuseReportsPaginateAndPassStatuses(t *testing.T) {
 	assert.Equal(abuseReport1.Id, "000000")
 	assert.Equal(abuseReport1.Subject, "Report description 1")
 	assert.Equal(abuseReport1.Status, "OPEN")
-	assert.Equal(abuseReport1.ReportedAt, "2022-01-01T00:00:00+00:00")
-	assert.Equal(abuseReport1.UpdatedAt, "2022-02-01T00:00:00+00:00")
+	assert.Equal(abuseReport1.ReportedAt, MustTime("2022-01-01T00:00:00+00:00"))
+	assert.Equal(abuseReport1.UpdatedAt, MustTime("2022-02-01T00:00:00+00:00"))
 	assert.Equal(abuseReport1.Notifier, "notifier1@email.com")
 	assert.Equal(abuseReport1.CustomerId, "10000001")
 	assert.Equal(abuseReport1.LegalEntityId, "2000")
-	assert.Equal(abuseReport1.Deadline, "2022-03-01T00:00:00+00:00")
+	assert.Equal(abuseReport1.Deadline, MustTime("2022-03-01T00:00:00+00:00"))
 }
 
 func TestListAbuseReportsBeEmpty(t *testing.T) {
@@ -254,13 +260,13 @@ func TestGetAbuseReport(t *testing.T) {
 	assert.Equal(response.Subject, "Report description")
 	assert.Equal(response.Status, "CLOSED")
 	assert.Equal(response.Reopened, false)
-	assert.Equal(response.ReportedAt, "2015-01-01T00:00:00+0100")
-	assert.Equal(response.UpdatedAt, "2015-02-01T00:00:00+0100")
+	assert.Equal(response.ReportedAt, MustTime("2015-01-01T00:00:00+0100"))
+	assert.Equal(response.UpdatedAt, MustTime("2015-02-01T00:00:00+0100"))
 	assert.Equal(response.Notifier, "notifier@email.com")
 	assert.Equal(response.CustomerId, "10000001")
 	assert.Equal(response.LegalEntityId, "2000")
 	assert.Equal(response.Body, "string with content")
-	assert.Equal(response.Deadline, "2015-01-01T00:00:00+0100")
+	assert.Equal(response.Deadline, MustTime("2015-01-01T00:00:00+0100"))
 	assert.Equal(response.DetectedIpAddresses[0], "127.0.0.1")
 	assert.Equal(response.DetectedDomainNames[0].Name, "example.com")
 	assert.Equal(response.DetectedDomainNames[0].IpAddresses[0], "93.184.216.34")
@@ -272,12 +278,12 @@ func TestGetAbuseReport(t *testing.T) {
 
 	message1 := response.LatestMessages[0]
 	assert.Equal(message1.PostedBy, "CUSTOMER")
-	assert.Equal(message1.PostedAt, "2015-09-30T06:23:40+00:00")
+	assert.Equal(message1.PostedAt, MustTime("2015-09-30T06:23:40+00:00"))
 	assert.Equal(message1.Body, "Hello, this is my first message!")
 
 	message2 := response.LatestMessages[1]
 	assert.Equal(message2.PostedBy, "ABUSE_AGENT")
-	assert.Equal(message2.PostedAt, "2015-10-08T08:25:29+00:00")
+	assert.Equal(message2.PostedAt, MustTime("2015-10-08T08:25:29+00:00"))
 	assert.Equal(message2.Body, "Hi, this is our first reply.")
 
 	assert.Equal(message2.Attachment.Id, "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
@@ -388,12 +394,12 @@ func TestGetAbuseReportMessages(t *testing.T) {
 
 	message1 := response.Messages[0]
 	assert.Equal(message1.PostedBy, "CUSTOMER")
-	assert.Equal(message1.PostedAt, "2015-09-30T06:23:40+00:00")
+	assert.Equal(message1.PostedAt, MustTime("2015-09-30T06:23:40+00:00"))
 	assert.Equal(message1.Body, "Hello, this is my first message!")
 
 	message2 := response.Messages[1]
 	assert.Equal(message2.PostedBy, "ABUSE_AGENT")
-	assert.Equal(message2.PostedAt, "2015-10-08T08:25:29+00:00")
+	assert.Equal(message2.PostedAt, MustTime("2015-10-08T08:25:29+00:00"))
 	assert.Equal(message2.Body, "Hi, this is our first reply.")
 
 	assert.Equal(message2.Attachment.Id, "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
@@ -405,6 +411,8 @@ func TestGetAbuseReportMessagesPaginate(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/abuse/v1/reports/123456789/messages", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
 		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "messages": [
 			{
 				"postedBy": "ABUSE_AGENT",
@@ -432,7 +440,7 @@ func TestGetAbuseReportMessagesPaginate(t *testing.T) {
 
 	message1 := response.Messages[0]
 	assert.Equal(message1.PostedBy, "ABUSE_AGENT")
-	assert.Equal(message1.PostedAt, "2015-10-08T08:25:29+00:00")
+	assert.Equal(message1.PostedAt, MustTime("2015-10-08T08:25:29+00:00"))
 	assert.Equal(message1.Body, "Hi, this is our first reply.")
 
 	assert.Equal(message1.Attachment.Id, "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
@@ -530,6 +538,9 @@ func TestCreateNewAbuseReportMessage(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
 		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		payload := map[string]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]string{"body": "message body..."}, payload)
 		w.WriteHeader(http.StatusAccepted)
 		fmt.Fprintf(w, `["To make sure the request has been processed please see if the message is added to the list."]`)
 	})
@@ -771,3 +782,365 @@ func TestResolveAbuseReportServerErrors(t *testing.T) {
 	}
 	assertServerErrorTests(t, serverErrorTests)
 }
+func TestGetAbuseReportAttachments(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/abuse/v1/reports/000005/attachments/1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2", r.URL.Path)
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Content-Disposition", `attachment; filename="evidence.txt"`)
+		fmt.Fprint(w, "spam headers")
+	})
+	defer teardown()
+
+	download, err := AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.ContentType, "text/plain")
+	assert.Equal(download.Filename, "evidence.txt")
+	content, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(content), "spam headers")
+}
+
+func TestGetAbuseReportAttachmentsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportAttachments(context.Background(), "000005", "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestGetAbuseReportMessageAttachments(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/abuse/v1/reports/000005/messageAttachments/436acbbe-0fdf-453c-b1f5-1abd8e7f8fef", r.URL.Path)
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "png data")
+	})
+	defer teardown()
+
+	download, err := AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.ContentType, "image/png")
+	content, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(content), "png data")
+}
+
+func TestGetAbuseReportMessageAttachmentsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.GetAbuseReportMessageAttachments(context.Background(), "000005", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestSaveAbuseReportAttachments(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		switch r.URL.Path {
+		case "/abuse/v1/reports/000005":
+			fmt.Fprintf(w, `{"id": "000005", "attachments": [
+				{"id": "1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2", "mimeType": "text/plain", "filename": "evidence.txt"},
+				{"id": "7d1c3c5e-3f4b-4e0e-9d6a-2b3c4d5e6f70", "mimeType": "text/plain", "filename": "../../etc/passwd"}
+			]}`)
+		case "/abuse/v1/reports/000005/messages":
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 0, "totalCount": 3}, "messages": [
+					{"postedBy": "CUSTOMER", "body": "Hello"},
+					{"postedBy": "ABUSE_AGENT", "body": "Screenshot", "attachment": {"id": "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef", "mimeType": "image/png", "filename": "evidence.txt"}}
+				]}`)
+				return
+			}
+			assert.Equal(t, "2", r.URL.Query().Get("offset"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 2, "totalCount": 3}, "messages": [
+				{"postedBy": "ABUSE_AGENT", "body": "Logs", "attachment": {"id": "9f8e7d6c-5b4a-4321-8765-43210fedcba9", "mimeType": "text/plain", "filename": "logs.txt"}}
+			]}`)
+		default:
+			fmt.Fprint(w, "content of "+path.Base(r.URL.Path))
+		}
+	})
+	defer teardown()
+
+	dir := filepath.Join(t.TempDir(), "000005")
+	files, err := AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", dir)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(files), 4)
+
+	expected := map[string]string{
+		"evidence.txt": "content of 1ba75a8a-1a1b-4a17-8fd2-8b2b5b5ba1c2",
+		"passwd":       "content of 7d1c3c5e-3f4b-4e0e-9d6a-2b3c4d5e6f70",
+		"436acbbe-0fdf-453c-b1f5-1abd8e7f8fef-evidence.txt": "content of 436acbbe-0fdf-453c-b1f5-1abd8e7f8fef",
+		"logs.txt": "content of 9f8e7d6c-5b4a-4321-8765-43210fedcba9",
+	}
+	for i, name := range []string{"evidence.txt", "passwd", "436acbbe-0fdf-453c-b1f5-1abd8e7f8fef-evidence.txt", "logs.txt"} {
+		assert.Equal(files[i].Path, filepath.Join(dir, name))
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		assert.Nil(err)
+		assert.Equal(string(content), expected[name])
+	}
+}
+
+func TestSaveAbuseReportAttachmentsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AbuseApi{}.SaveAbuseReportAttachments(context.Background(), "000005", t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
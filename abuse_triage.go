@@ -0,0 +1,173 @@
+package leaseweb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ABUSE_TRIAGE_CONTACT_PAGE_LIMIT = 50
+
+var ABUSE_TRIAGE_CONTACT_ROLES = []string{"ABUSE", "TECHNICAL"}
+
+type AbuseTriageOptions struct {
+	Abuse           AbuseService
+	IpManagement    IpManagementService
+	DedicatedServer DedicatedServerService
+	CustomerAccount CustomerAccountService
+	NullRoute       bool
+}
+
+type AbuseTriage struct {
+	Report              *AbuseReport
+	Ips                 []AbuseTriageIp
+	Contacts            []Contact
+	Resolutions         []Resolution
+	SuggestedResolution *Resolution
+}
+
+type AbuseTriageIp struct {
+	Ip                string
+	DomainNames       []string
+	Details           *Ip
+	Server            *DedicatedServer
+	ContractReference string
+	NullRoute         *NullRoute
+}
+
+func (ati AbuseTriageIp) Ours() bool {
+	return ati.Details != nil
+}
+
+func TriageAbuseReport(abuseReportId string, options AbuseTriageOptions) (*AbuseTriage, error) {
+	if options.Abuse == nil {
+		options.Abuse = AbuseApi{}
+	}
+	if options.IpManagement == nil {
+		options.IpManagement = IpManagementApi{}
+	}
+	if options.DedicatedServer == nil {
+		options.DedicatedServer = DedicatedServerApi{}
+	}
+	if options.CustomerAccount == nil {
+		options.CustomerAccount = CustomerAccountApi{}
+	}
+
+	report, err := options.Abuse.GetAbuseReport(abuseReportId)
+	if err != nil {
+		return nil, err
+	}
+	triage := &AbuseTriage{Report: report}
+
+	for _, triageIp := range detectedAbuseIps(report) {
+		if triageIp.Details, err = options.IpManagement.GetIp(triageIp.Ip); err != nil {
+			if !isNotFound(err) {
+				return triage, err
+			}
+		}
+		if triageIp.Details != nil && triageIp.Details.EquipmentId != "" {
+			if triageIp.Server, err = options.DedicatedServer.Get(triageIp.Details.EquipmentId); err != nil {
+				if !isNotFound(err) {
+					return triage, err
+				}
+			}
+		}
+		if triageIp.Server != nil {
+			triageIp.ContractReference = triageIp.Server.Contract.Reference
+		}
+		if options.NullRoute && triageIp.Ours() && !triageIp.Details.NullRouted {
+			comment := fmt.Sprintf("Null routed for abuse report %s", report.Id)
+			if triageIp.NullRoute, err = options.IpManagement.NullRouteIp(triageIp.Ip, map[string]string{"comment": comment}); err != nil {
+				return triage, err
+			}
+		}
+		triage.Ips = append(triage.Ips, triageIp)
+	}
+
+	if triage.Contacts, err = listAbuseTriageContacts(options.CustomerAccount); err != nil {
+		return triage, err
+	}
+
+	resolutions, err := options.Abuse.ListResolutionOptions(report.Id)
+	if err != nil {
+		return triage, err
+	}
+	triage.Resolutions = resolutions.Resolutions
+	triage.SuggestedResolution = triage.suggestResolution()
+	return triage, nil
+}
+
+func (at *AbuseTriage) Servers() []DedicatedServer {
+	var servers []DedicatedServer
+	seen := map[string]bool{}
+	for _, triageIp := range at.Ips {
+		if triageIp.Server != nil && !seen[triageIp.Server.Id] {
+			seen[triageIp.Server.Id] = true
+			servers = append(servers, *triageIp.Server)
+		}
+	}
+	return servers
+}
+
+func (at *AbuseTriage) suggestResolution() *Resolution {
+	var preferred []string
+	for _, triageIp := range at.Ips {
+		if triageIp.NullRoute != nil || (triageIp.Details != nil && triageIp.Details.NullRouted) {
+			preferred = append(preferred, "SUSPENDED")
+			break
+		}
+	}
+	if len(at.Report.DetectedDomainNames) > 0 {
+		preferred = append(preferred, "DOMAINS_REMOVED")
+	}
+	preferred = append(preferred, "CONTENT_REMOVED")
+
+	for _, id := range preferred {
+		for i, resolution := range at.Resolutions {
+			if strings.Contains(resolution.Id, id) {
+				return &at.Resolutions[i]
+			}
+		}
+	}
+	if len(at.Resolutions) > 0 {
+		return &at.Resolutions[0]
+	}
+	return nil
+}
+
+func detectedAbuseIps(report *AbuseReport) []AbuseTriageIp {
+	var result []AbuseTriageIp
+	index := map[string]int{}
+	add := func(ip string, domainName string) {
+		i, ok := index[ip]
+		if !ok {
+			i = len(result)
+			index[ip] = i
+			result = append(result, AbuseTriageIp{Ip: ip})
+		}
+		if domainName != "" {
+			result[i].DomainNames = append(result[i].DomainNames, domainName)
+		}
+	}
+
+	for _, ip := range report.DetectedIpAddresses {
+		add(ip, "")
+	}
+	for _, domainName := range report.DetectedDomainNames {
+		for _, ip := range domainName.IpAddresses {
+			add(ip, domainName.Name)
+		}
+	}
+	return result
+}
+
+// The API has no per-server contacts, so the triage lists the account-wide
+// ABUSE and TECHNICAL contacts.
+func listAbuseTriageContacts(service CustomerAccountService) ([]Contact, error) {
+	return listAllPages(ABUSE_TRIAGE_CONTACT_PAGE_LIMIT, func(offset, limit int) ([]Contact, Metadata, error) {
+		contacts, err := service.ListContacts(offset, limit, ABUSE_TRIAGE_CONTACT_ROLES)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return contacts.Contacts, contacts.Metadata, nil
+	})
+}
@@ -0,0 +1,184 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriageAbuseReport(t *testing.T) {
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errorCode": "404", "errorMessage": "Resource not found"}`)
+	}
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports/000005": respondWith(`{"id": "000005", "subject": "Phishing", "detectedIpAddresses": ["192.0.2.10", "198.51.100.1"], "detectedDomainNames": [
+			{"name": "example.com", "ipAddresses": ["192.0.2.10", "192.0.2.20"]}
+		]}`),
+		"GET /ipMgmt/v2/ips/192.0.2.10":    respondWith(`{"ip": "192.0.2.10", "equipmentId": "12345", "nullRouted": false}`),
+		"GET /ipMgmt/v2/ips/192.0.2.20":    respondWith(`{"ip": "192.0.2.20", "equipmentId": "67890", "nullRouted": true}`),
+		"GET /ipMgmt/v2/ips/198.51.100.1":  notFound,
+		"GET /bareMetals/v2/servers/12345": respondWith(`{"id": "12345", "contract": {"id": "674382", "reference": "web-01"}}`),
+		"GET /bareMetals/v2/servers/67890": notFound,
+		"GET /account/v1/contacts": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "ABUSE,TECHNICAL", r.URL.Query().Get("primaryRoles"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "contacts": [
+				{"id": "1", "email": "abuse@example.com", "primaryRoles": ["ABUSE"]}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000005/resolutions": respondWith(`{"resolutions": [
+			{"id": "CONTENT_REMOVED", "description": "The mentioned content has been removed."},
+			{"id": "DOMAINS_REMOVED", "description": "The mentioned domain(s) has/have been removed from the Leaseweb network."},
+			{"id": "SUSPENDED", "description": "The customer has been suspended."}
+		]}`),
+	}))
+	defer teardown()
+
+	triage, err := TriageAbuseReport("000005", AbuseTriageOptions{})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(triage.Report.Subject, "Phishing")
+	assert.Equal(len(triage.Ips), 3)
+
+	ip1 := triage.Ips[0]
+	assert.Equal(ip1.Ip, "192.0.2.10")
+	assert.Equal(ip1.DomainNames, []string{"example.com"})
+	assert.True(ip1.Ours())
+	assert.Equal(ip1.Server.Id, "12345")
+	assert.Equal(ip1.ContractReference, "web-01")
+	assert.Nil(ip1.NullRoute)
+
+	ip2 := triage.Ips[1]
+	assert.Equal(ip2.Ip, "198.51.100.1")
+	assert.False(ip2.Ours())
+	assert.Nil(ip2.Server)
+
+	ip3 := triage.Ips[2]
+	assert.Equal(ip3.Ip, "192.0.2.20")
+	assert.True(ip3.Ours())
+	assert.Nil(ip3.Server)
+
+	assert.Equal(len(triage.Servers()), 1)
+	assert.Equal(triage.Contacts[0].Email, "abuse@example.com")
+	assert.Equal(len(triage.Resolutions), 3)
+	assert.Equal(triage.SuggestedResolution.Id, "SUSPENDED")
+}
+
+func TestTriageAbuseReportNullRoute(t *testing.T) {
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errorCode": "404", "errorMessage": "Resource not found"}`)
+	}
+	nullRoutes := map[string]string{}
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports/000005": respondWith(`{"id": "000005", "subject": "Phishing", "detectedIpAddresses": ["192.0.2.10", "198.51.100.1"], "detectedDomainNames": [
+			{"name": "example.com", "ipAddresses": ["192.0.2.10", "192.0.2.20"]}
+		]}`),
+		"GET /ipMgmt/v2/ips/192.0.2.10":    respondWith(`{"ip": "192.0.2.10", "equipmentId": "12345", "nullRouted": false}`),
+		"GET /ipMgmt/v2/ips/192.0.2.20":    respondWith(`{"ip": "192.0.2.20", "equipmentId": "67890", "nullRouted": true}`),
+		"GET /ipMgmt/v2/ips/198.51.100.1":  notFound,
+		"GET /bareMetals/v2/servers/12345": respondWith(`{"id": "12345", "contract": {"id": "674382", "reference": "web-01"}}`),
+		"GET /bareMetals/v2/servers/67890": notFound,
+		"POST /ipMgmt/v2/ips/192.0.2.10/nullRoute": func(w http.ResponseWriter, r *http.Request) {
+			payload := map[string]string{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+			nullRoutes["192.0.2.10"] = payload["comment"]
+			fmt.Fprintf(w, `{"id": "1", "ip": "192.0.2.10", "comment": %q}`, payload["comment"])
+		},
+		"GET /account/v1/contacts": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "ABUSE,TECHNICAL", r.URL.Query().Get("primaryRoles"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "contacts": [
+				{"id": "1", "email": "abuse@example.com", "primaryRoles": ["ABUSE"]}
+			]}`)
+		},
+		"GET /abuse/v1/reports/000005/resolutions": respondWith(`{"resolutions": [
+			{"id": "CONTENT_REMOVED", "description": "The mentioned content has been removed."},
+			{"id": "DOMAINS_REMOVED", "description": "The mentioned domain(s) has/have been removed from the Leaseweb network."},
+			{"id": "SUSPENDED", "description": "The customer has been suspended."}
+		]}`),
+	}))
+	defer teardown()
+
+	triage, err := TriageAbuseReport("000005", AbuseTriageOptions{NullRoute: true})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(nullRoutes, map[string]string{"192.0.2.10": "Null routed for abuse report 000005"})
+	assert.Equal(triage.Ips[0].NullRoute.Comment, "Null routed for abuse report 000005")
+	assert.Nil(triage.Ips[1].NullRoute)
+	assert.Nil(triage.Ips[2].NullRoute)
+}
+
+func TestAbuseTriageSuggestResolution(t *testing.T) {
+	resolutions := []Resolution{{Id: "CONTENT_REMOVED"}, {Id: "DOMAINS_REMOVED"}}
+	assert := assert.New(t)
+
+	triage := &AbuseTriage{Report: &AbuseReport{}, Resolutions: resolutions}
+	assert.Equal(triage.suggestResolution().Id, "CONTENT_REMOVED")
+
+	triage.Report.DetectedDomainNames = []DetectedDomainName{{Name: "example.com"}}
+	assert.Equal(triage.suggestResolution().Id, "DOMAINS_REMOVED")
+
+	triage.Resolutions = []Resolution{{Id: "DUPLICATE"}}
+	assert.Equal(triage.suggestResolution().Id, "DUPLICATE")
+
+	triage.Resolutions = nil
+	assert.Nil(triage.suggestResolution())
+}
+
+func TestTriageAbuseReportServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 404 on report",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"404","errorMessage":"Resource 000005 was not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return TriageAbuseReport("000005", AbuseTriageOptions{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource 000005 was not found",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestTriageAbuseReportReturnsPartialTriageOnError(t *testing.T) {
+	serverError := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+	}
+	routes := map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports/000005":             respondWith(`{"id": "000005", "detectedIpAddresses": ["192.0.2.10", "192.0.2.11"]}`),
+		"GET /ipMgmt/v2/ips/192.0.2.10":            respondWith(`{"ip": "192.0.2.10", "equipmentId": "12345", "nullRouted": false}`),
+		"GET /ipMgmt/v2/ips/192.0.2.11":            respondWith(`{"ip": "192.0.2.11", "nullRouted": false}`),
+		"GET /bareMetals/v2/servers/12345":         respondWith(`{"id": "12345", "contract": {"id": "674382", "reference": "web-01"}}`),
+		"POST /ipMgmt/v2/ips/192.0.2.10/nullRoute": respondWith(`{"id": "1", "ip": "192.0.2.10", "comment": "Null routed for abuse report 000005"}`),
+		"POST /ipMgmt/v2/ips/192.0.2.11/nullRoute": serverError,
+		"GET /account/v1/contacts":                 respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 0}, "contacts": []}`),
+		"GET /abuse/v1/reports/000005/resolutions": respondWith(`{"resolutions": []}`),
+	}
+	setup(serveRoutes(t, routes))
+	defer teardown()
+
+	triage, err := TriageAbuseReport("000005", AbuseTriageOptions{NullRoute: true})
+	assert := assert.New(t)
+	assert.Equal(err.(*LeasewebError).ErrorCode, "SERVER_ERROR")
+	assert.Equal(triage.Report.Id, "000005")
+	assert.Equal(len(triage.Ips), 1)
+	assert.Equal(triage.Ips[0].NullRoute.Comment, "Null routed for abuse report 000005")
+
+	routes["POST /ipMgmt/v2/ips/192.0.2.11/nullRoute"] = respondWith(`{"id": "2", "ip": "192.0.2.11"}`)
+	routes["GET /account/v1/contacts"] = serverError
+	triage, err = TriageAbuseReport("000005", AbuseTriageOptions{NullRoute: true})
+	assert.Equal(err.(*LeasewebError).ErrorCode, "SERVER_ERROR")
+	assert.Equal(len(triage.Ips), 2)
+	assert.NotNil(triage.Ips[1].NullRoute)
+	assert.Nil(triage.Resolutions)
+}
@@ -0,0 +1,280 @@
+package leaseweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ABUSE_EVENT_NEW_REPORT           = "NEW_REPORT"
+	ABUSE_EVENT_NEW_MESSAGES         = "NEW_MESSAGES"
+	ABUSE_EVENT_DEADLINE_APPROACHING = "DEADLINE_APPROACHING"
+	DEFAULT_ABUSE_DEADLINE_WARNING   = 24 * time.Hour
+)
+
+var ABUSE_WATCHER_STATUSES = []string{"OPEN", "WAITING"}
+
+type AbuseWatcherOptions struct {
+	Service         AbuseService
+	Notifier        Notifier
+	StatePath       string
+	DeadlineWarning time.Duration
+	Now             func() time.Time
+	// OnError receives the errors of the checks done by Run. Errors are
+	// dropped when it is nil.
+	OnError func(err error)
+}
+
+// AbuseWatcherError holds the errors of the reports that could not be checked.
+// The other reports are still checked and their events returned.
+type AbuseWatcherError struct {
+	Reports map[string]error
+}
+
+type AbuseWatcher struct {
+	options AbuseWatcherOptions
+	state   abuseWatcherState
+}
+
+type AbuseEvent struct {
+	Type     string         `json:"type"`
+	Report   AbuseReport    `json:"report"`
+	Messages []AbuseMessage `json:"messages,omitempty"`
+	Deadline time.Time      `json:"deadline"`
+	TimeLeft time.Duration  `json:"timeLeft"`
+}
+
+type abuseWatcherState struct {
+	Reports map[string]abuseWatcherReportState `json:"reports"`
+}
+
+type abuseWatcherReportState struct {
+	MessagesCount    int  `json:"messagesCount"`
+	LastMessageAt    Time `json:"lastMessageAt"`
+	Deadline         Time `json:"deadline"`
+	DeadlineNotified bool `json:"deadlineNotified"`
+}
+
+func NewAbuseWatcher(options AbuseWatcherOptions) (*AbuseWatcher, error) {
+	if options.Service == nil {
+		options.Service = AbuseApi{}
+	}
+	if options.Notifier == nil {
+		return nil, fmt.Errorf("abuse watcher requires a notifier")
+	}
+	if options.DeadlineWarning <= 0 {
+		options.DeadlineWarning = DEFAULT_ABUSE_DEADLINE_WARNING
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+
+	aw := &AbuseWatcher{options: options, state: abuseWatcherState{Reports: map[string]abuseWatcherReportState{}}}
+	if options.StatePath == "" {
+		return aw, nil
+	}
+	b, err := os.ReadFile(options.StatePath)
+	if os.IsNotExist(err) {
+		return aw, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &aw.state); err != nil {
+		return nil, fmt.Errorf("invalid abuse watcher state %s: %w", options.StatePath, err)
+	}
+	if aw.state.Reports == nil {
+		aw.state.Reports = map[string]abuseWatcherReportState{}
+	}
+	return aw, nil
+}
+
+func (aw *AbuseWatcher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ctx.Err() == nil {
+		if _, err := aw.Check(); err != nil && aw.options.OnError != nil {
+			aw.options.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+	return ctx.Err()
+}
+
+func (aw *AbuseWatcher) Check() ([]AbuseEvent, error) {
+	reports, err := listAllAbuseReports(aw.options.Service, ABUSE_WATCHER_STATUSES)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []AbuseEvent
+	reportErrors := map[string]error{}
+	seen := map[string]bool{}
+	for _, report := range reports {
+		seen[report.Id] = true
+		reportEvents, err := aw.checkReport(report)
+		events = append(events, reportEvents...)
+		if err != nil {
+			reportErrors[report.Id] = err
+		}
+	}
+	for id := range aw.state.Reports {
+		if !seen[id] {
+			delete(aw.state.Reports, id)
+		}
+	}
+	if err = aw.saveState(); err != nil {
+		return events, err
+	}
+	if len(reportErrors) > 0 {
+		return events, &AbuseWatcherError{Reports: reportErrors}
+	}
+	return events, nil
+}
+
+func (aw *AbuseWatcher) checkReport(report AbuseReport) ([]AbuseEvent, error) {
+	var events []AbuseEvent
+	deadline := report.Deadline.Time
+	state, known := aw.state.Reports[report.Id]
+
+	if !known {
+		state = abuseWatcherReportState{MessagesCount: report.TotalMessagesCount, Deadline: report.Deadline}
+		if report.TotalMessagesCount > 0 {
+			messages, err := listAllAbuseReportMessages(aw.options.Service, report.Id)
+			if err != nil {
+				return events, err
+			}
+			_, state.LastMessageAt = newAbuseMessages(messages, Time{})
+		}
+		event := AbuseEvent{Type: ABUSE_EVENT_NEW_REPORT, Report: report, Deadline: deadline}
+		if err := aw.options.Notifier.Notify(event); err != nil {
+			return events, err
+		}
+		events = append(events, event)
+		aw.state.Reports[report.Id] = state
+	}
+
+	if report.TotalMessagesCount != state.MessagesCount {
+		messages, err := listAllAbuseReportMessages(aw.options.Service, report.Id)
+		if err != nil {
+			return events, err
+		}
+		newMessages, lastMessageAt := newAbuseMessages(messages, state.LastMessageAt)
+		if len(newMessages) > 0 {
+			event := AbuseEvent{Type: ABUSE_EVENT_NEW_MESSAGES, Report: report, Messages: newMessages, Deadline: deadline}
+			if err = aw.options.Notifier.Notify(event); err != nil {
+				return events, err
+			}
+			events = append(events, event)
+		}
+		state.LastMessageAt = lastMessageAt
+	}
+	state.MessagesCount = report.TotalMessagesCount
+
+	if !state.Deadline.Equal(deadline) {
+		state.Deadline = report.Deadline
+		state.DeadlineNotified = false
+	}
+	aw.state.Reports[report.Id] = state
+
+	timeLeft := deadline.Sub(aw.options.Now())
+	if !deadline.IsZero() && !state.DeadlineNotified && timeLeft <= aw.options.DeadlineWarning {
+		event := AbuseEvent{Type: ABUSE_EVENT_DEADLINE_APPROACHING, Report: report, Deadline: deadline, TimeLeft: timeLeft}
+		if err := aw.options.Notifier.Notify(event); err != nil {
+			return events, err
+		}
+		events = append(events, event)
+		state.DeadlineNotified = true
+		aw.state.Reports[report.Id] = state
+	}
+	return events, nil
+}
+
+func newAbuseMessages(messages []AbuseMessage, after Time) ([]AbuseMessage, Time) {
+	var result []AbuseMessage
+	last := after
+	for _, message := range messages {
+		if message.PostedAt.After(after.Time) {
+			result = append(result, message)
+		}
+		if message.PostedAt.After(last.Time) {
+			last = message.PostedAt
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PostedAt.Before(result[j].PostedAt.Time)
+	})
+	return result, last
+}
+
+func (aw *AbuseWatcher) saveState() error {
+	if aw.options.StatePath == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(aw.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(aw.options.StatePath), 0755); err != nil {
+		return err
+	}
+	tmpPath := aw.options.StatePath + ".tmp"
+	if err = os.WriteFile(tmpPath, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, aw.options.StatePath)
+}
+
+func (awe *AbuseWatcherError) Error() string {
+	ids := make([]string, 0, len(awe.Reports))
+	for id := range awe.Reports {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("abuse report %s: %v", id, awe.Reports[id])
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (ae AbuseEvent) Subject() string {
+	switch ae.Type {
+	case ABUSE_EVENT_NEW_REPORT:
+		return fmt.Sprintf("New abuse report %s: %s", ae.Report.Id, ae.Report.Subject)
+	case ABUSE_EVENT_NEW_MESSAGES:
+		return fmt.Sprintf("%d new message(s) on abuse report %s", len(ae.Messages), ae.Report.Id)
+	case ABUSE_EVENT_DEADLINE_APPROACHING:
+		if ae.TimeLeft <= 0 {
+			return fmt.Sprintf("Deadline of abuse report %s has passed", ae.Report.Id)
+		}
+		return fmt.Sprintf("Deadline of abuse report %s in %s", ae.Report.Id, ae.TimeLeft.Round(time.Minute))
+	}
+	return fmt.Sprintf("Abuse report %s", ae.Report.Id)
+}
+
+func (ae AbuseEvent) Body() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Report:   %s\n", ae.Report.Id)
+	fmt.Fprintf(&b, "Subject:  %s\n", ae.Report.Subject)
+	fmt.Fprintf(&b, "Status:   %s\n", ae.Report.Status)
+	if !ae.Deadline.IsZero() {
+		fmt.Fprintf(&b, "Deadline: %s\n", ae.Deadline.Format(time.RFC3339))
+	}
+	if len(ae.Report.DetectedIpAddresses) > 0 {
+		fmt.Fprintf(&b, "IPs:      %s\n", strings.Join(ae.Report.DetectedIpAddresses, ", "))
+	}
+	for _, message := range ae.Messages {
+		fmt.Fprintf(&b, "\n%s at %s:\n%s\n", message.PostedBy, message.PostedAt, message.Body)
+	}
+	return b.String()
+}
@@ -0,0 +1,173 @@
+package leaseweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbuseWatcher(t *testing.T) {
+	reports := `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "reports": [
+		{"id": "000001", "subject": "Spam", "status": "OPEN", "deadline": "2023-03-20T12:00:00+00:00", "totalMessagesCount": 1},
+		{"id": "000002", "subject": "Phishing", "status": "OPEN", "deadline": "2023-03-11T08:00:00+0100", "totalMessagesCount": 0}
+	]}`
+	messages := `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "messages": [
+		{"postedBy": "ABUSE_AGENT", "postedAt": "2023-03-09T09:00:00+00:00", "body": "Please investigate."}
+	]}`
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "OPEN,WAITING", r.URL.Query().Get("status"))
+			fmt.Fprint(w, reports)
+		},
+		"GET /abuse/v1/reports/000001/messages": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "0", r.URL.Query().Get("offset"))
+			fmt.Fprint(w, messages)
+		},
+	}))
+	defer teardown()
+
+	statePath := filepath.Join(t.TempDir(), "state", "abuse.json")
+	notifier := &recordingNotifier{}
+	options := AbuseWatcherOptions{Notifier: notifier, StatePath: statePath, Now: testClock}
+	watcher, err := NewAbuseWatcher(options)
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	events, err := watcher.Check()
+	assert.Nil(err)
+	assert.Equal(len(events), 3)
+	assert.Equal(events[0].Type, ABUSE_EVENT_NEW_REPORT)
+	assert.Equal(events[0].Report.Id, "000001")
+	assert.Equal(events[0].Subject(), "New abuse report 000001: Spam")
+	assert.Equal(events[1].Type, ABUSE_EVENT_NEW_REPORT)
+	assert.Equal(events[1].Report.Id, "000002")
+	assert.Equal(events[2].Type, ABUSE_EVENT_DEADLINE_APPROACHING)
+	assert.Equal(events[2].Report.Id, "000002")
+	assert.Equal(events[2].TimeLeft, 19*time.Hour)
+	assert.Equal(events[2].Subject(), "Deadline of abuse report 000002 in 19h0m0s")
+	assert.Equal(len(notifier.notifications), 3)
+
+	watcher, err = NewAbuseWatcher(options)
+	assert.Nil(err)
+	events, err = watcher.Check()
+	assert.Nil(err)
+	assert.Empty(events)
+
+	reports = `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "reports": [
+		{"id": "000001", "subject": "Spam", "status": "WAITING", "deadline": "2023-03-10T18:00:00+00:00", "totalMessagesCount": 3}
+	]}`
+	messages = `{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "messages": [
+		{"postedBy": "ABUSE_AGENT", "postedAt": "2023-03-10T11:00:00+00:00", "body": "Please reply."},
+		{"postedBy": "ABUSE_AGENT", "postedAt": "2023-03-10T10:00:00+00:00", "body": "Any update?"},
+		{"postedBy": "ABUSE_AGENT", "postedAt": "2023-03-09T09:00:00+00:00", "body": "Please investigate."}
+	]}`
+	watcher, err = NewAbuseWatcher(options)
+	assert.Nil(err)
+	events, err = watcher.Check()
+	assert.Nil(err)
+	assert.Equal(len(events), 2)
+	assert.Equal(events[0].Type, ABUSE_EVENT_NEW_MESSAGES)
+	assert.Equal(len(events[0].Messages), 2)
+	assert.Equal(events[0].Subject(), "2 new message(s) on abuse report 000001")
+	assert.Equal(events[0].Messages[0].Body, "Any update?")
+	assert.Equal(events[0].Messages[1].Body, "Please reply.")
+	assert.Contains(events[0].Body(), "ABUSE_AGENT at 2023-03-10T11:00:00Z:\nPlease reply.\n")
+	assert.Equal(events[1].Type, ABUSE_EVENT_DEADLINE_APPROACHING)
+	assert.Equal(events[1].TimeLeft, 6*time.Hour)
+	assert.Equal(len(watcher.state.Reports), 1)
+}
+
+func TestAbuseWatcherNotifierError(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "reports": [
+			{"id": "000001", "subject": "Spam", "status": "OPEN", "totalMessagesCount": 0}
+		]}`),
+	}))
+	defer teardown()
+
+	statePath := filepath.Join(t.TempDir(), "abuse.json")
+	notifier := &recordingNotifier{err: errors.New("smtp unavailable")}
+	watcher, err := NewAbuseWatcher(AbuseWatcherOptions{Notifier: notifier, StatePath: statePath})
+	assert := assert.New(t)
+	assert.Nil(err)
+	_, err = watcher.Check()
+	assert.Equal(err.Error(), "abuse report 000001: smtp unavailable")
+
+	notifier.err = nil
+	watcher, err = NewAbuseWatcher(AbuseWatcherOptions{Notifier: notifier, StatePath: statePath})
+	assert.Nil(err)
+	events, err := watcher.Check()
+	assert.Nil(err)
+	assert.Equal(len(events), 1)
+	assert.Equal(events[0].Type, ABUSE_EVENT_NEW_REPORT)
+}
+
+func TestAbuseWatcherChecksOtherReportsOnError(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /abuse/v1/reports": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "reports": [
+			{"id": "000001", "subject": "Spam", "status": "OPEN", "totalMessagesCount": 1},
+			{"id": "000002", "subject": "Phishing", "status": "OPEN", "totalMessagesCount": 0}
+		]}`),
+		"GET /abuse/v1/reports/000001/messages": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `{"errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+		},
+	}))
+	defer teardown()
+
+	notifier := &recordingNotifier{}
+	watcher, err := NewAbuseWatcher(AbuseWatcherOptions{Notifier: notifier})
+	assert := assert.New(t)
+	assert.Nil(err)
+	events, err := watcher.Check()
+	assert.Equal(err.Error(), "abuse report 000001: The API is not available at the moment.")
+	watcherErr := &AbuseWatcherError{}
+	assert.True(errors.As(err, &watcherErr))
+	assert.Equal(len(watcherErr.Reports), 1)
+	assert.Equal(len(events), 1)
+	assert.Equal(events[0].Report.Id, "000002")
+	assert.Equal(len(notifier.notifications), 1)
+}
+
+func TestAbuseWatcherRunKeepsTickingOnError(t *testing.T) {
+	checks := 0
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		checks++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, `{"errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+	})
+	defer teardown()
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	errs := []error{}
+	watcher, err := NewAbuseWatcher(AbuseWatcherOptions{Notifier: &recordingNotifier{}, OnError: func(err error) {
+		errs = append(errs, err)
+		if len(errs) == 3 {
+			cancel()
+		}
+	}})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.ErrorIs(watcher.Run(runCtx, time.Millisecond), context.Canceled)
+	assert.Equal(len(errs), 3)
+	assert.Equal(checks, 3)
+	assert.Equal(errs[0].Error(), "The API is not available at the moment.")
+}
+
+func TestNewAbuseWatcherErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := NewAbuseWatcher(AbuseWatcherOptions{})
+	assert.Equal(err.Error(), "abuse watcher requires a notifier")
+
+	statePath := filepath.Join(t.TempDir(), "abuse.json")
+	assert.Nil(os.WriteFile(statePath, []byte("not json"), 0600))
+	_, err = NewAbuseWatcher(AbuseWatcherOptions{Notifier: &recordingNotifier{}, StatePath: statePath})
+	assert.Contains(err.Error(), "invalid abuse watcher state")
+}
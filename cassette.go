@@ -0,0 +1,337 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CASSETTE_MATCH_STRICT  = "strict"
+	CASSETTE_MATCH_LENIENT = "lenient"
+)
+
+var cassetteScrubbedHeaders = []string{"x-lsw-auth", "Authorization"}
+
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  CassetteRequest  `json:"request" yaml:"request"`
+	Response CassetteResponse `json:"response" yaml:"response"`
+}
+
+type CassetteRequest struct {
+	Method  string      `json:"method" yaml:"method"`
+	Url     string      `json:"url" yaml:"url"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"statusCode" yaml:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+}
+
+type CassetteMismatchError struct {
+	Method string
+	Url    string
+	Reason string
+}
+
+func (cme *CassetteMismatchError) Error() string {
+	return fmt.Sprintf("cassette: no recorded interaction for %s %s: %s", cme.Method, cme.Url, cme.Reason)
+}
+
+type Recorder struct {
+	mu        sync.Mutex
+	path      string
+	cassette  Cassette
+	transport http.RoundTripper
+	oldClient *http.Client
+}
+
+type ReplayTransport struct {
+	mu        sync.Mutex
+	cassette  *Cassette
+	mode      string
+	used      []bool
+	next      int
+	oldClient *http.Client
+}
+
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if isJsonCassette(path) {
+		err = json.Unmarshal(b, cassette)
+	} else {
+		err = yaml.Unmarshal(b, cassette)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
+
+func (c *Cassette) Save(path string) error {
+	var b []byte
+	var err error
+	if isJsonCassette(path) {
+		b, err = json.MarshalIndent(c, "", "  ")
+	} else {
+		b, err = yaml.Marshal(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+func isJsonCassette(path string) bool {
+	return filepath.Ext(path) == ".json"
+}
+
+func StartRecording(path string) (*Recorder, error) {
+	if lswClient == nil || lswClient.client == nil {
+		return nil, errors.New("leaseweb client is not initialized, call InitLeasewebClient first")
+	}
+	recorder := &Recorder{
+		path:      path,
+		transport: lswClient.client.Transport,
+		oldClient: lswClient.client,
+	}
+	if recorder.transport == nil {
+		recorder.transport = http.DefaultTransport
+	}
+
+	client := *lswClient.client
+	client.Transport = recorder
+	lswClient.client = &client
+	return recorder, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: CassetteRequest{
+			Method:  req.Method,
+			Url:     req.URL.RequestURI(),
+			Headers: scrubHeaders(req.Header),
+			Body:    string(reqBody),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header),
+			Body:       string(respBody),
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette
+}
+
+func (r *Recorder) Stop() error {
+	lswClient.client = r.oldClient
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+func NewReplayTransport(cassette *Cassette, mode string) *ReplayTransport {
+	return &ReplayTransport{
+		cassette: cassette,
+		mode:     mode,
+		used:     make([]bool, len(cassette.Interactions)),
+	}
+}
+
+func StartReplay(path string, mode string) (*ReplayTransport, error) {
+	if mode != CASSETTE_MATCH_STRICT && mode != CASSETTE_MATCH_LENIENT {
+		return nil, fmt.Errorf("unknown cassette match mode %q, expected %s or %s", mode, CASSETTE_MATCH_STRICT, CASSETTE_MATCH_LENIENT)
+	}
+	if lswClient == nil || lswClient.client == nil {
+		return nil, errors.New("leaseweb client is not initialized, call InitLeasewebClient first")
+	}
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	replay := NewReplayTransport(cassette, mode)
+	replay.oldClient = lswClient.client
+	client := *lswClient.client
+	client.Transport = replay
+	lswClient.client = &client
+	return replay, nil
+}
+
+func (rt *ReplayTransport) Stop() {
+	if rt.oldClient != nil {
+		lswClient.client = rt.oldClient
+	}
+}
+
+func (rt *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	var interaction *Interaction
+	if rt.mode == CASSETTE_MATCH_LENIENT {
+		interaction, err = rt.matchLenient(req)
+	} else {
+		interaction, err = rt.matchStrict(req, reqBody)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	for key, values := range interaction.Response.Headers {
+		header[key] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (rt *ReplayTransport) matchStrict(req *http.Request, body []byte) (*Interaction, error) {
+	requestUri := req.URL.RequestURI()
+	if rt.next >= len(rt.cassette.Interactions) {
+		return nil, &CassetteMismatchError{Method: req.Method, Url: requestUri, Reason: "cassette exhausted"}
+	}
+
+	interaction := &rt.cassette.Interactions[rt.next]
+	if interaction.Request.Method != req.Method || interaction.Request.Url != requestUri {
+		reason := fmt.Sprintf("expected %s %s", interaction.Request.Method, interaction.Request.Url)
+		return nil, &CassetteMismatchError{Method: req.Method, Url: requestUri, Reason: reason}
+	}
+	if interaction.Request.Body != string(body) {
+		return nil, &CassetteMismatchError{Method: req.Method, Url: requestUri, Reason: "request body differs"}
+	}
+
+	rt.used[rt.next] = true
+	rt.next++
+	return interaction, nil
+}
+
+func (rt *ReplayTransport) matchLenient(req *http.Request) (*Interaction, error) {
+	var fallback *Interaction
+	for i := range rt.cassette.Interactions {
+		interaction := &rt.cassette.Interactions[i]
+		if !sameRequestTarget(interaction.Request, req) {
+			continue
+		}
+		if !rt.used[i] {
+			rt.used[i] = true
+			return interaction, nil
+		}
+		fallback = interaction
+	}
+
+	if fallback == nil {
+		return nil, &CassetteMismatchError{Method: req.Method, Url: req.URL.RequestURI(), Reason: "no matching method and path"}
+	}
+	return fallback, nil
+}
+
+func sameRequestTarget(recorded CassetteRequest, req *http.Request) bool {
+	if recorded.Method != req.Method {
+		return false
+	}
+	recordedUrl, err := url.Parse(recorded.Url)
+	if err != nil {
+		return false
+	}
+	if recordedUrl.Path != req.URL.Path {
+		return false
+	}
+	return reflect.DeepEqual(normalizeQuery(recordedUrl.Query()), normalizeQuery(req.URL.Query()))
+}
+
+func normalizeQuery(v url.Values) url.Values {
+	if len(v) == 0 {
+		return url.Values{}
+	}
+	return v
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func scrubHeaders(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	for _, key := range cassetteScrubbedHeaders {
+		scrubbed.Del(key)
+	}
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
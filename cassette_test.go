@@ -0,0 +1,133 @@
+package leaseweb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordCassette(t *testing.T) {
+	for _, name := range []string{"cassette.yaml", "cassette.json"} {
+		setup(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id": "123456", "reference": "my-reference"}`)
+		})
+
+		path := filepath.Join(t.TempDir(), name)
+		recorder, err := StartRecording(path)
+		assert.Nil(t, err)
+		response, err := CustomerAccountApi{}.GetContact("123456")
+		assert.Nil(t, err)
+		assert.Equal(t, response.Id, "123456")
+		assert.Nil(t, recorder.Stop())
+		teardown()
+
+		b, err := os.ReadFile(path)
+		assert.Nil(t, err)
+		assert.False(t, strings.Contains(string(b), testApiKey))
+
+		cassette, err := LoadCassette(path)
+		assert := assert.New(t)
+		assert.Nil(err)
+		assert.Equal(len(cassette.Interactions), 1)
+		assert.Equal(cassette.Interactions[0].Request.Method, http.MethodGet)
+		assert.Equal(cassette.Interactions[0].Request.Url, "/account/v1/contacts/123456")
+		assert.Empty(cassette.Interactions[0].Request.Headers.Get("x-lsw-auth"))
+		assert.Equal(cassette.Interactions[0].Response.StatusCode, http.StatusOK)
+		assert.Equal(cassette.Interactions[0].Response.Headers.Get("Content-Type"), "application/json")
+		assert.Equal(cassette.Interactions[0].Response.Body, `{"id": "123456", "reference": "my-reference"}`)
+	}
+}
+
+func TestReplayCassetteStrict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	cassette := Cassette{Interactions: []Interaction{
+		{
+			Request:  CassetteRequest{Method: http.MethodGet, Url: "/invoices/v1/invoices/00000001"},
+			Response: CassetteResponse{StatusCode: http.StatusOK, Body: `{"id": "00000001", "status": "PAID"}`},
+		},
+		{
+			Request:  CassetteRequest{Method: http.MethodPost, Url: "/services/v1/services/12345/uncancel"},
+			Response: CassetteResponse{StatusCode: http.StatusNoContent},
+		},
+	}}
+	assert.Nil(t, cassette.Save(path))
+
+	replay, err := StartReplay(path, CASSETTE_MATCH_STRICT)
+	assert.Nil(t, err)
+	defer replay.Stop()
+
+	assert := assert.New(t)
+	_, err = ServicesApi{}.GetService("12345")
+	assert.NotNil(err)
+	mismatchErr := &CassetteMismatchError{}
+	assert.True(errors.As(err, &mismatchErr))
+	assert.Equal(mismatchErr.Reason, "expected GET /invoices/v1/invoices/00000001")
+
+	invoice, err := InvoiceApi{}.GetInvoice("00000001")
+	assert.Nil(err)
+	assert.Equal(invoice.Id, "00000001")
+	assert.Equal(invoice.Status, "PAID")
+	assert.Nil(ServicesApi{}.UncancelService("12345"))
+
+	_, err = InvoiceApi{}.GetInvoice("00000001")
+	assert.True(errors.As(err, &mismatchErr))
+	assert.Equal(mismatchErr.Reason, "cassette exhausted")
+}
+
+func TestReplayCassetteLenient(t *testing.T) {
+	cassette := &Cassette{Interactions: []Interaction{
+		{
+			Request:  CassetteRequest{Method: http.MethodGet, Url: "/invoices/v1/invoices?limit=10&offset=0"},
+			Response: CassetteResponse{StatusCode: http.StatusOK, Body: `{"_metadata": {"limit": 10, "offset": 0, "totalCount": 1}, "invoices": [{"id": "00000001"}]}`},
+		},
+		{
+			Request:  CassetteRequest{Method: http.MethodGet, Url: "/services/v1/services/12345"},
+			Response: CassetteResponse{StatusCode: http.StatusNotFound, Body: `{"errorCode": "404", "errorMessage": "Resource not found"}`},
+		},
+	}}
+
+	oldClient := lswClient.client
+	lswClient.client = &http.Client{Transport: NewReplayTransport(cassette, CASSETTE_MATCH_LENIENT)}
+	defer func() { lswClient.client = oldClient }()
+
+	assert := assert.New(t)
+	_, err := ServicesApi{}.GetService("12345")
+	assert.Equal(err.Error(), "Resource not found")
+
+	for i := 0; i < 2; i++ {
+		invoices, err := InvoiceApi{}.ListInvoices(0, 10)
+		assert.Nil(err)
+		assert.Equal(invoices.Invoices[0].Id, "00000001")
+	}
+
+	_, err = InvoiceApi{}.ListInvoices(10, 10)
+	mismatchErr := &CassetteMismatchError{}
+	assert.True(errors.As(err, &mismatchErr))
+	assert.Equal(mismatchErr.Reason, "no matching method and path")
+}
+
+func TestStartCassetteErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	assert.Nil(t, (&Cassette{}).Save(path))
+
+	assert := assert.New(t)
+	_, err := StartReplay(path, "fuzzy")
+	assert.Equal(err.Error(), `unknown cassette match mode "fuzzy", expected strict or lenient`)
+
+	oldClient := lswClient.client
+	lswClient.client = nil
+	defer func() { lswClient.client = oldClient }()
+
+	_, err = StartRecording(path)
+	assert.Equal(err.Error(), "leaseweb client is not initialized, call InitLeasewebClient first")
+	_, err = StartReplay(path, CASSETTE_MATCH_STRICT)
+	assert.Equal(err.Error(), "leaseweb client is not initialized, call InitLeasewebClient first")
+}
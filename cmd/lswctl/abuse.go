@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func listAbuseReports(s *session, args []string) error {
+	flags := flag.NewFlagSet("abuse list", flag.ContinueOnError)
+	status := flags.String("status", "OPEN,WAITING", "")
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	args, err := parseFlags("abuse list", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("abuse list", args, 0); err != nil {
+		return err
+	}
+
+	result, err := leaseweb.AbuseApi{}.ListAbuseReports(*offset, strings.Split(*status, ","), *limit)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "STATUS", "SUBJECT", "REPORTED AT", "DEADLINE", "MESSAGES"}}
+	for _, report := range result.AbuseReports {
+		t.add(report.Id, report.Status, report.Subject, report.ReportedAt, report.Deadline, report.TotalMessagesCount)
+	}
+	return s.print(result, t)
+}
+
+func resolveAbuseReport(s *session, args []string) error {
+	flags := flag.NewFlagSet("abuse resolve", flag.ContinueOnError)
+	resolution := flags.String("resolution", "", "")
+	args, err := parseFlags("abuse resolve", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("abuse resolve", args, 1); err != nil {
+		return err
+	}
+	if *resolution == "" {
+		return errRequiredFlag("abuse resolve", "resolution")
+	}
+
+	if err = (leaseweb.AbuseApi{}).ResolveAbuseReport(args[0], strings.Split(*resolution, ",")); err != nil {
+		return err
+	}
+	return s.done("Abuse report %s resolved", args[0])
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func listContacts(s *session, args []string) error {
+	flags := flag.NewFlagSet("contacts list", flag.ContinueOnError)
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	args, err := parseFlags("contacts list", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("contacts list", args, 0); err != nil {
+		return err
+	}
+
+	result, err := leaseweb.CustomerAccountApi{}.ListContacts(*offset, *limit)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "EMAIL", "ROLES", "PRIMARY ROLES"}}
+	for _, contact := range result.Contacts {
+		t.add(contact.Id, strings.TrimSpace(contact.FirstName+" "+contact.LastName), contact.Email, strings.Join(contact.Roles, ","), strings.Join(contact.PrimaryRoles, ","))
+	}
+	return s.print(result, t)
+}
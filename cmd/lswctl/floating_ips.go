@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func listFloatingIpRanges(s *session, args []string) error {
+	flags := flag.NewFlagSet("floating-ips ranges", flag.ContinueOnError)
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	args, err := parseFlags("floating-ips ranges", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("floating-ips ranges", args, 0); err != nil {
+		return err
+	}
+
+	result, err := leaseweb.FloatingIpApi{}.ListRanges(*offset, *limit)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "RANGE", "LOCATION", "TYPE"}}
+	for _, ipRange := range result.Ranges {
+		t.add(ipRange.Id, ipRange.Range, ipRange.Location, ipRange.Type)
+	}
+	return s.print(result, t)
+}
+
+func listFloatingIpDefinitions(s *session, args []string) error {
+	flags := flag.NewFlagSet("floating-ips definitions", flag.ContinueOnError)
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	args, err := parseFlags("floating-ips definitions", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("floating-ips definitions", args, 1); err != nil {
+		return err
+	}
+
+	result, err := leaseweb.FloatingIpApi{}.ListRangeDefinitions(args[0], *offset, *limit)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "FLOATING IP", "ANCHOR IP", "LOCATION", "STATUS"}}
+	for _, definition := range result.FloatingIpDefinitions {
+		t.add(definition.Id, definition.FloatingIp, definition.AnchorIp, definition.Location, definition.Status)
+	}
+	return s.print(result, t)
+}
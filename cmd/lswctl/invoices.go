@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func listInvoices(s *session, args []string) error {
+	flags := flag.NewFlagSet("invoices list", flag.ContinueOnError)
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	args, err := parseFlags("invoices list", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("invoices list", args, 0); err != nil {
+		return err
+	}
+
+	result, err := leaseweb.InvoiceApi{}.ListInvoices(*offset, *limit)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "DATE", "DUE DATE", "STATUS", "TOTAL", "OPEN AMOUNT", "CURRENCY"}}
+	for _, invoice := range result.Invoices {
+		t.add(invoice.Id, invoice.Date.DateString(), invoice.DueDate.DateString(), invoice.Status, invoice.Total.Amount(), invoice.OpenAmount.Amount(), invoice.Currency)
+	}
+	return s.print(result, t)
+}
+
+func showInvoice(s *session, args []string) error {
+	if err := requireArgs("invoices show", args, 1); err != nil {
+		return err
+	}
+	invoice, err := leaseweb.InvoiceApi{}.GetInvoice(args[0])
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"CONTRACT", "EQUIPMENT", "PRODUCT", "REFERENCE", "QUANTITY", "UNIT AMOUNT", "TOTAL AMOUNT"}}
+	for _, line := range invoice.Lines {
+		t.add(line.ContractId, line.EquipmentId, line.Product, line.Reference, line.Quantity, line.UnitAmount.Amount(), line.TotalAmount.Amount())
+	}
+	t.add("", "", "", "", "", "TAX", invoice.TaxAmount.Amount())
+	t.add("", "", "", "", "", "TOTAL", invoice.Total.Amount())
+	return s.print(invoice, t)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func nullRouteIp(s *session, args []string) error {
+	flags := flag.NewFlagSet("ips null-route", flag.ContinueOnError)
+	comment := flags.String("comment", "", "")
+	level := flags.Int("level", 0, "")
+	args, err := parseFlags("ips null-route", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("ips null-route", args, 1); err != nil {
+		return err
+	}
+
+	params := map[string]string{}
+	if *comment != "" {
+		params["comment"] = *comment
+	}
+	if *level > 0 {
+		params["nullLevel"] = fmt.Sprint(*level)
+	}
+	nullRoute, err := leaseweb.IpManagementApi{}.NullRouteIp(args[0], params)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "IP", "NULL LEVEL", "NULLED AT", "COMMENT"}}
+	t.add(nullRoute.Id, nullRoute.Ip, nullRoute.NullLevel, nullRoute.NulledAt, nullRoute.Comment)
+	return s.print(nullRoute, t)
+}
+
+func unnullIp(s *session, args []string) error {
+	if err := requireArgs("ips unnull", args, 1); err != nil {
+		return err
+	}
+	if err := (leaseweb.IpManagementApi{}).RemoveNullRouteIp(args[0]); err != nil {
+		return err
+	}
+	return s.done("Null route of %s removed", args[0])
+}
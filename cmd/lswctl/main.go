@@ -0,0 +1,164 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type command struct {
+	usage string
+	run   func(s *session, args []string) error
+}
+
+type session struct {
+	stdout  io.Writer
+	output  string
+	profile *leaseweb.ConfigProfile
+}
+
+var commands = map[string]map[string]command{
+	"servers": {
+		"list":        {usage: "[--offset N] [--limit N] [--site SITE] [--ip IP]", run: listServers},
+		"get":         {usage: "SERVER_ID", run: getServer},
+		"power-cycle": {usage: "SERVER_ID", run: powerCycleServer},
+		"install":     {usage: "SERVER_ID --os OPERATING_SYSTEM_ID [--hostname NAME] [--control-panel ID]", run: installServer},
+	},
+	"ips": {
+		"null-route": {usage: "IP [--comment TEXT] [--level N]", run: nullRouteIp},
+		"unnull":     {usage: "IP", run: unnullIp},
+	},
+	"floating-ips": {
+		"ranges":      {usage: "[--offset N] [--limit N]", run: listFloatingIpRanges},
+		"definitions": {usage: "RANGE_ID [--offset N] [--limit N]", run: listFloatingIpDefinitions},
+	},
+	"invoices": {
+		"list": {usage: "[--offset N] [--limit N]", run: listInvoices},
+		"show": {usage: "INVOICE_ID", run: showInvoice},
+	},
+	"abuse": {
+		"list":    {usage: "[--status OPEN,WAITING] [--offset N] [--limit N]", run: listAbuseReports},
+		"resolve": {usage: "REPORT_ID --resolution ID[,ID...]", run: resolveAbuseReport},
+	},
+	"contacts": {
+		"list": {usage: "[--offset N] [--limit N]", run: listContacts},
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lswctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	profileName := flags.String("profile", "", "name of the profile to use")
+	configPath := flags.String("config", "", "path to the configuration file")
+	output := flags.String("output", "table", "output format: table, json or yaml")
+	flags.Usage = func() { printUsage(stderr) }
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		printUsage(stderr)
+		return 2
+	}
+
+	resource, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "lswctl: unknown command %q\n", args[0])
+		printUsage(stderr)
+		return 2
+	}
+
+	action := "list"
+	if len(args) > 1 && !strings.HasPrefix(args[1], "-") {
+		action = args[1]
+		args = args[2:]
+	} else {
+		args = args[1:]
+	}
+	cmd, ok := resource[action]
+	if !ok {
+		fmt.Fprintf(stderr, "lswctl: unknown command %q\n", strings.TrimSpace(flags.Arg(0)+" "+action))
+		printUsage(stderr)
+		return 2
+	}
+
+	switch *output {
+	case OUTPUT_TABLE, OUTPUT_JSON, OUTPUT_YAML:
+	default:
+		fmt.Fprintf(stderr, "lswctl: unknown output format %q\n", *output)
+		return 2
+	}
+
+	p, err := leaseweb.InitLeasewebClientFromConfig(*configPath, *profileName)
+	if err != nil {
+		fmt.Fprintf(stderr, "lswctl: %v\n", err)
+		return 1
+	}
+
+	s := &session{stdout: stdout, output: *output, profile: p}
+	if err := cmd.run(s, args); err != nil {
+		fmt.Fprintf(stderr, "lswctl: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: lswctl [--profile NAME] [--config PATH] [--output table|json|yaml] COMMAND")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	var resources []string
+	for resource := range commands {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		var actions []string
+		for action := range commands[resource] {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			fmt.Fprintf(w, "  %s %s %s\n", resource, action, commands[resource][action].usage)
+		}
+	}
+}
+
+func parseFlags(name string, flags *flag.FlagSet, args []string) ([]string, error) {
+	flags.SetOutput(io.Discard)
+
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func requireArgs(name string, args []string, count int) error {
+	if len(args) != count {
+		return fmt.Errorf("%s: expected %d argument(s), got %d", name, count, len(args))
+	}
+	return nil
+}
+
+func errRequiredFlag(name, flagName string) error {
+	return fmt.Errorf("%s: --%s is required", name, flagName)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+	"leaseweb-go-sdk/leasewebtest"
+)
+
+const testApiKey = "test-api-key"
+
+func newTestServer(t *testing.T) (*leasewebtest.Server, string) {
+	s := leasewebtest.NewServer()
+	s.ApiKey = testApiKey
+	t.Cleanup(s.Close)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	config := "profiles:\n" +
+		"  default:\n" +
+		"    apiKey: " + testApiKey + "\n" +
+		"    baseUrl: " + s.URL + "\n" +
+		"  other:\n" +
+		"    apiKey: other-api-key\n" +
+		"    baseUrl: " + s.URL + "\n"
+	assert.Nil(t, os.WriteFile(path, []byte(config), 0600))
+	t.Setenv(leaseweb.ENV_API_KEY, "")
+	t.Setenv(leaseweb.ENV_PROFILE, "")
+	return s, path
+}
+
+func runCommand(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestServersListTable(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345", Location: leaseweb.Location{Site: "AMS-01", Rack: "22"}})
+
+	code, stdout, stderr := runCommand("--config", config, "servers", "list")
+	assert := assert.New(t)
+	assert.Equal(code, 0, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(len(lines), 2)
+	assert.True(strings.HasPrefix(lines[0], "ID"))
+	assert.Equal(strings.Fields(lines[1]), []string{"12345", "AMS-01", "22"})
+}
+
+func TestServersListFilters(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345", Location: leaseweb.Location{Site: "AMS-01"}})
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "67890", Location: leaseweb.Location{Site: "FRA-10"}})
+
+	code, stdout, stderr := runCommand("--config", config, "servers", "list", "--site", "FRA-10")
+	assert := assert.New(t)
+	assert.Equal(code, 0, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(len(lines), 2)
+	assert.Equal(strings.Fields(lines[1]), []string{"67890", "FRA-10"})
+}
+
+func TestServersGetJsonAndYaml(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345", AssetId: "627294"})
+
+	assert := assert.New(t)
+	code, stdout, stderr := runCommand("--config", config, "--output", "json", "servers", "get", "12345")
+	assert.Equal(code, 0, stderr)
+	server := leaseweb.DedicatedServer{}
+	assert.Nil(json.Unmarshal([]byte(stdout), &server))
+	assert.Equal(server.AssetId, "627294")
+
+	code, stdout, stderr = runCommand("--config", config, "--output", "yaml", "servers", "get", "12345")
+	assert.Equal(code, 0, stderr)
+	assert.Contains(stdout, "assetId: \"627294\"")
+}
+
+func TestServersPowerCycleAndInstall(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+
+	assert := assert.New(t)
+	code, stdout, _ := runCommand("--config", config, "servers", "power-cycle", "12345")
+	assert.Equal(code, 0)
+	assert.Equal(stdout, "Power cycle of server 12345 requested\n")
+
+	code, _, stderr := runCommand("--config", config, "servers", "install", "12345")
+	assert.Equal(code, 1)
+	assert.Equal(stderr, "lswctl: servers install: --os is required\n")
+
+	code, stdout, stderr = runCommand("--config", config, "servers", "install", "12345", "--os", "UBUNTU_22_04_64BIT")
+	assert.Equal(code, 0, stderr)
+	assert.Contains(stdout, "ACTIVE")
+}
+
+func TestIpsNullRouteAndUnnull(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.10"})
+
+	assert := assert.New(t)
+	code, stdout, stderr := runCommand("--config", config, "ips", "null-route", "192.0.2.10", "--comment", "abuse", "--level", "2")
+	assert.Equal(code, 0, stderr)
+	assert.Contains(stdout, "abuse")
+
+	code, stdout, _ = runCommand("--config", config, "--output", "json", "ips", "unnull", "192.0.2.10")
+	assert.Equal(code, 0)
+	assert.JSONEq(stdout, `{"result": "Null route of 192.0.2.10 removed"}`)
+}
+
+func TestProfiles(t *testing.T) {
+	s, config := newTestServer(t)
+	s.AddAbuseReport(leaseweb.AbuseReport{Id: "000001", Subject: "Spam"}, nil, nil)
+
+	assert := assert.New(t)
+	code, _, stderr := runCommand("--config", config, "--profile", "other", "abuse", "list")
+	assert.Equal(code, 1)
+	assert.Equal(stderr, "lswctl: You are not authorized to view this resource.\n")
+
+	code, _, stderr = runCommand("--config", config, "--profile", "missing", "abuse", "list")
+	assert.Equal(code, 1)
+	assert.Contains(stderr, `profile "missing" not found`)
+
+	t.Setenv(leaseweb.ENV_API_KEY, "other-api-key")
+	code, _, stderr = runCommand("--config", config, "abuse", "list")
+	assert.Equal(code, 1)
+	assert.Equal(stderr, "lswctl: You are not authorized to view this resource.\n")
+
+	code, stdout, stderr := runCommand("--config", config, "--profile", "default", "abuse")
+	assert.Equal(code, 0, stderr)
+	assert.Contains(stdout, "Spam")
+}
+
+func TestUnknownCommand(t *testing.T) {
+	_, config := newTestServer(t)
+
+	code, _, stderr := runCommand("--config", config, "servers", "explode")
+	assert.Equal(t, code, 2)
+	assert.Contains(t, stderr, `unknown command "servers explode"`)
+}
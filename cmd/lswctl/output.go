@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OUTPUT_TABLE = "table"
+	OUTPUT_JSON  = "json"
+	OUTPUT_YAML  = "yaml"
+)
+
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+func (t *table) add(values ...interface{}) {
+	row := make([]string, len(values))
+	for i, value := range values {
+		row[i] = fmt.Sprint(value)
+	}
+	t.rows = append(t.rows, row)
+}
+
+func (s *session) print(data interface{}, t *table) error {
+	switch s.output {
+	case OUTPUT_JSON:
+		b, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(s.stdout, string(b))
+		return err
+	case OUTPUT_YAML:
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		var generic interface{}
+		if err = json.Unmarshal(b, &generic); err != nil {
+			return err
+		}
+		b, err = yaml.Marshal(generic)
+		if err != nil {
+			return err
+		}
+		_, err = s.stdout.Write(b)
+		return err
+	}
+
+	w := tabwriter.NewWriter(s.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+func (s *session) done(message string, args ...interface{}) error {
+	if s.output != OUTPUT_TABLE {
+		return s.print(map[string]string{"result": fmt.Sprintf(message, args...)}, nil)
+	}
+	_, err := fmt.Fprintf(s.stdout, message+"\n", args...)
+	return err
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func listServers(s *session, args []string) error {
+	flags := flag.NewFlagSet("servers list", flag.ContinueOnError)
+	offset := flags.Int("offset", 0, "")
+	limit := flags.Int("limit", 50, "")
+	site := flags.String("site", "", "")
+	ip := flags.String("ip", "", "")
+	args, err := parseFlags("servers list", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("servers list", args, 0); err != nil {
+		return err
+	}
+
+	filters := []interface{}{*offset, *limit}
+	if *ip != "" || *site != "" {
+		filters = append(filters, *ip, "", *site)
+	}
+	result, err := leaseweb.DedicatedServerApi{}.List(filters...)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"ID", "REFERENCE", "SITE", "RACK", "PUBLIC IP", "DELIVERY STATUS"}}
+	for _, server := range result.Servers {
+		t.add(server.Id, server.Contract.Reference, server.Location.Site, server.Location.Rack, server.NetworkInterfaces.Public.Ip, server.Contract.DeliveryStatus)
+	}
+	return s.print(result, t)
+}
+
+func getServer(s *session, args []string) error {
+	if err := requireArgs("servers get", args, 1); err != nil {
+		return err
+	}
+	server, err := leaseweb.DedicatedServerApi{}.Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"FIELD", "VALUE"}}
+	t.add("ID", server.Id)
+	t.add("ASSET ID", server.AssetId)
+	t.add("SERIAL NUMBER", server.SerialNumber)
+	t.add("REFERENCE", server.Contract.Reference)
+	t.add("SITE", server.Location.Site)
+	t.add("SUITE", server.Location.Suite)
+	t.add("RACK", server.Location.Rack)
+	t.add("UNIT", server.Location.Unit)
+	t.add("PUBLIC IP", server.NetworkInterfaces.Public.Ip)
+	t.add("REMOTE MANAGEMENT IP", server.NetworkInterfaces.RemoteManagement.Ip)
+	t.add("CPU", server.Specs.Cpu.Type)
+	t.add("RAM", fmt.Sprintf("%d %s", server.Specs.Ram.Size, server.Specs.Ram.Unit))
+	t.add("CONTRACT", server.Contract.Id)
+	return s.print(server, t)
+}
+
+func powerCycleServer(s *session, args []string) error {
+	if err := requireArgs("servers power-cycle", args, 1); err != nil {
+		return err
+	}
+	if err := (leaseweb.DedicatedServerApi{}).PowerCycleServer(args[0]); err != nil {
+		return err
+	}
+	return s.done("Power cycle of server %s requested", args[0])
+}
+
+func installServer(s *session, args []string) error {
+	flags := flag.NewFlagSet("servers install", flag.ContinueOnError)
+	operatingSystemId := flags.String("os", "", "")
+	hostname := flags.String("hostname", "", "")
+	controlPanelId := flags.String("control-panel", "", "")
+	args, err := parseFlags("servers install", flags, args)
+	if err != nil {
+		return err
+	}
+	if err = requireArgs("servers install", args, 1); err != nil {
+		return err
+	}
+	if *operatingSystemId == "" {
+		return errRequiredFlag("servers install", "os")
+	}
+
+	payload := map[string]interface{}{"operatingSystemId": *operatingSystemId}
+	if *hostname != "" {
+		payload["hostname"] = *hostname
+	}
+	if *controlPanelId != "" {
+		payload["controlPanelId"] = *controlPanelId
+	}
+	job, err := leaseweb.DedicatedServerApi{}.LunchInstallation(args[0], payload)
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"JOB", "TYPE", "STATUS", "SERVER"}}
+	t.add(job.Uuid, job.Type, job.Status, job.ServerId)
+	return s.print(job, t)
+}
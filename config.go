@@ -0,0 +1,184 @@
+package leaseweb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DEFAULT_PROFILE     = "default"
+	ENV_API_KEY         = "LEASEWEB_API_KEY"
+	ENV_PROFILE         = "LEASEWEB_PROFILE"
+	ENV_CONFIG_FILE     = "LEASEWEB_CONFIG_FILE"
+	DEFAULT_RETRY_DELAY = 500 * time.Millisecond
+)
+
+type Config struct {
+	Path     string                   `yaml:"-"`
+	Profiles map[string]ConfigProfile `yaml:"profiles"`
+}
+
+type ConfigProfile struct {
+	Name          string        `yaml:"-"`
+	ApiKey        string        `yaml:"apiKey"`
+	ApiKeyFile    string        `yaml:"apiKeyFile"`
+	ApiKeyCommand string        `yaml:"apiKeyCommand"`
+	BaseUrl       string        `yaml:"baseUrl"`
+	Timeout       time.Duration `yaml:"timeout"`
+	Retry         RetryConfig   `yaml:"retry"`
+}
+
+type RetryConfig struct {
+	MaxRetries int           `yaml:"maxRetries"`
+	Delay      time.Duration `yaml:"delay"`
+	MaxDelay   time.Duration `yaml:"maxDelay"`
+}
+
+func DefaultConfigPath() string {
+	if path := os.Getenv(ENV_CONFIG_FILE); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "leaseweb", "config.yaml")
+}
+
+func LoadConfig(path string) (*Config, error) {
+	explicitPath := path != ""
+	if !explicitPath {
+		path = DefaultConfigPath()
+	}
+
+	config := &Config{Path: path, Profiles: map[string]ConfigProfile{}}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicitPath {
+			return config, nil
+		}
+		return nil, err
+	}
+	if err = yaml.Unmarshal(b, config); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if config.Profiles == nil {
+		config.Profiles = map[string]ConfigProfile{}
+	}
+	return config, nil
+}
+
+func (c *Config) Profile(name string) (*ConfigProfile, error) {
+	if name == "" {
+		name = os.Getenv(ENV_PROFILE)
+	}
+	explicitProfile := name != ""
+	if !explicitProfile {
+		name = DEFAULT_PROFILE
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok && explicitProfile {
+		return nil, fmt.Errorf("profile %q not found in %s", name, c.Path)
+	}
+	profile.Name = name
+
+	apiKey := os.Getenv(ENV_API_KEY)
+	if apiKey == "" || explicitProfile {
+		var err error
+		if apiKey, err = profile.ResolveApiKey(); err != nil {
+			return nil, err
+		}
+	}
+	profile.ApiKey = apiKey
+	profile.ApiKeyFile = ""
+	profile.ApiKeyCommand = ""
+	return &profile, nil
+}
+
+func (cp *ConfigProfile) ResolveApiKey() (string, error) {
+	if cp.ApiKey != "" {
+		return cp.ApiKey, nil
+	}
+	if cp.ApiKeyFile != "" {
+		b, err := os.ReadFile(expandHome(cp.ApiKeyFile))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	if cp.ApiKeyCommand != "" {
+		var stderr bytes.Buffer
+		cmd := exec.Command("sh", "-c", cp.ApiKeyCommand)
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("apiKeyCommand of profile %q failed: %v: %s", cp.Name, err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	return "", fmt.Errorf("no API key configured for profile %q, set %s or apiKey, apiKeyFile or apiKeyCommand", cp.Name, ENV_API_KEY)
+}
+
+// InitLeasewebClientFromProfile resolves the API key of the profile and sets
+// up the client with its base URL, timeout and retry settings.
+func InitLeasewebClientFromProfile(profile *ConfigProfile) error {
+	apiKey, err := profile.ResolveApiKey()
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: profile.Timeout}
+	if profile.Retry.MaxRetries > 0 {
+		client.Transport = &retryTransport{
+			transport:  http.DefaultTransport,
+			maxRetries: profile.Retry.MaxRetries,
+			delay:      profile.Retry.Delay,
+			maxDelay:   profile.Retry.MaxDelay,
+		}
+	}
+
+	lswClient = &leasewebClient{
+		client:  client,
+		apiKey:  apiKey,
+		baseUrl: strings.TrimSuffix(profile.BaseUrl, "/"),
+	}
+	return nil
+}
+
+// InitLeasewebClientFromConfig loads the config file at path, or the default
+// one when path is empty, and initializes the client from the named profile.
+func InitLeasewebClientFromConfig(path, profileName string) (*ConfigProfile, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := config.Profile(profileName)
+	if err != nil {
+		return nil, err
+	}
+	if err = InitLeasewebClientFromProfile(profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,185 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfig = `
+profiles:
+  default:
+    apiKey: default-api-key
+  production:
+    apiKeyFile: %s
+    baseUrl: https://api.example.com/
+    timeout: 30s
+    retry:
+      maxRetries: 3
+      delay: 1s
+      maxDelay: 10s
+  vault:
+    apiKeyCommand: echo command-api-key
+  broken:
+    apiKeyCommand: exit 3
+`
+
+func writeTestConfig(t *testing.T) string {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "api-key")
+	assert.Nil(t, os.WriteFile(keyFile, []byte("file-api-key\n"), 0600))
+
+	path := filepath.Join(dir, "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(fmt.Sprintf(testConfig, keyFile)), 0600))
+	t.Setenv(ENV_API_KEY, "")
+	t.Setenv(ENV_PROFILE, "")
+	t.Setenv(ENV_CONFIG_FILE, "")
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig(writeTestConfig(t))
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(config.Profiles), 4)
+
+	production := config.Profiles["production"]
+	assert.Equal(production.BaseUrl, "https://api.example.com/")
+	assert.Equal(production.Timeout, 30*time.Second)
+	assert.Equal(production.Retry, RetryConfig{MaxRetries: 3, Delay: time.Second, MaxDelay: 10 * time.Second})
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	t.Setenv(ENV_CONFIG_FILE, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	assert := assert.New(t)
+	config, err := LoadConfig("")
+	assert.Nil(err)
+	assert.Empty(config.Profiles)
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestDefaultConfigPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(ENV_CONFIG_FILE, "")
+	assert.Equal(t, DefaultConfigPath(), filepath.Join(home, ".config", "leaseweb", "config.yaml"))
+
+	t.Setenv(ENV_CONFIG_FILE, "/etc/leaseweb.yaml")
+	assert.Equal(t, DefaultConfigPath(), "/etc/leaseweb.yaml")
+}
+
+func TestConfigProfilePrecedence(t *testing.T) {
+	config, err := LoadConfig(writeTestConfig(t))
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	profile, err := config.Profile("")
+	assert.Nil(err)
+	assert.Equal(profile.Name, DEFAULT_PROFILE)
+	assert.Equal(profile.ApiKey, "default-api-key")
+
+	t.Setenv(ENV_PROFILE, "production")
+	profile, err = config.Profile("")
+	assert.Nil(err)
+	assert.Equal(profile.Name, "production")
+	assert.Equal(profile.ApiKey, "file-api-key")
+
+	profile, err = config.Profile("vault")
+	assert.Nil(err)
+	assert.Equal(profile.ApiKey, "command-api-key")
+
+	t.Setenv(ENV_API_KEY, "env-api-key")
+	profile, err = config.Profile("vault")
+	assert.Nil(err)
+	assert.Equal(profile.ApiKey, "command-api-key")
+
+	profile, err = config.Profile("")
+	assert.Nil(err)
+	assert.Equal(profile.Name, "production")
+	assert.Equal(profile.ApiKey, "file-api-key")
+
+	t.Setenv(ENV_PROFILE, "")
+	profile, err = config.Profile("")
+	assert.Nil(err)
+	assert.Equal(profile.Name, DEFAULT_PROFILE)
+	assert.Equal(profile.ApiKey, "env-api-key")
+
+	_, err = config.Profile("missing")
+	assert.Equal(err.Error(), fmt.Sprintf("profile \"missing\" not found in %s", config.Path))
+}
+
+func TestConfigProfileApiKeyErrors(t *testing.T) {
+	config, err := LoadConfig(writeTestConfig(t))
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	_, err = config.Profile("broken")
+	assert.Contains(err.Error(), "apiKeyCommand of profile \"broken\" failed")
+
+	config.Profiles = map[string]ConfigProfile{}
+	_, err = config.Profile("")
+	assert.Equal(err.Error(), "no API key configured for profile \"default\", set LEASEWEB_API_KEY or apiKey, apiKeyFile or apiKeyCommand")
+}
+
+func TestInitLeasewebClientFromConfig(t *testing.T) {
+	path := writeTestConfig(t)
+	oldClient := lswClient
+	defer func() { lswClient = oldClient }()
+
+	assert := assert.New(t)
+	profile, err := InitLeasewebClientFromConfig(path, "production")
+	assert.Nil(err)
+	assert.Equal(profile.Name, "production")
+	assert.Equal(lswClient.apiKey, "file-api-key")
+	assert.Equal(getBaseUrl(), "https://api.example.com")
+	assert.Equal(lswClient.client.Timeout, 30*time.Second)
+	transport, ok := lswClient.client.Transport.(*retryTransport)
+	assert.True(ok)
+	assert.Equal(transport.maxRetries, 3)
+
+	_, err = InitLeasewebClientFromConfig(path, "default")
+	assert.Nil(err)
+	assert.Equal(getBaseUrl(), DEFAULT_BASE_URL)
+	assert.Nil(lswClient.client.Transport)
+
+	_, err = InitLeasewebClientFromConfig(path, "missing")
+	assert.NotNil(err)
+	assert.Equal(lswClient.apiKey, "default-api-key")
+}
+
+func TestInitLeasewebClientFromProfileRetries(t *testing.T) {
+	oldClient := lswClient
+	defer func() { lswClient = oldClient }()
+
+	attempts := 0
+	ts := setup(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, `{"errorCode": "TEMPORARILY_UNAVAILABLE", "errorMessage": "The server is currently unable to handle the request."}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id": "00000001"}`)
+	})
+	defer teardown()
+
+	assert := assert.New(t)
+	profile := &ConfigProfile{ApiKey: testApiKey, BaseUrl: ts.URL, Retry: RetryConfig{MaxRetries: 2, Delay: time.Millisecond}}
+	t.Setenv(ENV_API_KEY, "")
+	assert.Nil(InitLeasewebClientFromProfile(profile))
+	lswClient.client.Transport.(*retryTransport).transport = ts.Client().Transport
+
+	invoice, err := InvoiceApi{}.GetInvoice("00000001")
+	assert.Nil(err)
+	assert.Equal(invoice.Id, "00000001")
+	assert.Equal(attempts, 3)
+}
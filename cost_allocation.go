@@ -0,0 +1,235 @@
+package leaseweb
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	COST_ALLOCATION_UNALLOCATED = "unallocated"
+	COST_ALLOCATION_PAGE_LIMIT  = 50
+	COST_ALLOCATION_MONTH       = "2006-01"
+)
+
+type CostAllocationOptions struct {
+	Invoices         InvoiceService
+	Services         ServicesService
+	DedicatedServers DedicatedServerService
+	From             time.Time
+	To               time.Time
+	Tag              func(reference string) string
+}
+
+type CostAllocationReport struct {
+	Lines []CostAllocationLine
+}
+
+type CostAllocationLine struct {
+	Month    string
+	Team     string
+	Currency string
+	Amount   Money
+	Items    []CostAllocationItem
+}
+
+type CostAllocationItem struct {
+	InvoiceId   string
+	ContractId  string
+	EquipmentId string
+	Product     string
+	Reference   string
+	Amount      Money
+}
+
+func TagFromReference(key string) func(reference string) string {
+	return func(reference string) string {
+		for _, token := range strings.FieldsFunc(reference, func(r rune) bool { return r == ',' || r == ';' || r == ' ' }) {
+			separator := strings.IndexAny(token, "=:")
+			if separator > 0 && strings.EqualFold(token[:separator], key) {
+				return token[separator+1:]
+			}
+		}
+		return ""
+	}
+}
+
+func AllocateCosts(options CostAllocationOptions) (*CostAllocationReport, error) {
+	if options.Invoices == nil {
+		options.Invoices = InvoiceApi{}
+	}
+	if options.Services == nil {
+		options.Services = ServicesApi{}
+	}
+	if options.DedicatedServers == nil {
+		options.DedicatedServers = DedicatedServerApi{}
+	}
+	if options.Tag == nil {
+		options.Tag = strings.TrimSpace
+	}
+
+	references, err := costAllocationReferences(options.Services, options.DedicatedServers)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := listAllInvoices(options.Invoices)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := map[string]*CostAllocationLine{}
+	allocate := func(month string, team string, currency string, item CostAllocationItem) error {
+		if team == "" {
+			team = COST_ALLOCATION_UNALLOCATED
+		}
+		key := month + "\x00" + team + "\x00" + currency
+		line, ok := lines[key]
+		if !ok {
+			line = &CostAllocationLine{Month: month, Team: team, Currency: currency, Amount: Money{Currency: currency}}
+			lines[key] = line
+		}
+		amount, err := line.Amount.Add(item.Amount)
+		if err != nil {
+			return err
+		}
+		line.Amount = amount
+		line.Items = append(line.Items, item)
+		return nil
+	}
+
+	for _, listed := range invoices {
+		date := invoiceDay(listed.Date)
+		if (!options.From.IsZero() && date.Before(options.From)) || (!options.To.IsZero() && !date.Before(options.To)) {
+			continue
+		}
+		invoice, err := options.Invoices.GetInvoice(listed.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		month := date.Format(COST_ALLOCATION_MONTH)
+		remainder, err := invoice.Total.Sub(invoice.TaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		for _, invoiceLine := range invoice.Lines {
+			reference := references.lookup(invoiceLine)
+			item := CostAllocationItem{
+				InvoiceId:   invoice.Id,
+				ContractId:  invoiceLine.ContractId,
+				EquipmentId: invoiceLine.EquipmentId,
+				Product:     invoiceLine.Product,
+				Reference:   reference,
+				Amount:      invoiceLine.TotalAmount,
+			}
+			if err = allocate(month, options.Tag(reference), invoice.Currency, item); err != nil {
+				return nil, err
+			}
+			if remainder, err = remainder.Sub(item.Amount); err != nil {
+				return nil, err
+			}
+		}
+
+		if !remainder.IsZero() {
+			item := CostAllocationItem{InvoiceId: invoice.Id, Product: "Remainder", Amount: remainder}
+			if err = allocate(month, "", invoice.Currency, item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	report := &CostAllocationReport{}
+	for _, line := range lines {
+		report.Lines = append(report.Lines, *line)
+	}
+	sort.Slice(report.Lines, func(i, j int) bool {
+		a, b := report.Lines[i], report.Lines[j]
+		if a.Month != b.Month {
+			return a.Month < b.Month
+		}
+		if (a.Team == COST_ALLOCATION_UNALLOCATED) != (b.Team == COST_ALLOCATION_UNALLOCATED) {
+			return b.Team == COST_ALLOCATION_UNALLOCATED
+		}
+		if a.Team != b.Team {
+			return a.Team < b.Team
+		}
+		return a.Currency < b.Currency
+	})
+	return report, nil
+}
+
+func (car *CostAllocationReport) WriteCsv(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"month", "team", "currency", "amount", "items"}); err != nil {
+		return err
+	}
+	for _, line := range car.Lines {
+		record := []string{line.Month, line.Team, line.Currency, line.Amount.Format(2), strconv.Itoa(len(line.Items))}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type costAllocationReferenceIndex struct {
+	equipment map[string]string
+	contracts map[string]string
+}
+
+func (cari costAllocationReferenceIndex) lookup(line Line) string {
+	if reference := cari.equipment[line.EquipmentId]; line.EquipmentId != "" && reference != "" {
+		return reference
+	}
+	if reference := cari.contracts[line.ContractId]; line.ContractId != "" && reference != "" {
+		return reference
+	}
+	return line.Reference
+}
+
+func costAllocationReferences(services ServicesService, dedicatedServers DedicatedServerService) (costAllocationReferenceIndex, error) {
+	index := costAllocationReferenceIndex{equipment: map[string]string{}, contracts: map[string]string{}}
+
+	err := forEachPage(COST_ALLOCATION_PAGE_LIMIT, func(offset, limit int) ([]DedicatedServer, Metadata, error) {
+		servers, err := dedicatedServers.List(offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return servers.Servers, servers.Metadata, nil
+	}, func(servers []DedicatedServer) bool {
+		for _, server := range servers {
+			if server.Contract.Reference != "" {
+				index.equipment[server.Id] = server.Contract.Reference
+				index.contracts[server.Contract.Id] = server.Contract.Reference
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return index, err
+	}
+
+	err = forEachPage(COST_ALLOCATION_PAGE_LIMIT, func(offset, limit int) ([]Service, Metadata, error) {
+		result, err := services.ListServices(offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return result.Services, result.Metadata, nil
+	}, func(result []Service) bool {
+		for _, service := range result {
+			if service.Reference == "" {
+				continue
+			}
+			index.contracts[service.ContractId] = service.Reference
+			if _, ok := index.equipment[service.EquipmentId]; service.EquipmentId != "" && !ok {
+				index.equipment[service.EquipmentId] = service.Reference
+			}
+		}
+		return true
+	})
+	return index, err
+}
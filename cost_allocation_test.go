@@ -0,0 +1,134 @@
+package leaseweb
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateCosts(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /bareMetals/v2/servers": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "servers": [
+			{"id": "12345", "contract": {"id": "1001", "reference": "team=web env=prod"}},
+			{"id": "67890", "contract": {"id": "1002", "reference": ""}}
+		]}`),
+		"GET /services/v1/services": respondWith(`{"metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "services": [
+			{"id": "s1", "contractId": "2001", "equipmentId": "", "reference": "team=data"},
+			{"id": "s2", "contractId": "2002", "equipmentId": "67890", "reference": "team:db"}
+		]}`),
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01T00:00:00+00:00"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "currency": "EUR", "total": 205, "taxAmount": 35, "lineItems": [
+			{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "totalAmount": 100},
+			{"contractId": "2001", "product": "Object Storage", "totalAmount": 30},
+			{"contractId": "3001", "product": "Support", "reference": "team=web", "totalAmount": 25},
+			{"contractId": "3002", "product": "Bandwidth", "totalAmount": 10}
+		]}`),
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01", "currency": "EUR", "total": 60, "taxAmount": 10, "lineItems": [
+			{"contractId": "1002", "equipmentId": "67890", "product": "Dedicated Server", "totalAmount": 50}
+		]}`),
+	}))
+	defer teardown()
+
+	report, err := AllocateCosts(CostAllocationOptions{Tag: TagFromReference("team")})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(report.Lines), 4)
+
+	expected := []struct {
+		month, team string
+		amount      string
+		items       int
+	}{
+		{"2023-01", "data", "30", 1},
+		{"2023-01", "web", "125", 2},
+		{"2023-01", COST_ALLOCATION_UNALLOCATED, "15", 2},
+		{"2023-02", "db", "50", 1},
+	}
+	for i, e := range expected {
+		assert.Equal(report.Lines[i].Month, e.month)
+		assert.Equal(report.Lines[i].Team, e.team)
+		assert.Equal(report.Lines[i].Currency, "EUR")
+		assert.Equal(report.Lines[i].Amount, MustMoney(e.amount, "EUR"))
+		assert.Equal(len(report.Lines[i].Items), e.items)
+	}
+
+	unallocated := report.Lines[2]
+	assert.Equal(unallocated.Items[0].Product, "Bandwidth")
+	assert.Equal(unallocated.Items[1].Product, "Remainder")
+	assert.Equal(unallocated.Items[1].Amount, MustMoney("5", "EUR"))
+}
+
+func TestAllocateCostsByReference(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /bareMetals/v2/servers": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "servers": [
+			{"id": "12345", "contract": {"id": "1001", "reference": "team=web env=prod"}},
+			{"id": "67890", "contract": {"id": "1002", "reference": ""}}
+		]}`),
+		"GET /services/v1/services": respondWith(`{"metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "services": [
+			{"id": "s1", "contractId": "2001", "equipmentId": "", "reference": "team=data"},
+			{"id": "s2", "contractId": "2002", "equipmentId": "67890", "reference": "team:db"}
+		]}`),
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 2}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01T00:00:00+00:00"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "currency": "EUR", "total": 205, "taxAmount": 35, "lineItems": [
+			{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "totalAmount": 100},
+			{"contractId": "2001", "product": "Object Storage", "totalAmount": 30},
+			{"contractId": "3001", "product": "Support", "reference": "team=web", "totalAmount": 25},
+			{"contractId": "3002", "product": "Bandwidth", "totalAmount": 10}
+		]}`),
+	}))
+	defer teardown()
+
+	report, err := AllocateCosts(CostAllocationOptions{From: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	var out bytes.Buffer
+	assert.Nil(report.WriteCsv(&out))
+	assert.Equal(out.String(), "month,team,currency,amount,items\n"+
+		"2023-01,team=data,EUR,30.00,1\n"+
+		"2023-01,team=web,EUR,25.00,1\n"+
+		"2023-01,team=web env=prod,EUR,100.00,1\n"+
+		"2023-01,unallocated,EUR,15.00,2\n")
+}
+
+func TestTagFromReference(t *testing.T) {
+	tag := TagFromReference("team")
+	assert := assert.New(t)
+	assert.Equal(tag("team=web env=prod"), "web")
+	assert.Equal(tag("env:prod;Team:db"), "db")
+	assert.Equal(tag("web-01"), "")
+	assert.Equal(tag(""), "")
+}
+
+func TestAllocateCostsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return AllocateCosts(CostAllocationOptions{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
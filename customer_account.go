@@ -111,7 +111,7 @@ func (cai CustomerAccountApi) DeleteContact(contactId string) error {
 }
 
 func (cai CustomerAccountApi) GetContact(contactId string) (*Contact, error) {
-	path := cai.getPath("/contacts" + contactId)
+	path := cai.getPath("/contacts/" + contactId)
 	result := &Contact{}
 	if err := doRequest(http.MethodGet, path, result); err != nil {
 		return nil, err
@@ -130,12 +130,12 @@ func (cai CustomerAccountApi) UpdateContact(contactId string, phone Phone, roles
 		payload["description"] = fmt.Sprint(args[1])
 	}
 
-	path := cai.getPath("/contacts" + contactId)
+	path := cai.getPath("/contacts/" + contactId)
 	return doRequest(http.MethodPut, path, nil, payload)
 }
 
 func (cai CustomerAccountApi) AssignPrimaryRolesToContact(contactId string, roles []string) error {
 	payload := map[string][]string{"roles": roles}
-	path := cai.getPath("/contacts" + contactId)
+	path := cai.getPath("/contacts/" + contactId)
 	return doRequest(http.MethodPost, path, nil, payload)
 }
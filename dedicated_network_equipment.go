@@ -0,0 +1,263 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const DEDICATED_NETWORK_EQUIPMENT_API_VERSION = "v2"
+
+type NetworkEquipmentApi struct{}
+
+type NetworkEquipments struct {
+	NetworkEquipments []NetworkEquipment `json:"networkEquipments"`
+	Metadata          Metadata           `json:"_metadata"`
+}
+
+type NetworkEquipment struct {
+	Contract            DedicatedServerContract `json:"contract"`
+	FeatureAvailability FeatureAvailability     `json:"featureAvailability"`
+	Id                  string                  `json:"id"`
+	Location            Location                `json:"location"`
+	Name                string                  `json:"name"`
+	NetworkInterfaces   NetworkInterfaces       `json:"networkInterfaces"`
+	PowerPorts          []Port                  `json:"powerPorts"`
+	Rack                Rack                    `json:"rack"`
+	SerialNumber        string                  `json:"serialNumber"`
+	Specs               NetworkEquipmentSpecs   `json:"specs"`
+	Type                string                  `json:"type"`
+}
+
+type NetworkEquipmentSpecs struct {
+	Brand string `json:"brand"`
+	Model string `json:"model"`
+}
+
+func (nea NetworkEquipmentApi) getPath(endpoint string) string {
+	return "/bareMetals/" + DEDICATED_NETWORK_EQUIPMENT_API_VERSION + endpoint
+}
+
+func (nea NetworkEquipmentApi) List(args ...interface{}) (*NetworkEquipments, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+	if len(args) >= 3 {
+		v.Add("reference", fmt.Sprint(args[2]))
+	}
+	if len(args) >= 4 {
+		v.Add("ip", fmt.Sprint(args[3]))
+	}
+	if len(args) >= 5 {
+		v.Add("macAddress", fmt.Sprint(args[4]))
+	}
+	if len(args) >= 6 {
+		v.Add("site", fmt.Sprint(args[5]))
+	}
+	if len(args) >= 7 {
+		v.Add("privateRackId", fmt.Sprint(args[6]))
+	}
+
+	path := nea.getPath("/networkEquipments?" + v.Encode())
+	result := &NetworkEquipments{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) Get(networkEquipmentId string) (*NetworkEquipment, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId)
+	result := &NetworkEquipment{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) Update(networkEquipmentId string, payload map[string]interface{}) error {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId)
+	return doRequest(http.MethodPut, path, nil, payload)
+}
+
+func (nea NetworkEquipmentApi) ListIps(networkEquipmentId string, args ...interface{}) (*DedicatedServerIps, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+	if len(args) >= 3 {
+		v.Add("networkType", fmt.Sprint(args[2]))
+	}
+	if len(args) >= 4 {
+		v.Add("version", fmt.Sprint(args[3]))
+	}
+	if len(args) >= 5 {
+		v.Add("nullRouted", fmt.Sprint(args[4]))
+	}
+	if len(args) >= 6 {
+		v.Add("ips", fmt.Sprint(args[5]))
+	}
+
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/ips?" + v.Encode())
+	result := &DedicatedServerIps{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) GetIp(networkEquipmentId, ip string) (*DedicatedServerIp, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/ips/" + ip)
+	result := &DedicatedServerIp{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) UpdateIp(networkEquipmentId, ip string, payload map[string]string) (*DedicatedServerIp, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/ips/" + ip)
+	result := &DedicatedServerIp{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) NullRouteAnIp(networkEquipmentId, ip string) (*DedicatedServerIp, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/ips/" + ip + "/null")
+	result := &DedicatedServerIp{}
+	if err := doRequest(http.MethodPost, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) RemoveNullRouteAnIp(networkEquipmentId, ip string) (*DedicatedServerIp, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/ips/" + ip + "/unnull")
+	result := &DedicatedServerIp{}
+	if err := doRequest(http.MethodPost, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) ListNullRouteHistory(networkEquipmentId string, args ...int) (*DedicatedServerNullRoutes, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/nullRouteHistory?" + v.Encode())
+	result := &DedicatedServerNullRoutes{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) ListCredentials(networkEquipmentId string, args ...int) (*DedicatedServerCredentials, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials?" + v.Encode())
+	result := &DedicatedServerCredentials{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) CreateCredential(networkEquipmentId, credentialType, username, password string) (*DedicatedServerCredential, error) {
+	payload := map[string]string{
+		"type":     credentialType,
+		"username": username,
+		"password": password,
+	}
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials")
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodPost, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) ListCredentialsByType(networkEquipmentId, credentialType string, args ...int) (*DedicatedServerCredentials, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials/" + credentialType + "?" + v.Encode())
+	result := &DedicatedServerCredentials{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) GetCredential(networkEquipmentId, credentialType, username string) (*DedicatedServerCredential, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials/" + credentialType + "/" + username)
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) DeleteCredential(networkEquipmentId, credentialType, username string) error {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials/" + credentialType + "/" + username)
+	return doRequest(http.MethodDelete, path)
+}
+
+func (nea NetworkEquipmentApi) UpdateCredential(networkEquipmentId, credentialType, username, password string) (*DedicatedServerCredential, error) {
+	payload := map[string]string{"password": password}
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/credentials/" + credentialType + "/" + username)
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) PowerCycleNetworkEquipment(networkEquipmentId string) error {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/powerCycle")
+	return doRequest(http.MethodPost, path)
+}
+
+func (nea NetworkEquipmentApi) GetPowerStatus(networkEquipmentId string) (*DedicatedServerPowerStatus, error) {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/powerInfo")
+	result := &DedicatedServerPowerStatus{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (nea NetworkEquipmentApi) PowerOffNetworkEquipment(networkEquipmentId string) error {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/powerOff")
+	return doRequest(http.MethodPost, path)
+}
+
+func (nea NetworkEquipmentApi) PowerOnNetworkEquipment(networkEquipmentId string) error {
+	path := nea.getPath("/networkEquipments/" + networkEquipmentId + "/powerOn")
+	return doRequest(http.MethodPost, path)
+}
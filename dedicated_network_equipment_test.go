@@ -0,0 +1,2385 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkEquipmentList(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"networkEquipments": [
+				{
+					"contract": {
+						"id": "674382",
+						"customerId": "32923828192",
+						"deliveryStatus": "ACTIVE",
+						"reference": "core switch",
+						"salesOrgId": "2000"
+					},
+					"featureAvailability": {
+						"automation": false,
+						"ipmiReboot": false,
+						"powerCycle": true,
+						"privateNetwork": false,
+						"remoteManagement": false
+					},
+					"id": "12345",
+					"location": {
+						"rack": "13",
+						"site": "AMS-01",
+						"suite": "A6",
+						"unit": "16-17"
+					},
+					"name": "Network Equipment 1",
+					"networkInterfaces": {
+						"public": {
+							"gateway": "95.211.162.62",
+							"ip": "95.211.162.0/27",
+							"mac": "AA:BB:CC:DD:EE:FF",
+							"ports": [
+								{
+									"name": "EVO-AABB-01",
+									"port": "33"
+								}
+							]
+						}
+					},
+					"powerPorts": [
+						{
+							"name": "EVO-JV12-APC02",
+							"port": "10"
+						}
+					],
+					"rack": {
+						"type": "DEDICATED"
+					},
+					"serialNumber": "JDK18291JK",
+					"specs": {
+						"brand": "Juniper",
+						"model": "EX3400-48T"
+					},
+					"type": "SWITCH"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.List()
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 1)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.NetworkEquipments), 1)
+
+	networkEquipment := response.NetworkEquipments[0]
+	assert.Equal(networkEquipment.Contract.Id, "674382")
+	assert.Equal(networkEquipment.Contract.CustomerId, "32923828192")
+	assert.Equal(networkEquipment.Contract.DeliveryStatus, "ACTIVE")
+	assert.Equal(networkEquipment.Contract.Reference, "core switch")
+	assert.Equal(networkEquipment.Contract.SalesOrgId, "2000")
+	assert.True(networkEquipment.FeatureAvailability.PowerCycle)
+	assert.False(networkEquipment.FeatureAvailability.RemoteManagement)
+	assert.Equal(networkEquipment.Id, "12345")
+	assert.Equal(networkEquipment.Location.Rack, "13")
+	assert.Equal(networkEquipment.Location.Site, "AMS-01")
+	assert.Equal(networkEquipment.Location.Suite, "A6")
+	assert.Equal(networkEquipment.Location.Unit, "16-17")
+	assert.Equal(networkEquipment.Name, "Network Equipment 1")
+	assert.Equal(networkEquipment.NetworkInterfaces.Public.Gateway, "95.211.162.62")
+	assert.Equal(networkEquipment.NetworkInterfaces.Public.Ip, "95.211.162.0/27")
+	assert.Equal(networkEquipment.NetworkInterfaces.Public.Mac, "AA:BB:CC:DD:EE:FF")
+	assert.Equal(networkEquipment.NetworkInterfaces.Public.Ports[0].Name, "EVO-AABB-01")
+	assert.Equal(networkEquipment.NetworkInterfaces.Public.Ports[0].Port, "33")
+	assert.Equal(networkEquipment.PowerPorts[0].Name, "EVO-JV12-APC02")
+	assert.Equal(networkEquipment.PowerPorts[0].Port, "10")
+	assert.Equal(networkEquipment.Rack.Type, "DEDICATED")
+	assert.Equal(networkEquipment.SerialNumber, "JDK18291JK")
+	assert.Equal(networkEquipment.Specs.Brand, "Juniper")
+	assert.Equal(networkEquipment.Specs.Model, "EX3400-48T")
+	assert.Equal(networkEquipment.Type, "SWITCH")
+}
+
+func TestNetworkEquipmentListServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListBeEmpty(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 0, "totalCount": 0}, "networkEquipments": []}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.List()
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 0)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.NetworkEquipments), 0)
+}
+
+func TestNetworkEquipmentListPaginateAndFilter(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		query := r.URL.Query()
+		assert.Equal(t, "1", query.Get("offset"))
+		assert.Equal(t, "10", query.Get("limit"))
+		assert.Equal(t, "core switch", query.Get("reference"))
+		assert.Equal(t, "95.211.162.0", query.Get("ip"))
+		assert.Equal(t, "AA:BB:CC:DD:EE:FF", query.Get("macAddress"))
+		assert.Equal(t, "AMS-01", query.Get("site"))
+		assert.Equal(t, "54321", query.Get("privateRackId"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "networkEquipments": [{"id": "12345"}]}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.List(1, 10, "core switch", "95.211.162.0", "AA:BB:CC:DD:EE:FF", "AMS-01", "54321")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 11)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.NetworkEquipments), 1)
+	assert.Equal(response.NetworkEquipments[0].Id, "12345")
+}
+
+func TestNetworkEquipmentGet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"contract": {
+				"id": "674382",
+				"reference": "core switch"
+			},
+			"id": "12345",
+			"location": {
+				"rack": "13",
+				"site": "AMS-01",
+				"suite": "A6",
+				"unit": "16-17"
+			},
+			"name": "Network Equipment 1",
+			"serialNumber": "JDK18291JK",
+			"specs": {
+				"brand": "Juniper",
+				"model": "EX3400-48T"
+			},
+			"type": "SWITCH"
+		}`)
+	})
+	defer teardown()
+
+	networkEquipment, err := NetworkEquipmentApi{}.Get("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(networkEquipment.Contract.Id, "674382")
+	assert.Equal(networkEquipment.Contract.Reference, "core switch")
+	assert.Equal(networkEquipment.Id, "12345")
+	assert.Equal(networkEquipment.Location.Site, "AMS-01")
+	assert.Equal(networkEquipment.Location.Unit, "16-17")
+	assert.Equal(networkEquipment.Name, "Network Equipment 1")
+	assert.Equal(networkEquipment.SerialNumber, "JDK18291JK")
+	assert.Equal(networkEquipment.Specs.Brand, "Juniper")
+	assert.Equal(networkEquipment.Specs.Model, "EX3400-48T")
+	assert.Equal(networkEquipment.Type, "SWITCH")
+}
+
+func TestNetworkEquipmentGetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentUpdate(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	payload := map[string]interface{}{"reference": "new reference"}
+	err := NetworkEquipmentApi{}.Update("12345", payload)
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestNetworkEquipmentUpdateServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.Update("12345", map[string]interface{}{"reference": "new reference"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.Update("12345", map[string]interface{}{"reference": "new reference"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.Update("12345", map[string]interface{}{"reference": "new reference"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.Update("12345", map[string]interface{}{"reference": "new reference"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.Update("12345", map[string]interface{}{"reference": "new reference"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListIps(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/ips", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"ips": [
+				{
+					"ddos": {
+						"detectionProfile": "ADVANCED_LOW_UDP",
+						"protectionType": "ADVANCED"
+					},
+					"floatingIp": false,
+					"gateway": "12.123.123.254",
+					"ip": "12.123.123.1/24",
+					"mainIp": true,
+					"networkType": "PUBLIC",
+					"nullRouted": true,
+					"reverseLookup": "domain.example.com",
+					"version": 4
+				},
+				{
+					"ddos": {
+						"detectionProfile": "STANDARD_DEFAULT",
+						"protectionType": "STANDARD"
+					},
+					"floatingIp": false,
+					"gateway": "2001:db8:85a3::8a2e:370:1",
+					"ip": "2001:db8:85a3::8a2e:370:7334/64",
+					"mainIp": false,
+					"networkType": "REMOTE_MANAGEMENT",
+					"nullRouted": false,
+					"reverseLookup": "domain.example.com",
+					"version": 6
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.ListIps("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Ips), 2)
+
+	assert.Equal(response.Ips[0].DDOS.DetectionProfile, "ADVANCED_LOW_UDP")
+	assert.Equal(response.Ips[0].DDOS.ProtectionType, "ADVANCED")
+	assert.False(response.Ips[0].FloatingIp)
+	assert.Equal(response.Ips[0].Gateway, "12.123.123.254")
+	assert.Equal(response.Ips[0].Ip, "12.123.123.1/24")
+	assert.True(response.Ips[0].MainIp)
+	assert.Equal(response.Ips[0].NetworkType, "PUBLIC")
+	assert.True(response.Ips[0].NullRouted)
+	assert.Equal(response.Ips[0].ReverseLookup, "domain.example.com")
+	assert.Equal(response.Ips[0].Version, 4)
+
+	assert.Equal(response.Ips[1].Ip, "2001:db8:85a3::8a2e:370:7334/64")
+	assert.Equal(response.Ips[1].NetworkType, "REMOTE_MANAGEMENT")
+	assert.False(response.Ips[1].NullRouted)
+	assert.Equal(response.Ips[1].Version, 6)
+}
+
+func TestNetworkEquipmentListIpsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListIps("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListIps("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListIps("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListIps("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListIps("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListIpsFilterAndPagination(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		query := r.URL.Query()
+		assert.Equal(t, "1", query.Get("offset"))
+		assert.Equal(t, "10", query.Get("limit"))
+		assert.Equal(t, "PUBLIC", query.Get("networkType"))
+		assert.Equal(t, "4", query.Get("version"))
+		assert.Equal(t, "true", query.Get("nullRouted"))
+		assert.Equal(t, "12.123.123.1", query.Get("ips"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "ips": [{"ip": "12.123.123.1/24", "nullRouted": true}]}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.ListIps("12345", 1, 10, "PUBLIC", 4, true, "12.123.123.1")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 11)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(len(response.Ips), 1)
+	assert.Equal(response.Ips[0].Ip, "12.123.123.1/24")
+	assert.True(response.Ips[0].NullRouted)
+}
+
+func TestNetworkEquipmentGetIp(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/ips/12.123.123.1", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"ddos": {
+				"detectionProfile": "ADVANCED_LOW_UDP",
+				"protectionType": "ADVANCED"
+			},
+			"floatingIp": false,
+			"gateway": "12.123.123.254",
+			"ip": "12.123.123.1/24",
+			"mainIp": true,
+			"networkType": "PUBLIC",
+			"nullRouted": false,
+			"reverseLookup": "domain.example.com",
+			"version": 4
+		}`)
+	})
+	defer teardown()
+
+	ip, err := NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(ip.DDOS.DetectionProfile, "ADVANCED_LOW_UDP")
+	assert.Equal(ip.DDOS.ProtectionType, "ADVANCED")
+	assert.Equal(ip.Gateway, "12.123.123.254")
+	assert.Equal(ip.Ip, "12.123.123.1/24")
+	assert.True(ip.MainIp)
+	assert.Equal(ip.NetworkType, "PUBLIC")
+	assert.False(ip.NullRouted)
+	assert.Equal(ip.ReverseLookup, "domain.example.com")
+	assert.Equal(ip.Version, 4)
+}
+
+func TestNetworkEquipmentGetIpServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentUpdateIp(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/ips/12.123.123.1", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"ddos": {
+				"detectionProfile": "ADVANCED_DEFAULT",
+				"protectionType": "ADVANCED"
+			},
+			"ip": "12.123.123.1/24",
+			"reverseLookup": "switch.example.com",
+			"version": 4
+		}`)
+	})
+	defer teardown()
+
+	payload := map[string]string{"detectionProfile": "ADVANCED_DEFAULT", "reverseLookup": "switch.example.com"}
+	ip, err := NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", payload)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(ip.DDOS.DetectionProfile, "ADVANCED_DEFAULT")
+	assert.Equal(ip.Ip, "12.123.123.1/24")
+	assert.Equal(ip.ReverseLookup, "switch.example.com")
+}
+
+func TestNetworkEquipmentUpdateIpServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", map[string]string{"reverseLookup": "switch.example.com"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", map[string]string{"reverseLookup": "switch.example.com"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", map[string]string{"reverseLookup": "switch.example.com"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", map[string]string{"reverseLookup": "switch.example.com"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateIp("12345", "12.123.123.1", map[string]string{"reverseLookup": "switch.example.com"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentNullRouteAnIp(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/ips/12.123.123.1/null", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{
+			"ip": "12.123.123.1/24",
+			"nullRouted": true,
+			"version": 4
+		}`)
+	})
+	defer teardown()
+
+	ip, err := NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(ip.Ip, "12.123.123.1/24")
+	assert.True(ip.NullRouted)
+}
+
+func TestNetworkEquipmentNullRouteAnIpServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.NullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentRemoveNullRouteAnIp(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/ips/12.123.123.1/unnull", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{
+			"ip": "12.123.123.1/24",
+			"nullRouted": false,
+			"version": 4
+		}`)
+	})
+	defer teardown()
+
+	ip, err := NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(ip.Ip, "12.123.123.1/24")
+	assert.False(ip.NullRouted)
+}
+
+func TestNetworkEquipmentRemoveNullRouteAnIpServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.RemoveNullRouteAnIp("12345", "12.123.123.1")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListNullRouteHistory(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/nullRouteHistory", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"nullRoutes": [
+				{
+					"automatedUnnullingAt": "2016-08-12T07:45:33+00:00",
+					"comment": "Device Null Route related to DDoS Mitigation",
+					"ip": "1.1.1.1/32",
+					"nullLevel": 3,
+					"nulledAt": "2016-08-12T07:40:27+00:00",
+					"ticketId": "282912"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 1)
+	assert.Equal(len(response.NullRoutes), 1)
+
+	nullRoute := response.NullRoutes[0]
+	assert.Equal(nullRoute.AutomatedUnnullingAt, MustTime("2016-08-12T07:45:33+00:00"))
+	assert.Equal(nullRoute.Comment, "Device Null Route related to DDoS Mitigation")
+	assert.Equal(nullRoute.Ip, "1.1.1.1/32")
+	assert.Equal(nullRoute.NullLevel, 3)
+	assert.Equal(nullRoute.NulledAt, MustTime("2016-08-12T07:40:27+00:00"))
+	assert.Equal(nullRoute.TicketId, "282912")
+}
+
+func TestNetworkEquipmentListNullRouteHistoryServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListCredentials(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"credentials": [
+				{
+					"type": "OPERATING_SYSTEM",
+					"username": "admin"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.ListCredentials("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 1)
+	assert.Equal(len(response.Credentials), 1)
+	assert.Equal(response.Credentials[0].Type, "OPERATING_SYSTEM")
+	assert.Equal(response.Credentials[0].Username, "admin")
+}
+
+func TestNetworkEquipmentListCredentialsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentCreateCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "mys3cr3tp@ssw0rd",
+			"type": "OPERATING_SYSTEM",
+			"username": "admin"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Type, "OPERATING_SYSTEM")
+	assert.Equal(resp.Username, "admin")
+	assert.Equal(resp.Password, "mys3cr3tp@ssw0rd")
+}
+
+func TestNetworkEquipmentCreateCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "admin", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentListCredentialsByType(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials/OPERATING_SYSTEM", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"credentials": [
+				{
+					"type": "OPERATING_SYSTEM",
+					"username": "admin"
+				},
+				{
+					"type": "OPERATING_SYSTEM",
+					"username": "operator"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(len(response.Credentials), 2)
+	assert.Equal(response.Credentials[0].Username, "admin")
+	assert.Equal(response.Credentials[1].Username, "operator")
+}
+
+func TestNetworkEquipmentListCredentialsByTypeServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentGetCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials/OPERATING_SYSTEM/admin", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "mys3cr3tp@ssw0rd",
+			"type": "OPERATING_SYSTEM",
+			"username": "admin"
+		}`)
+	})
+	defer teardown()
+
+	credential, err := NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(credential.Password, "mys3cr3tp@ssw0rd")
+	assert.Equal(credential.Username, "admin")
+	assert.Equal(credential.Type, "OPERATING_SYSTEM")
+}
+
+func TestNetworkEquipmentGetCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentDeleteCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials/OPERATING_SYSTEM/admin", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestNetworkEquipmentDeleteCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentUpdateCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/credentials/OPERATING_SYSTEM/admin", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "new password",
+			"type": "OPERATING_SYSTEM",
+			"username": "admin"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Password, "new password")
+	assert.Equal(resp.Type, "OPERATING_SYSTEM")
+	assert.Equal(resp.Username, "admin")
+}
+
+func TestNetworkEquipmentUpdateCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentPowerCycle(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/powerCycle", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer teardown()
+
+	err := NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestNetworkEquipmentPowerCycleServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerCycleNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentGetPowerStatus(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/powerInfo", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"pdu": {
+				"status": "on"
+			}
+		}`)
+	})
+	defer teardown()
+
+	resp, err := NetworkEquipmentApi{}.GetPowerStatus("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Pdu.Status, "on")
+}
+
+func TestNetworkEquipmentGetPowerStatusServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetPowerStatus("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetPowerStatus("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetPowerStatus("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetPowerStatus("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return NetworkEquipmentApi{}.GetPowerStatus("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentPowerOff(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/powerOff", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer teardown()
+
+	err := NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestNetworkEquipmentPowerOffServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOffNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestNetworkEquipmentPowerOn(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/networkEquipments/12345/powerOn", r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer teardown()
+
+	err := NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestNetworkEquipmentPowerOnServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, NetworkEquipmentApi{}.PowerOnNetworkEquipment("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -0,0 +1,328 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const DEDICATED_RACK_API_VERSION = "v2"
+
+type DedicatedRackApi struct{}
+
+type DedicatedRacks struct {
+	Racks    []DedicatedRack `json:"privateRacks"`
+	Metadata Metadata        `json:"_metadata"`
+}
+
+type DedicatedRack struct {
+	Id                  string                  `json:"id"`
+	CustomerId          string                  `json:"customerId"`
+	SalesOrgId          string                  `json:"salesOrgId"`
+	Contract            DedicatedServerContract `json:"contract"`
+	FeatureAvailability FeatureAvailability     `json:"featureAvailability"`
+	Location            Location                `json:"location"`
+	NetworkInterfaces   NetworkInterfaces       `json:"networkInterfaces"`
+	PowerPorts          []Port                  `json:"powerPorts"`
+	Type                string                  `json:"type"`
+	Units               []DedicatedRackUnit     `json:"units"`
+}
+
+type DedicatedRackUnit struct {
+	Id             string   `json:"id"`
+	Status         string   `json:"status"`
+	ConnectedUnits []string `json:"connectedUnits"`
+}
+
+func (dra DedicatedRackApi) getPath(endpoint string) string {
+	return "/bareMetals/" + DEDICATED_RACK_API_VERSION + endpoint
+}
+
+func (dra DedicatedRackApi) List(args ...int) (*DedicatedRacks, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks?" + v.Encode())
+	result := &DedicatedRacks{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) Get(rackId string) (*DedicatedRack, error) {
+	path := dra.getPath("/privateRacks/" + rackId)
+	result := &DedicatedRack{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) Update(rackId, reference string) error {
+	payload := map[string]string{"reference": reference}
+	path := dra.getPath("/privateRacks/" + rackId)
+	return doRequest(http.MethodPut, path, nil, payload)
+}
+
+func (dra DedicatedRackApi) ListCredentials(rackId string, args ...int) (*DedicatedServerCredentials, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials?" + v.Encode())
+	result := &DedicatedServerCredentials{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) CreateCredential(rackId, credentialType, username, password string) (*DedicatedServerCredential, error) {
+	payload := map[string]string{
+		"type":     credentialType,
+		"username": username,
+		"password": password,
+	}
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials")
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodPost, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) ListCredentialsByType(rackId, credentialType string, args ...int) (*DedicatedServerCredentials, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials/" + credentialType + "?" + v.Encode())
+	result := &DedicatedServerCredentials{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) GetCredential(rackId, credentialType, username string) (*DedicatedServerCredential, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials/" + credentialType + "/" + username)
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) DeleteCredential(rackId, credentialType, username string) error {
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials/" + credentialType + "/" + username)
+	return doRequest(http.MethodDelete, path)
+}
+
+func (dra DedicatedRackApi) UpdateCredential(rackId, credentialType, username, password string) (*DedicatedServerCredential, error) {
+	payload := map[string]string{"password": password}
+	path := dra.getPath("/privateRacks/" + rackId + "/credentials/" + credentialType + "/" + username)
+	result := &DedicatedServerCredential{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) GetDataTrafficMetrics(rackId string, args ...interface{}) (*DedicatedServerDataTrafficMetrics, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("granularity", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("aggregation", fmt.Sprint(args[1]))
+	}
+	if len(args) >= 3 {
+		v.Add("from", fmt.Sprint(args[2]))
+	}
+	if len(args) >= 4 {
+		v.Add("to", fmt.Sprint(args[3]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/metrics/datatraffic?" + v.Encode())
+	result := &DedicatedServerDataTrafficMetrics{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) GetBandWidthMetrics(rackId string, args ...interface{}) (*BandWidthMetrics, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("granularity", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("aggregation", fmt.Sprint(args[1]))
+	}
+	if len(args) >= 3 {
+		v.Add("from", fmt.Sprint(args[2]))
+	}
+	if len(args) >= 4 {
+		v.Add("to", fmt.Sprint(args[3]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/metrics/bandwidth?" + v.Encode())
+	result := &BandWidthMetrics{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) ListBandWidthNotificationSettings(rackId string, args ...int) (*BandWidthNotificationSettings, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/bandwidth?" + v.Encode())
+	result := &BandWidthNotificationSettings{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) CreateBandWidthNotificationSetting(rackId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error) {
+	payload := map[string]string{
+		"frequency": frequency,
+		"threshold": threshold,
+		"unit":      unit,
+	}
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/bandwidth")
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodPost, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) DeleteBandWidthNotificationSetting(rackId, notificationSettingId string) error {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/bandwidth/" + notificationSettingId)
+	return doRequest(http.MethodDelete, path)
+}
+
+func (dra DedicatedRackApi) GetBandWidthNotificationSetting(rackId, notificationSettingId string) (*DedicatedServerNotificationSetting, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/bandwidth/" + notificationSettingId)
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) UpdateBandWidthNotificationSetting(rackId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/bandwidth/" + notificationSettingId)
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) ListDataTrafficNotificationSettings(rackId string, args ...int) (*DataTrafficNotificationSettings, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/datatraffic?" + v.Encode())
+	result := &DataTrafficNotificationSettings{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) CreateDataTrafficNotificationSetting(rackId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error) {
+	payload := map[string]string{
+		"frequency": frequency,
+		"threshold": threshold,
+		"unit":      unit,
+	}
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/datatraffic")
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodPost, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) DeleteDataTrafficNotificationSetting(rackId, notificationSettingId string) error {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/datatraffic/" + notificationSettingId)
+	return doRequest(http.MethodDelete, path)
+}
+
+func (dra DedicatedRackApi) GetDataTrafficNotificationSetting(rackId, notificationSettingId string) (*DedicatedServerNotificationSetting, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/datatraffic/" + notificationSettingId)
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) UpdateDataTrafficNotificationSetting(rackId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/datatraffic/" + notificationSettingId)
+	result := &DedicatedServerNotificationSetting{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) GetDdosNotificationSetting(rackId string) (*DedicatedServerDdosNotificationSetting, error) {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/ddos")
+	result := &DedicatedServerDdosNotificationSetting{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (dra DedicatedRackApi) UpdateDdosNotificationSetting(rackId string, payload map[string]string) error {
+	path := dra.getPath("/privateRacks/" + rackId + "/notificationSettings/ddos")
+	return doRequest(http.MethodPut, path, nil, payload)
+}
+
+func (dra DedicatedRackApi) ListNullRouteHistory(rackId string, args ...int) (*DedicatedServerNullRoutes, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := dra.getPath("/privateRacks/" + rackId + "/nullRouteHistory?" + v.Encode())
+	result := &DedicatedServerNullRoutes{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,2997 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedicatedRackList(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"privateRacks": [
+				{
+					"id": "12345",
+					"customerId": "1301178860",
+					"salesOrgId": "2000",
+					"contract": {
+						"id": "674382",
+						"customerId": "1301178860",
+						"deliveryStatus": "ACTIVE",
+						"reference": "database rack",
+						"salesOrgId": "2000"
+					},
+					"featureAvailability": {
+						"automation": false,
+						"ipmiReboot": false,
+						"powerCycle": false,
+						"privateNetwork": false,
+						"remoteManagement": false
+					},
+					"location": {
+						"rack": "13",
+						"site": "AMS-01",
+						"suite": "A6"
+					},
+					"networkInterfaces": {
+						"public": {
+							"ports": [
+								{
+									"name": "EVO-AABB-01",
+									"port": "33"
+								}
+							]
+						}
+					},
+					"powerPorts": [
+						{
+							"name": "EVO-JV12-APC02",
+							"port": "10"
+						}
+					],
+					"type": "PRIVATE_RACK",
+					"units": [
+						{
+							"id": "AMS-01-A6-13-01",
+							"status": "OCCUPIED",
+							"connectedUnits": ["AMS-01-A6-13-01", "AMS-01-A6-13-02"]
+						},
+						{
+							"id": "AMS-01-A6-13-03",
+							"status": "FREE",
+							"connectedUnits": []
+						}
+					]
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.List()
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 1)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Racks), 1)
+
+	rack := response.Racks[0]
+	assert.Equal(rack.Id, "12345")
+	assert.Equal(rack.CustomerId, "1301178860")
+	assert.Equal(rack.SalesOrgId, "2000")
+	assert.Equal(rack.Contract.Id, "674382")
+	assert.Equal(rack.Contract.DeliveryStatus, "ACTIVE")
+	assert.Equal(rack.Contract.Reference, "database rack")
+	assert.False(rack.FeatureAvailability.Automation)
+	assert.False(rack.FeatureAvailability.PowerCycle)
+	assert.Equal(rack.Location.Rack, "13")
+	assert.Equal(rack.Location.Site, "AMS-01")
+	assert.Equal(rack.Location.Suite, "A6")
+	assert.Equal(rack.NetworkInterfaces.Public.Ports[0].Name, "EVO-AABB-01")
+	assert.Equal(rack.NetworkInterfaces.Public.Ports[0].Port, "33")
+	assert.Equal(rack.PowerPorts[0].Name, "EVO-JV12-APC02")
+	assert.Equal(rack.PowerPorts[0].Port, "10")
+	assert.Equal(rack.Type, "PRIVATE_RACK")
+	assert.Equal(len(rack.Units), 2)
+	assert.Equal(rack.Units[0].Id, "AMS-01-A6-13-01")
+	assert.Equal(rack.Units[0].Status, "OCCUPIED")
+	assert.Equal(rack.Units[0].ConnectedUnits, []string{"AMS-01-A6-13-01", "AMS-01-A6-13-02"})
+	assert.Equal(rack.Units[1].Id, "AMS-01-A6-13-03")
+	assert.Equal(rack.Units[1].Status, "FREE")
+	assert.Empty(rack.Units[1].ConnectedUnits)
+}
+
+func TestDedicatedRackListServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.List()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListBeEmpty(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 0, "totalCount": 0}, "privateRacks": []}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.List()
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 0)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Racks), 0)
+}
+
+func TestDedicatedRackListPaginate(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "privateRacks": [{"id": "12345"}]}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.List(1, 10)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 11)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Racks), 1)
+	assert.Equal(response.Racks[0].Id, "12345")
+}
+
+func TestDedicatedRackGet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"id": "12345",
+			"customerId": "1301178860",
+			"salesOrgId": "2000",
+			"contract": {
+				"id": "674382",
+				"reference": "database rack"
+			},
+			"location": {
+				"rack": "13",
+				"site": "AMS-01",
+				"suite": "A6"
+			},
+			"type": "PRIVATE_RACK",
+			"units": [
+				{
+					"id": "AMS-01-A6-13-01",
+					"status": "OCCUPIED",
+					"connectedUnits": ["AMS-01-A6-13-01"]
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	rack, err := DedicatedRackApi{}.Get("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(rack.Id, "12345")
+	assert.Equal(rack.CustomerId, "1301178860")
+	assert.Equal(rack.SalesOrgId, "2000")
+	assert.Equal(rack.Contract.Id, "674382")
+	assert.Equal(rack.Contract.Reference, "database rack")
+	assert.Equal(rack.Location.Site, "AMS-01")
+	assert.Equal(rack.Location.Suite, "A6")
+	assert.Equal(rack.Location.Rack, "13")
+	assert.Equal(rack.Type, "PRIVATE_RACK")
+	assert.Equal(rack.Units[0].Id, "AMS-01-A6-13-01")
+	assert.Equal(rack.Units[0].Status, "OCCUPIED")
+	assert.Equal(rack.Units[0].ConnectedUnits, []string{"AMS-01-A6-13-01"})
+}
+
+func TestDedicatedRackGetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.Get("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackUpdate(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := DedicatedRackApi{}.Update("12345", "new reference")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestDedicatedRackUpdateServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.Update("12345", "new reference")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.Update("12345", "new reference")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.Update("12345", "new reference")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.Update("12345", "new reference")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.Update("12345", "new reference")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListCredentials(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"credentials": [
+				{
+					"type": "REMOTE_MANAGEMENT",
+					"username": "admin"
+				},
+				{
+					"type": "OPERATING_SYSTEM",
+					"username": "root"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.ListCredentials("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Credentials), 2)
+
+	assert.Equal(response.Credentials[0].Type, "REMOTE_MANAGEMENT")
+	assert.Equal(response.Credentials[0].Username, "admin")
+	assert.Equal(response.Credentials[1].Type, "OPERATING_SYSTEM")
+	assert.Equal(response.Credentials[1].Username, "root")
+}
+
+func TestDedicatedRackListCredentialsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentials("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListCredentialsPaginate(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "credentials": [{"type": "REMOTE_MANAGEMENT", "username": "admin"}]}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.ListCredentials("12345", 1, 10)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 11)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(len(response.Credentials), 1)
+	assert.Equal(response.Credentials[0].Username, "admin")
+}
+
+func TestDedicatedRackCreateCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "mys3cr3tp@ssw0rd",
+			"type": "OPERATING_SYSTEM",
+			"username": "root"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Type, "OPERATING_SYSTEM")
+	assert.Equal(resp.Username, "root")
+	assert.Equal(resp.Password, "mys3cr3tp@ssw0rd")
+}
+
+func TestDedicatedRackCreateCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateCredential("12345", "OPERATING_SYSTEM", "root", "mys3cr3tp@ssw0rd")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListCredentialsByType(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials/REMOTE_MANAGEMENT", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"credentials": [
+				{
+					"type": "REMOTE_MANAGEMENT",
+					"username": "admin"
+				},
+				{
+					"type": "REMOTE_MANAGEMENT",
+					"username": "root"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(len(response.Credentials), 2)
+	assert.Equal(response.Credentials[0].Type, "REMOTE_MANAGEMENT")
+	assert.Equal(response.Credentials[0].Username, "admin")
+	assert.Equal(response.Credentials[1].Type, "REMOTE_MANAGEMENT")
+	assert.Equal(response.Credentials[1].Username, "root")
+}
+
+func TestDedicatedRackListCredentialsByTypeServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListCredentialsByType("12345", "REMOTE_MANAGEMENT")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials/OPERATING_SYSTEM/root", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "mys3cr3tp@ssw0rd",
+			"type": "OPERATING_SYSTEM",
+			"username": "root"
+		}`)
+	})
+	defer teardown()
+
+	credential, err := DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(credential.Password, "mys3cr3tp@ssw0rd")
+	assert.Equal(credential.Username, "root")
+	assert.Equal(credential.Type, "OPERATING_SYSTEM")
+}
+
+func TestDedicatedRackGetCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetCredential("12345", "OPERATING_SYSTEM", "root")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackDeleteCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials/OPERATING_SYSTEM/admin", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestDedicatedRackDeleteCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteCredential("12345", "OPERATING_SYSTEM", "admin")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackUpdateCredential(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/credentials/OPERATING_SYSTEM/admin", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"password": "new password",
+			"type": "OPERATING_SYSTEM",
+			"username": "admin"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Password, "new password")
+	assert.Equal(resp.Type, "OPERATING_SYSTEM")
+	assert.Equal(resp.Username, "admin")
+}
+
+func TestDedicatedRackUpdateCredentialServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateCredential("12345", "OPERATING_SYSTEM", "admin", "new password")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetBandWidthMetrics(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/metrics/bandwidth", r.URL.Path)
+		assert.Equal(t, "HOUR", r.URL.Query().Get("granularity"))
+		assert.Equal(t, "AVG", r.URL.Query().Get("aggregation"))
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"aggregation": "AVG",
+				"from": "2016-10-20T09:00:00Z",
+				"granularity": "HOUR",
+				"to": "2016-10-20T11:00:00Z"
+			},
+			"metrics": {
+				"DOWN_PUBLIC": {
+					"unit": "bps",
+					"values": [
+						{
+							"timestamp": "2016-10-20T09:00:00Z",
+							"value": 202499
+						}
+					]
+				},
+				"UP_PUBLIC": {
+					"unit": "bps",
+					"values": [
+						{
+							"timestamp": "2016-10-20T09:00:00Z",
+							"value": 43212393
+						}
+					]
+				}
+			}
+		}`)
+	})
+	defer teardown()
+
+	metric, err := DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(metric.Metadata.Aggregation, "AVG")
+	assert.Equal(metric.Metadata.From, "2016-10-20T09:00:00Z")
+	assert.Equal(metric.Metadata.To, "2016-10-20T11:00:00Z")
+	assert.Equal(metric.Metadata.Granularity, "HOUR")
+	assert.Equal(metric.Metric.DownPublic.Unit, "bps")
+	assert.Equal(metric.Metric.DownPublic.Values[0].Value, 202499)
+	assert.Equal(metric.Metric.DownPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
+	assert.Equal(metric.Metric.UpPublic.Unit, "bps")
+	assert.Equal(metric.Metric.UpPublic.Values[0].Value, 43212393)
+	assert.Equal(metric.Metric.UpPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
+}
+
+func TestDedicatedRackGetBandWidthMetricsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthMetrics("12345", "HOUR", "AVG", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetDataTrafficMetrics(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/metrics/datatraffic", r.URL.Path)
+		assert.Equal(t, "SUM", r.URL.Query().Get("aggregation"))
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"aggregation": "SUM",
+				"from": "2016-10-20T09:00:00Z",
+				"granularity": "HOUR",
+				"to": "2016-10-20T11:00:00Z"
+			},
+			"metrics": {
+				"DOWN_PUBLIC": {
+					"unit": "B",
+					"values": [
+						{
+							"timestamp": "2016-10-20T09:00:00Z",
+							"value": 202499
+						}
+					]
+				},
+				"UP_PUBLIC": {
+					"unit": "B",
+					"values": [
+						{
+							"timestamp": "2016-10-20T09:00:00Z",
+							"value": 43212393
+						}
+					]
+				}
+			}
+		}`)
+	})
+	defer teardown()
+
+	metric, err := DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(metric.Metadata.Aggregation, "SUM")
+	assert.Equal(metric.Metadata.Granularity, "HOUR")
+	assert.Equal(metric.Metric.DownPublic.Unit, "B")
+	assert.Equal(metric.Metric.DownPublic.Values[0].Value, 202499)
+	assert.Equal(metric.Metric.UpPublic.Unit, "B")
+	assert.Equal(metric.Metric.UpPublic.Values[0].Value, 43212393)
+}
+
+func TestDedicatedRackGetDataTrafficMetricsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficMetrics("12345", "HOUR", "SUM", "2016-10-20T09:00:00Z", "2016-10-20T11:00:00Z")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListBandWidthNotificationSettings(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/bandwidth", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"bandwidthNotificationSettings": [
+				{
+					"actions": [
+						{
+							"lastTriggeredAt": "2021-03-16T01:01:44+00:00",
+							"type": "EMAIL"
+						}
+					],
+					"frequency": "WEEKLY",
+					"id": "12345",
+					"lastCheckedAt": "2021-03-16T01:01:41+00:00",
+					"threshold": "1",
+					"thresholdExceededAt": "2021-03-16T01:01:41+00:00",
+					"unit": "Gbps"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Metadata.TotalCount, 1)
+	assert.Equal(len(resp.Settings), 1)
+	assert.Equal(resp.Settings[0].Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
+	assert.Equal(resp.Settings[0].Actions[0].Type, "EMAIL")
+	assert.Equal(resp.Settings[0].Frequency, "WEEKLY")
+	assert.Equal(resp.Settings[0].Id, "12345")
+	assert.Equal(resp.Settings[0].LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
+	assert.Equal(resp.Settings[0].Threshold, "1")
+	assert.Equal(resp.Settings[0].ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
+	assert.Equal(resp.Settings[0].Unit, "Gbps")
+}
+
+func TestDedicatedRackListBandWidthNotificationSettingsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListBandWidthNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackCreateBandWidthNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/bandwidth", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "WEEKLY",
+			"id": "12345",
+			"threshold": "1",
+			"unit": "Gbps"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "WEEKLY")
+	assert.Equal(resp.Id, "12345")
+	assert.Equal(resp.Threshold, "1")
+	assert.Equal(resp.Unit, "Gbps")
+}
+
+func TestDedicatedRackCreateBandWidthNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateBandWidthNotificationSetting("12345", "WEEKLY", "1", "Gbps")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackDeleteBandWidthNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/bandwidth/67890", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestDedicatedRackDeleteBandWidthNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetBandWidthNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/bandwidth/67890", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "WEEKLY",
+			"id": "67890",
+			"threshold": "1",
+			"unit": "Gbps"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "WEEKLY")
+	assert.Equal(resp.Id, "67890")
+	assert.Equal(resp.Threshold, "1")
+	assert.Equal(resp.Unit, "Gbps")
+}
+
+func TestDedicatedRackGetBandWidthNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetBandWidthNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackUpdateBandWidthNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/bandwidth/67890", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "MONTHLY",
+			"id": "67890",
+			"threshold": "2",
+			"unit": "Mbps"
+		}`)
+	})
+	defer teardown()
+
+	payload := map[string]string{"frequency": "MONTHLY", "threshold": "2", "unit": "Mbps"}
+	resp, err := DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", payload)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "MONTHLY")
+	assert.Equal(resp.Id, "67890")
+	assert.Equal(resp.Threshold, "2")
+	assert.Equal(resp.Unit, "Mbps")
+}
+
+func TestDedicatedRackUpdateBandWidthNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", map[string]string{"frequency": "MONTHLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", map[string]string{"frequency": "MONTHLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", map[string]string{"frequency": "MONTHLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", map[string]string{"frequency": "MONTHLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateBandWidthNotificationSetting("12345", "67890", map[string]string{"frequency": "MONTHLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListDataTrafficNotificationSettings(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/datatraffic", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"datatrafficNotificationSettings": [
+				{
+					"actions": [
+						{
+							"lastTriggeredAt": "2021-03-16T01:01:44+00:00",
+							"type": "EMAIL"
+						}
+					],
+					"frequency": "MONTHLY",
+					"id": "12345",
+					"lastCheckedAt": "2021-03-16T01:01:41+00:00",
+					"threshold": "1",
+					"thresholdExceededAt": "2021-03-16T01:01:41+00:00",
+					"unit": "GB"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Metadata.TotalCount, 1)
+	assert.Equal(len(resp.Settings), 1)
+	assert.Equal(resp.Settings[0].Actions[0].Type, "EMAIL")
+	assert.Equal(resp.Settings[0].Frequency, "MONTHLY")
+	assert.Equal(resp.Settings[0].Id, "12345")
+	assert.Equal(resp.Settings[0].Threshold, "1")
+	assert.Equal(resp.Settings[0].Unit, "GB")
+}
+
+func TestDedicatedRackListDataTrafficNotificationSettingsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListDataTrafficNotificationSettings("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackCreateDataTrafficNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/datatraffic", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "MONTHLY",
+			"id": "12345",
+			"threshold": "1",
+			"unit": "GB"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "MONTHLY")
+	assert.Equal(resp.Id, "12345")
+	assert.Equal(resp.Threshold, "1")
+	assert.Equal(resp.Unit, "GB")
+}
+
+func TestDedicatedRackCreateDataTrafficNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.CreateDataTrafficNotificationSetting("12345", "MONTHLY", "1", "GB")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackDeleteDataTrafficNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/datatraffic/67890", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestDedicatedRackDeleteDataTrafficNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.DeleteDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetDataTrafficNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/datatraffic/67890", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "MONTHLY",
+			"id": "67890",
+			"threshold": "1",
+			"unit": "GB"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "MONTHLY")
+	assert.Equal(resp.Id, "67890")
+	assert.Equal(resp.Threshold, "1")
+	assert.Equal(resp.Unit, "GB")
+}
+
+func TestDedicatedRackGetDataTrafficNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDataTrafficNotificationSetting("12345", "67890")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackUpdateDataTrafficNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/datatraffic/67890", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"frequency": "WEEKLY",
+			"id": "67890",
+			"threshold": "2",
+			"unit": "TB"
+		}`)
+	})
+	defer teardown()
+
+	payload := map[string]string{"frequency": "WEEKLY", "threshold": "2", "unit": "TB"}
+	resp, err := DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", payload)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Frequency, "WEEKLY")
+	assert.Equal(resp.Id, "67890")
+	assert.Equal(resp.Threshold, "2")
+	assert.Equal(resp.Unit, "TB")
+}
+
+func TestDedicatedRackUpdateDataTrafficNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", map[string]string{"frequency": "WEEKLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", map[string]string{"frequency": "WEEKLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", map[string]string{"frequency": "WEEKLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", map[string]string{"frequency": "WEEKLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.UpdateDataTrafficNotificationSetting("12345", "67890", map[string]string{"frequency": "WEEKLY"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackGetDdosNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/ddos", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"nulling": "ENABLED",
+			"scrubbing": "DISABLED"
+		}`)
+	})
+	defer teardown()
+
+	resp, err := DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Nulling, "ENABLED")
+	assert.Equal(resp.Scrubbing, "DISABLED")
+}
+
+func TestDedicatedRackGetDdosNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.GetDdosNotificationSetting("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackUpdateDdosNotificationSetting(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/notificationSettings/ddos", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED", "scrubbing": "ENABLED"})
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestDedicatedRackUpdateDdosNotificationSettingServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, DedicatedRackApi{}.UpdateDdosNotificationSetting("12345", map[string]string{"nulling": "DISABLED"})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDedicatedRackListNullRouteHistory(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/privateRacks/12345/nullRouteHistory", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 1
+			},
+			"nullRoutes": [
+				{
+					"automatedUnnullingAt": "2016-08-12T07:45:33+00:00",
+					"comment": "Device Null Route related to DDoS Mitigation",
+					"ip": "1.1.1.1/32",
+					"nullLevel": 3,
+					"nulledAt": "2016-08-12T07:40:27+00:00",
+					"ticketId": "282912"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := DedicatedRackApi{}.ListNullRouteHistory("12345")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 1)
+	assert.Equal(len(response.NullRoutes), 1)
+
+	nullRoute := response.NullRoutes[0]
+	assert.Equal(nullRoute.AutomatedUnnullingAt, MustTime("2016-08-12T07:45:33+00:00"))
+	assert.Equal(nullRoute.Comment, "Device Null Route related to DDoS Mitigation")
+	assert.Equal(nullRoute.Ip, "1.1.1.1/32")
+	assert.Equal(nullRoute.NullLevel, 3)
+	assert.Equal(nullRoute.NulledAt, MustTime("2016-08-12T07:40:27+00:00"))
+	assert.Equal(nullRoute.TicketId, "282912")
+}
+
+func TestDedicatedRackListNullRouteHistoryServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return DedicatedRackApi{}.ListNullRouteHistory("12345")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -1,6 +1,7 @@
 package leaseweb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -66,24 +67,24 @@ type DedicatedServerContract struct {
 	BillingFrequency  string                          `json:"billingFrequency"`
 	ContractTerm      int                             `json:"contractTerm"`
 	Currency          string                          `json:"currency"`
-	EndsAt            string                          `json:"endsAt"`
-	StartsAt          string                          `json:"startsAt"`
+	EndsAt            Time                            `json:"endsAt"`
+	StartsAt          Time                            `json:"startsAt"`
 	CustomerId        string                          `json:"customerId"`
 	DeliveryStatus    string                          `json:"deliveryStatus"`
 	Id                string                          `json:"id"`
 	Reference         string                          `json:"reference"`
 	SalesOrgId        string                          `json:"salesOrgId"`
 	NetworkTraffic    NetworkTraffic                  `json:"networkTraffic"`
-	PricePerFrequency int                             `json:"pricePerFrequency"`
+	PricePerFrequency Money                           `json:"pricePerFrequency"`
 	PrivateNetworks   []DedicatedServerPrivateNetwork `json:"privateNetworks"`
 	Sla               string                          `json:"sla"`
 	SoftwareLicenses  []SoftwareLicense               `json:"softwareLicenses"`
 }
 
 type SoftwareLicense struct {
-	Currency string  `json:"currency"`
-	Name     string  `json:"name"`
-	Price    float32 `json:"price"`
+	Currency string `json:"currency"`
+	Name     string `json:"name"`
+	Price    Money  `json:"price"`
 }
 
 type FeatureAvailability struct {
@@ -135,7 +136,7 @@ type DedicatedServerHardware struct {
 	Id            string                             `json:"id"`
 	ParserVersion string                             `json:"parserVersion"`
 	Result        DedicatedServerHardwareInformation `json:"result"`
-	ScannedAt     string                             `json:"scannedAt"`
+	ScannedAt     Time                               `json:"scannedAt"`
 	ServerId      string                             `json:"serverId"`
 }
 
@@ -364,11 +365,11 @@ type DedicatedServerNullRoutes struct {
 }
 
 type DedicatedServerNullRoute struct {
-	AutomatedUnnullingAt string `json:"automatedUnnullingAt"`
+	AutomatedUnnullingAt Time   `json:"automatedUnnullingAt"`
 	Comment              string `json:"comment"`
 	Ip                   string `json:"ip"`
 	NullLevel            int    `json:"nullLevel"`
-	NulledAt             string `json:"nulledAt"`
+	NulledAt             Time   `json:"nulledAt"`
 	TicketId             string `json:"ticketId"`
 }
 
@@ -393,7 +394,7 @@ type DedicatedServerDhcpReservations struct {
 
 type DedicatedServerDhcpReservation struct {
 	BootFile          string                                          `json:"bootfile"`
-	CreatedAt         string                                          `json:"createdAt"`
+	CreatedAt         Time                                            `json:"createdAt"`
 	Gateway           string                                          `json:"gateway"`
 	Hostname          string                                          `json:"hostname"`
 	Ip                string                                          `json:"ip"`
@@ -401,7 +402,7 @@ type DedicatedServerDhcpReservation struct {
 	Mac               string                                          `json:"mac"`
 	Netmask           string                                          `json:"netmask"`
 	Site              string                                          `json:"site"`
-	UpdatedAt         string                                          `json:"updatedAt"`
+	UpdatedAt         Time                                            `json:"updatedAt"`
 }
 
 type DedicatedServerDhcpReservationLastClientRequest struct {
@@ -416,7 +417,7 @@ type DedicatedServerJobs struct {
 }
 
 type DedicatedServerJob struct {
-	CreatedAt string                     `json:"createdAt"`
+	CreatedAt Time                       `json:"createdAt"`
 	Flow      string                     `json:"flow"`
 	IsRunning bool                       `json:"isRunning"`
 	Node      string                     `json:"node"`
@@ -426,7 +427,7 @@ type DedicatedServerJob struct {
 	Status    string                     `json:"status"`
 	Tasks     []DedicatedServerJobTask   `json:"tasks"`
 	Type      string                     `json:"type"`
-	UpdatedAt string                     `json:"updatedAt"`
+	UpdatedAt Time                       `json:"updatedAt"`
 	Uuid      string                     `json:"uuid"`
 	Metadata  struct {
 		BatchId string `json:"BATCH_ID"`
@@ -531,14 +532,14 @@ type DedicatedServerNotificationSetting struct {
 	Actions             []DedicatedServerNotificationSettingAction `json:"actions"`
 	Frequency           string                                     `json:"frequency"`
 	Id                  string                                     `json:"id"`
-	LastCheckedAt       string                                     `json:"lastCheckedAt"`
+	LastCheckedAt       Time                                       `json:"lastCheckedAt"`
 	Threshold           string                                     `json:"threshold"`
-	ThresholdExceededAt string                                     `json:"thresholdExceededAt"`
+	ThresholdExceededAt Time                                       `json:"thresholdExceededAt"`
 	Unit                string                                     `json:"unit"`
 }
 
 type DedicatedServerNotificationSettingAction struct {
-	LastTriggeredAt string `json:"lastTriggeredAt"`
+	LastTriggeredAt Time   `json:"lastTriggeredAt"`
 	Type            string `json:"type"`
 }
 
@@ -609,6 +610,24 @@ type RescueImage struct {
 	Name string `json:"name"`
 }
 
+func (dsc *DedicatedServerContract) UnmarshalJSON(b []byte) error {
+	type contract DedicatedServerContract
+	if err := json.Unmarshal(b, (*contract)(dsc)); err != nil {
+		return err
+	}
+	dsc.PricePerFrequency = dsc.PricePerFrequency.WithCurrency(dsc.Currency)
+	return nil
+}
+
+func (sl *SoftwareLicense) UnmarshalJSON(b []byte) error {
+	type softwareLicense SoftwareLicense
+	if err := json.Unmarshal(b, (*softwareLicense)(sl)); err != nil {
+		return err
+	}
+	sl.Price = sl.Price.WithCurrency(sl.Currency)
+	return nil
+}
+
 func (dsa DedicatedServerApi) getPath(endpoint string) string {
 	return "/bareMetals/" + DEDICATED_SERVER_API_VERSION + endpoint
 }
@@ -621,16 +640,16 @@ func (dsa DedicatedServerApi) List(args ...interface{}) (*DedicatedServers, erro
 	if len(args) >= 2 {
 		v.Add("limit", fmt.Sprint(args[1]))
 	}
-	if len(args) >= 3 {
+	if len(args) >= 3 && fmt.Sprint(args[2]) != "" {
 		v.Add("ip", fmt.Sprint(args[2]))
 	}
-	if len(args) >= 4 {
+	if len(args) >= 4 && fmt.Sprint(args[3]) != "" {
 		v.Add("macAddress", fmt.Sprint(args[3]))
 	}
-	if len(args) >= 5 {
+	if len(args) >= 5 && fmt.Sprint(args[4]) != "" {
 		v.Add("site", fmt.Sprint(args[4]))
 	}
-	if len(args) >= 6 {
+	if len(args) >= 6 && fmt.Sprint(args[5]) != "" {
 		v.Add("privateRackId", fmt.Sprint(args[5]))
 	}
 	if len(args) >= 7 {
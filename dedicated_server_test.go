@@ -158,6 +158,17 @@ func TestListBeEmpty(t *testing.T) {
 	assert.Equal(len(response.Servers), 0)
 }
 
+func TestListSkipsEmptyFilters(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "limit=10&offset=0&site=AMS-01", r.URL.RawQuery)
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 0, "totalCount": 0}, "servers": []}`)
+	})
+	defer teardown()
+	_, err := DedicatedServerApi{}.List(0, 10, "", "", "AMS-01")
+	assert.Nil(t, err)
+}
+
 func TestListPaginateAndFilter(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
@@ -499,20 +510,20 @@ func TestGet(t *testing.T) {
 	assert.Equal(Server.Contract.Id, "674382")
 	assert.Equal(Server.Contract.Reference, "database.server")
 	assert.Equal(Server.Contract.SalesOrgId, "2300")
-	assert.Equal(Server.Contract.StartsAt, "2014-01-01T01:00:00+0100")
+	assert.Equal(Server.Contract.StartsAt, MustTime("2014-01-01T01:00:00+0100"))
 	assert.Equal(Server.Contract.Sla, "BRONZE")
 
 	SoftwareLicense := Server.Contract.SoftwareLicenses[0]
 	assert.Equal(len(Server.Contract.SoftwareLicenses), 1)
 	assert.Equal(SoftwareLicense.Currency, "EUR")
 	assert.Equal(SoftwareLicense.Name, "WINDOWS_2012_R2_SERVER")
-	assert.Equal(SoftwareLicense.Price, float32(12.12))
+	assert.Equal(SoftwareLicense.Price, MustMoney("12.12", "EUR"))
 	assert.Equal(Server.Contract.BillingCycle, 12)
 	assert.Equal(Server.Contract.BillingFrequency, "MONTH")
 	assert.Equal(Server.Contract.ContractTerm, 12)
 	assert.Equal(Server.Contract.Currency, "EUR")
-	assert.Equal(Server.Contract.EndsAt, "2017-10-01T01:00:00+0100")
-	assert.Equal(Server.Contract.PricePerFrequency, 49)
+	assert.Equal(Server.Contract.EndsAt, MustTime("2017-10-01T01:00:00+0100"))
+	assert.Equal(Server.Contract.PricePerFrequency, MustMoney("49", "EUR"))
 	assert.Equal(Server.Contract.NetworkTraffic.DataTrafficLimit, 100)
 	assert.Equal(Server.Contract.NetworkTraffic.DataTrafficUnit, "TB")
 	assert.Equal(Server.Contract.NetworkTraffic.TrafficType, "PREMIUM")
@@ -971,7 +982,7 @@ func TestGetHardwareInformation(t *testing.T) {
 
 	assert.Equal(Server.Id, "2378237")
 	assert.Equal(Server.ParserVersion, "3.6")
-	assert.Equal(Server.ScannedAt, "2017-09-27T14:21:01Z")
+	assert.Equal(Server.ScannedAt, MustTime("2017-09-27T14:21:01Z"))
 	assert.Equal(Server.ServerId, "62264")
 
 	assert.Equal(Server.Result.Chassis.Description, "Rack Mount Chassis")
@@ -1967,11 +1978,11 @@ func TestListNullRouteHistory(t *testing.T) {
 	assert.Equal(len(response.NullRoutes), 1)
 
 	NullRoute := response.NullRoutes[0]
-	assert.Equal(NullRoute.AutomatedUnnullingAt, "2016-08-12T07:45:33+00:00")
+	assert.Equal(NullRoute.AutomatedUnnullingAt, MustTime("2016-08-12T07:45:33+00:00"))
 	assert.Equal(NullRoute.Comment, "Device Null Route related to DDoS Mitigation")
 	assert.Equal(NullRoute.Ip, "1.1.1.1/32")
 	assert.Equal(NullRoute.NullLevel, 3)
-	assert.Equal(NullRoute.NulledAt, "2016-08-12T07:40:27+00:00")
+	assert.Equal(NullRoute.NulledAt, MustTime("2016-08-12T07:40:27+00:00"))
 	assert.Equal(NullRoute.TicketId, "282912")
 }
 
@@ -2024,11 +2035,11 @@ func TestListNullRouteHistoryFilterAndPagination(t *testing.T) {
 	assert.Equal(len(response.NullRoutes), 1)
 
 	NullRoute := response.NullRoutes[0]
-	assert.Equal(NullRoute.AutomatedUnnullingAt, "2016-08-12T07:45:33+00:00")
+	assert.Equal(NullRoute.AutomatedUnnullingAt, MustTime("2016-08-12T07:45:33+00:00"))
 	assert.Equal(NullRoute.Comment, "Device Null Route related to DDoS Mitigation")
 	assert.Equal(NullRoute.Ip, "1.1.1.1/32")
 	assert.Equal(NullRoute.NullLevel, 3)
-	assert.Equal(NullRoute.NulledAt, "2016-08-12T07:40:27+00:00")
+	assert.Equal(NullRoute.NulledAt, MustTime("2016-08-12T07:40:27+00:00"))
 	assert.Equal(NullRoute.TicketId, "282912")
 }
 
@@ -3259,7 +3270,7 @@ func TestListDhcpReservation(t *testing.T) {
 
 	Lease1 := response.Leases[0]
 	assert.Equal(Lease1.BootFile, "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe")
-	assert.Equal(Lease1.CreatedAt, "2019-10-18T17:31:01+00:00")
+	assert.Equal(Lease1.CreatedAt, MustTime("2019-10-18T17:31:01+00:00"))
 	assert.Equal(Lease1.Gateway, "192.168.0.254")
 	assert.Equal(Lease1.Hostname, "my-server")
 	assert.Equal(Lease1.Ip, "192.168.0.100")
@@ -3269,7 +3280,7 @@ func TestListDhcpReservation(t *testing.T) {
 	assert.Equal(Lease1.Mac, "AA:BB:CC:DD:EE:FF")
 	assert.Equal(Lease1.Netmask, "255.255.255.0")
 	assert.Equal(Lease1.Site, "AMS-01")
-	assert.Equal(Lease1.UpdatedAt, "2019-11-18T19:29:01+00:00")
+	assert.Equal(Lease1.UpdatedAt, MustTime("2019-11-18T19:29:01+00:00"))
 }
 
 func TestListDhcpReservationBeEmpty(t *testing.T) {
@@ -3330,7 +3341,7 @@ func TestListDhcpReservationPagination(t *testing.T) {
 
 	Lease1 := response.Leases[0]
 	assert.Equal(Lease1.BootFile, "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe")
-	assert.Equal(Lease1.CreatedAt, "2019-10-18T17:31:01+00:00")
+	assert.Equal(Lease1.CreatedAt, MustTime("2019-10-18T17:31:01+00:00"))
 	assert.Equal(Lease1.Gateway, "192.168.0.254")
 	assert.Equal(Lease1.Hostname, "my-server")
 	assert.Equal(Lease1.Ip, "192.168.0.100")
@@ -3340,7 +3351,7 @@ func TestListDhcpReservationPagination(t *testing.T) {
 	assert.Equal(Lease1.Mac, "AA:BB:CC:DD:EE:FF")
 	assert.Equal(Lease1.Netmask, "255.255.255.0")
 	assert.Equal(Lease1.Site, "AMS-01")
-	assert.Equal(Lease1.UpdatedAt, "2019-11-18T19:29:01+00:00")
+	assert.Equal(Lease1.UpdatedAt, MustTime("2019-11-18T19:29:01+00:00"))
 }
 
 func TestListDhcpReservationServerErrors(t *testing.T) {
@@ -3492,14 +3503,14 @@ func TestCancelActiveJob(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2021-01-09T08:54:06+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2021-01-09T08:54:06+0000"))
 	assert.Equal(Job.Flow, "#stop")
 	assert.Equal(Job.IsRunning, false)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "CANCELED")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2021-01-09T08:54:15+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2021-01-09T08:54:15+0000"))
 	assert.Equal(Job.Uuid, "c77d8a6b-d255-4744-8b95-8bf4af6f8b48")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -3709,14 +3720,14 @@ func TestExpireActiveJob(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2021-01-09T08:54:06+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2021-01-09T08:54:06+0000"))
 	assert.Equal(Job.Flow, "#stop")
 	assert.Equal(Job.IsRunning, false)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "CANCELED")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2021-01-09T08:54:15+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2021-01-09T08:54:15+0000"))
 	assert.Equal(Job.Uuid, "c77d8a6b-d255-4744-8b95-8bf4af6f8b48")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -3906,14 +3917,14 @@ func TestLunchHardwareScan(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2021-01-09T08:54:06+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2021-01-09T08:54:06+0000"))
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "hardwareScan")
-	assert.Equal(Job.UpdatedAt, "2021-01-09T08:54:15+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2021-01-09T08:54:15+0000"))
 	assert.Equal(Job.Uuid, "c77d8a6b-d255-4744-8b95-8bf4af6f8b48")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -4161,14 +4172,14 @@ func TestLunchInstallation(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2021-03-06T21:55:32+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2021-03-06T21:55:32+0000"))
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "AA:BB:CC:DD:EE:FF!DKFJKD8989")
 	assert.Equal(Job.ServerId, "12345")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2021-03-06T21:55:32+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2021-03-06T21:55:32+0000"))
 	assert.Equal(Job.Uuid, "bcf2bedf-8450-4b22-86a8-f30aeb3a38f9")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -4526,14 +4537,14 @@ func TestLunchIpmiRest(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2018-01-09T09:18:06+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2018-01-09T09:18:06+0000"))
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "ipmiReset")
-	assert.Equal(Job.UpdatedAt, "2018-01-09T09:18:06+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2018-01-09T09:18:06+0000"))
 	assert.Equal(Job.Uuid, "754154c2-cc7f-4d5f-b8bf-b654084ba4a9")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -4720,11 +4731,11 @@ func TestListJobs(t *testing.T) {
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
-	assert.Equal(Job.CreatedAt, "2018-01-09T10:38:12+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2018-01-09T10:38:12+0000"))
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2018-01-09T10:38:12+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2018-01-09T10:38:12+0000"))
 	assert.Equal(Job.Uuid, "3a867358-5b4b-44ee-88ac-4274603ef641")
 }
 
@@ -4803,11 +4814,11 @@ func TestListJobsPaginate(t *testing.T) {
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
-	assert.Equal(Job.CreatedAt, "2018-01-09T10:38:12+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2018-01-09T10:38:12+0000"))
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2018-01-09T10:38:12+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2018-01-09T10:38:12+0000"))
 	assert.Equal(Job.Uuid, "3a867358-5b4b-44ee-88ac-4274603ef641")
 }
 
@@ -4973,11 +4984,11 @@ func TestGetJob(t *testing.T) {
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
-	assert.Equal(Job.CreatedAt, "2021-01-09T10:38:12+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2021-01-09T10:38:12+0000"))
 	assert.Equal(Job.ServerId, "99944")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "install")
-	assert.Equal(Job.UpdatedAt, "2021-01-09T10:38:12+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2021-01-09T10:38:12+0000"))
 	assert.Equal(Job.Uuid, "3a867358-5b4b-44ee-88ac-4274603ef641")
 	assert.Equal(Job.Metadata.BatchId, "biannual-os-upgrade-installs")
 	assert.Equal(len(Job.Tasks), 1)
@@ -5163,14 +5174,14 @@ func TestLunchRescueMode(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(Job.CreatedAt, "2018-01-09T09:18:06+0000")
+	assert.Equal(Job.CreatedAt, MustTime("2018-01-09T09:18:06+0000"))
 	assert.Equal(Job.Flow, "tasks")
 	assert.Equal(Job.IsRunning, true)
 	assert.Equal(Job.Node, "80:18:44:E0:AF:C4!JGNTQ92")
 	assert.Equal(Job.ServerId, "2349839")
 	assert.Equal(Job.Status, "ACTIVE")
 	assert.Equal(Job.Type, "rescueMode")
-	assert.Equal(Job.UpdatedAt, "2018-01-09T09:18:06+0000")
+	assert.Equal(Job.UpdatedAt, MustTime("2018-01-09T09:18:06+0000"))
 	assert.Equal(Job.Uuid, "754154c2-cc7f-4d5f-b8bf-b654084ba4a9")
 
 	assert.Equal(len(Job.Tasks), 1)
@@ -6180,15 +6191,15 @@ func TestDedicatedServerGetBandWidthMetrics(t *testing.T) {
 	assert.Equal(Metric.Metadata.Granularity, "HOUR")
 	assert.Equal(Metric.Metric.DownPublic.Unit, "bps")
 	assert.Equal(Metric.Metric.DownPublic.Values[0].Value, 202499)
-	assert.Equal(Metric.Metric.DownPublic.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.DownPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.DownPublic.Values[1].Value, 29900)
-	assert.Equal(Metric.Metric.DownPublic.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.DownPublic.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 
 	assert.Equal(Metric.Metric.UpPublic.Unit, "bps")
 	assert.Equal(Metric.Metric.UpPublic.Values[0].Value, 43212393)
-	assert.Equal(Metric.Metric.UpPublic.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.UpPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.UpPublic.Values[1].Value, 12342929)
-	assert.Equal(Metric.Metric.UpPublic.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.UpPublic.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 }
 
 func TestDedicatedServerGetBandWidthMetricsServerErrors(t *testing.T) {
@@ -6334,15 +6345,15 @@ func TestDedicatedServerGetDataTrafficMetrics(t *testing.T) {
 	assert.Equal(Metric.Metadata.Granularity, "HOUR")
 	assert.Equal(Metric.Metric.DownPublic.Unit, "B")
 	assert.Equal(Metric.Metric.DownPublic.Values[0].Value, 202499)
-	assert.Equal(Metric.Metric.DownPublic.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.DownPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.DownPublic.Values[1].Value, 29900)
-	assert.Equal(Metric.Metric.DownPublic.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.DownPublic.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 
 	assert.Equal(Metric.Metric.UpPublic.Unit, "B")
 	assert.Equal(Metric.Metric.UpPublic.Values[0].Value, 43212393)
-	assert.Equal(Metric.Metric.UpPublic.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.UpPublic.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.UpPublic.Values[1].Value, 12342929)
-	assert.Equal(Metric.Metric.UpPublic.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.UpPublic.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 }
 
 func TestDedicatedServerGetDataTrafficMetricsServerErrors(t *testing.T) {
@@ -6488,22 +6499,22 @@ func TestListBandWidthNotificationSettings(t *testing.T) {
 	assert.Equal(resp.Metadata.Limit, 10)
 	assert.Equal(len(resp.Settings), 2)
 
-	assert.Equal(resp.Settings[0].Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Settings[0].Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Settings[0].Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Settings[0].Frequency, "WEEKLY")
 	assert.Equal(resp.Settings[0].Id, "12345")
-	assert.Equal(resp.Settings[0].LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[0].LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[0].Threshold, "1")
-	assert.Equal(resp.Settings[0].ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[0].ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[0].Unit, "Gbps")
 
-	assert.Equal(resp.Settings[1].Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Settings[1].Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Settings[1].Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Settings[1].Frequency, "DAILY")
 	assert.Equal(resp.Settings[1].Id, "123456")
-	assert.Equal(resp.Settings[1].LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[1].LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[1].Threshold, "1")
-	assert.Equal(resp.Settings[1].ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[1].ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[1].Unit, "Mbps")
 }
 
@@ -6545,13 +6556,13 @@ func TestListBandWidthNotificationSettingsPaginate(t *testing.T) {
 	assert.Equal(resp.Metadata.Limit, 10)
 	assert.Equal(len(resp.Settings), 1)
 
-	assert.Equal(resp.Settings[0].Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Settings[0].Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Settings[0].Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Settings[0].Frequency, "WEEKLY")
 	assert.Equal(resp.Settings[0].Id, "12345")
-	assert.Equal(resp.Settings[0].LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[0].LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[0].Threshold, "1")
-	assert.Equal(resp.Settings[0].ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.Settings[0].ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Settings[0].Unit, "Gbps")
 }
 
@@ -6671,13 +6682,13 @@ func TestCreateBandWidthNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "WEEKLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "1")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "Gbps")
 }
 
@@ -6901,13 +6912,13 @@ func TestGetBandWidthNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "WEEKLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "1")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "Gbps")
 }
 
@@ -7028,13 +7039,13 @@ func TestUpdateBandWidthNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "MONTHLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "2")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "Mbps")
 }
 
@@ -7369,13 +7380,13 @@ func TestCreateDataTrafficNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "WEEKLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "1")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "GB")
 }
 
@@ -7599,13 +7610,13 @@ func TestGetDataTrafficNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "WEEKLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "1")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "GB")
 }
 
@@ -7726,13 +7737,13 @@ func TestUpdateDataTrafficNotificationSetting(t *testing.T) {
 	assert := assert.New(t)
 	assert.Nil(err)
 
-	assert.Equal(resp.Actions[0].LastTriggeredAt, "2021-03-16T01:01:44+00:00")
+	assert.Equal(resp.Actions[0].LastTriggeredAt, MustTime("2021-03-16T01:01:44+00:00"))
 	assert.Equal(resp.Actions[0].Type, "EMAIL")
 	assert.Equal(resp.Frequency, "MONTHLY")
 	assert.Equal(resp.Id, "12345")
-	assert.Equal(resp.LastCheckedAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.LastCheckedAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Threshold, "2")
-	assert.Equal(resp.ThresholdExceededAt, "2021-03-16T01:01:41+00:00")
+	assert.Equal(resp.ThresholdExceededAt, MustTime("2021-03-16T01:01:41+00:00"))
 	assert.Equal(resp.Unit, "GB")
 }
 
@@ -0,0 +1,189 @@
+package leaseweb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Download struct {
+	io.ReadCloser
+	ContentType   string
+	Filename      string
+	ContentLength int64
+	Offset        int64
+}
+
+type DownloadFileOptions struct {
+	Resume   bool
+	Checksum string
+	Hash     func() hash.Hash
+}
+
+type DownloadedFile struct {
+	Path        string
+	ContentType string
+	Filename    string
+	Size        int64
+	Checksum    string
+}
+
+type ChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (cme *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s: expected %s, got %s", cme.Path, cme.Expected, cme.Actual)
+}
+
+type DownloadApi struct{}
+
+func (da DownloadApi) Download(ctx context.Context, path string) (*Download, error) {
+	return doDownload(ctx, path, 0)
+}
+
+func (da DownloadApi) DownloadFile(ctx context.Context, path string, dst string, options DownloadFileOptions) (*DownloadedFile, error) {
+	return downloadFile(ctx, path, dst, options)
+}
+
+func doDownload(ctx context.Context, endpoint string, offset int64) (*Download, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getBaseUrl()+endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("x-lsw-auth", lswClient.apiKey)
+	if offset > 0 {
+		req.Header.Add("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := lswClient.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		resp.Body.Close()
+		if resp.Header.Get("Content-Range") == fmt.Sprintf("bytes */%d", offset) {
+			return &Download{ReadCloser: http.NoBody, Offset: offset}, nil
+		}
+		return doDownload(ctx, endpoint, 0)
+	}
+
+	statusOK := resp.StatusCode >= 200 && resp.StatusCode < 300
+	if !statusOK {
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		lswErr := &LeasewebError{}
+		if err = json.Unmarshal(respBody, lswErr); err != nil || lswErr.ErrorMessage == "" {
+			return nil, &LeasewebError{ErrorCode: fmt.Sprint(resp.StatusCode), ErrorMessage: resp.Status}
+		}
+		return nil, lswErr
+	}
+
+	download := &Download{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		download.Filename = sanitizeFilename(params["filename"], "")
+	}
+	if resp.StatusCode == http.StatusPartialContent {
+		if !strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected Content-Range %q for offset %d", resp.Header.Get("Content-Range"), offset)
+		}
+		download.Offset = offset
+	}
+	return download, nil
+}
+
+func downloadFile(ctx context.Context, endpoint string, path string, options DownloadFileOptions) (*DownloadedFile, error) {
+	newHash := options.Hash
+	if newHash == nil {
+		newHash = sha256.New
+	}
+
+	partPath := path + ".part"
+	var offset int64
+	if options.Resume {
+		if info, err := os.Stat(partPath); err == nil {
+			offset = info.Size()
+		}
+	}
+
+	download, err := doDownload(ctx, endpoint, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer download.Close()
+
+	h := newHash()
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if download.Offset > 0 {
+		flags = os.O_WRONLY | os.O_APPEND
+		part, err := os.Open(partPath)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.CopyN(h, part, download.Offset)
+		part.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.OpenFile(partPath, flags, 0644)
+	if err != nil {
+		return nil, err
+	}
+	written, err := io.Copy(io.MultiWriter(file, h), download)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DownloadedFile{
+		Path:        path,
+		ContentType: download.ContentType,
+		Filename:    download.Filename,
+		Size:        download.Offset + written,
+		Checksum:    hex.EncodeToString(h.Sum(nil)),
+	}
+	if options.Checksum != "" && !strings.EqualFold(options.Checksum, result.Checksum) {
+		os.Remove(partPath)
+		return nil, &ChecksumMismatchError{Path: path, Expected: options.Checksum, Actual: result.Checksum}
+	}
+	if err = os.Rename(partPath, path); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func sanitizeFilename(name string, fallback string) string {
+	name = filepath.Base(filepath.Clean("/" + strings.ReplaceAll(name, "\\", "/")))
+	if name == "/" || name == "." {
+		return fallback
+	}
+	return name
+}
@@ -0,0 +1,300 @@
+package leaseweb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDownloadContent = "%PDF-1.4 this is a test document"
+
+func testDownloadChecksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func serveTestDownload(t *testing.T, ranges *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/invoices/v1/invoices/00000001/pdf", r.URL.Path)
+		*ranges = append(*ranges, r.Header.Get("Range"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="../00000001.pdf"`)
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(testDownloadContent))
+	}
+}
+
+func TestDoDownload(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	download, err := doDownload(context.Background(), "/invoices/v1/invoices/00000001/pdf", 0)
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.ContentType, "application/pdf")
+	assert.Equal(download.Filename, "00000001.pdf")
+	assert.Equal(download.ContentLength, int64(len(testDownloadContent)))
+	assert.Equal(download.Offset, int64(0))
+	body, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(body), testDownloadContent)
+	assert.Equal(ranges, []string{""})
+}
+
+func TestDoDownloadWithOffset(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	download, err := doDownload(context.Background(), "/invoices/v1/invoices/00000001/pdf", 9)
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.Offset, int64(9))
+	assert.Equal(download.ContentLength, int64(len(testDownloadContent)-9))
+	body, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(body), testDownloadContent[9:])
+	assert.Equal(ranges, []string{"bytes=9-"})
+}
+
+func TestDoDownloadServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"401","errorMessage":"You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return doDownload(context.Background(), "/invoices/v1/invoices/00000001/pdf", 0)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 404 without json body",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, "<html>Not Found</html>")
+			},
+			FunctionCall: func() (interface{}, error) {
+				return doDownload(context.Background(), "/invoices/v1/invoices/00000001/pdf", 0)
+			},
+			ExpectedError: LeasewebError{
+				ErrorCode:    "404",
+				ErrorMessage: "404 Not Found",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDownloadFile(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	result, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Checksum: strings.ToUpper(testDownloadChecksum(testDownloadContent))})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(result.Path, path)
+	assert.Equal(result.ContentType, "application/pdf")
+	assert.Equal(result.Filename, "00000001.pdf")
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	assert.Equal(result.Checksum, testDownloadChecksum(testDownloadContent))
+
+	content, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(string(content), testDownloadContent)
+	_, err = os.Stat(path + ".part")
+	assert.True(os.IsNotExist(err))
+}
+
+func TestDownloadFileResume(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	assert := assert.New(t)
+	assert.Nil(os.WriteFile(path+".part", []byte(testDownloadContent[:12]), 0644))
+
+	result, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true, Checksum: testDownloadChecksum(testDownloadContent)})
+	assert.Nil(err)
+	assert.Equal(ranges, []string{"bytes=12-"})
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	content, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(string(content), testDownloadContent)
+}
+
+func TestDownloadFileResumeCompletePart(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	assert := assert.New(t)
+	assert.Nil(os.WriteFile(path+".part", []byte(testDownloadContent), 0644))
+
+	result, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true, Checksum: testDownloadChecksum(testDownloadContent)})
+	assert.Nil(err)
+	assert.Equal(ranges, []string{fmt.Sprintf("bytes=%d-", len(testDownloadContent))})
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	content, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(string(content), testDownloadContent)
+}
+
+func TestDownloadFileResumeIgnoredByServer(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytes=13-", r.Header.Get("Range"))
+		fmt.Fprint(w, testDownloadContent)
+	})
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	assert := assert.New(t)
+	assert.Nil(os.WriteFile(path+".part", []byte("stale content"), 0644))
+
+	result, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true})
+	assert.Nil(err)
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	assert.Equal(result.Checksum, testDownloadChecksum(testDownloadContent))
+	content, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(string(content), testDownloadContent)
+}
+
+func TestDownloadFileChecksumMismatch(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	_, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Checksum: testDownloadChecksum("something else")})
+	assert := assert.New(t)
+	mismatch, ok := err.(*ChecksumMismatchError)
+	assert.True(ok)
+	assert.Equal(mismatch.Expected, testDownloadChecksum("something else"))
+	assert.Equal(mismatch.Actual, testDownloadChecksum(testDownloadContent))
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(path + ".part")
+	assert.True(os.IsNotExist(err))
+}
+
+func TestDownloadFileCancelled(t *testing.T) {
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", fmt.Sprint(2*len(testDownloadContent)))
+		fmt.Fprint(w, testDownloadContent)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	go func() {
+		for {
+			if info, err := os.Stat(path + ".part"); err == nil && info.Size() > 0 {
+				cancel()
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	_, err := downloadFile(cancelCtx, "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true})
+	assert := assert.New(t)
+	assert.ErrorIs(err, context.Canceled)
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(path + ".part")
+	assert.Nil(err)
+}
+
+func TestDownloadFileResumeStalePart(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "invoice.pdf")
+	assert := assert.New(t)
+	assert.Nil(os.WriteFile(path+".part", []byte(testDownloadContent+" and some stale bytes"), 0644))
+
+	result, err := downloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true})
+	assert.Nil(err)
+	assert.Equal(ranges, []string{fmt.Sprintf("bytes=%d-", len(testDownloadContent)+21), ""})
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	assert.Equal(result.Checksum, testDownloadChecksum(testDownloadContent))
+	content, err := os.ReadFile(path)
+	assert.Nil(err)
+	assert.Equal(string(content), testDownloadContent)
+}
+
+func TestDownloadApiDownload(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	download, err := DownloadApi{}.Download(context.Background(), "/invoices/v1/invoices/00000001/pdf")
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.Filename, "00000001.pdf")
+	body, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(body), testDownloadContent)
+}
+
+func TestDownloadApiDownloadFile(t *testing.T) {
+	ranges := []string{}
+	setup(serveTestDownload(t, &ranges))
+	defer teardown()
+
+	dir := t.TempDir()
+	assert := assert.New(t)
+	result, err := DownloadApi{}.DownloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", filepath.Join(dir, "invoice.pdf"), DownloadFileOptions{Checksum: testDownloadChecksum(testDownloadContent)})
+	assert.Nil(err)
+	assert.Equal(result.Checksum, testDownloadChecksum(testDownloadContent))
+
+	path := filepath.Join(dir, "resumed.pdf")
+	assert.Nil(os.WriteFile(path+".part", []byte("%PDF-1.4 corrupted"), 0644))
+	result, err = DownloadApi{}.DownloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true, Checksum: testDownloadChecksum(testDownloadContent)})
+	assert.Nil(result)
+	mismatch, ok := err.(*ChecksumMismatchError)
+	assert.True(ok)
+	assert.Equal(mismatch.Path, path)
+	assert.Equal(mismatch.Expected, testDownloadChecksum(testDownloadContent))
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(path + ".part")
+	assert.True(os.IsNotExist(err))
+
+	result, err = DownloadApi{}.DownloadFile(context.Background(), "/invoices/v1/invoices/00000001/pdf", path, DownloadFileOptions{Resume: true, Checksum: testDownloadChecksum(testDownloadContent)})
+	assert.Nil(err)
+	assert.Equal(result.Size, int64(len(testDownloadContent)))
+	assert.Equal(ranges, []string{"", "bytes=18-", ""})
+}
@@ -36,8 +36,8 @@ type FloatingIpDefinition struct {
 	FloatingIp string `json:"floatingIp"`
 	AnchorIp   string `json:"anchorIp"`
 	Status     string `json:"status"`
-	CreatedAt  string `json:"createdAt"`
-	UpdatedAt  string `json:"updatedAt"`
+	CreatedAt  Time   `json:"createdAt"`
+	UpdatedAt  Time   `json:"updatedAt"`
 }
 
 type FloatingIpDefinitions struct {
@@ -310,8 +310,8 @@ func TestListRangeDefinitions(t *testing.T) {
 	assert.Equal(floatingIpDefinition1.FloatingIp, "88.17.34.108/32")
 	assert.Equal(floatingIpDefinition1.AnchorIp, "95.10.126.1")
 	assert.Equal(floatingIpDefinition1.Status, "ACTIVE")
-	assert.Equal(floatingIpDefinition1.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(floatingIpDefinition1.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(floatingIpDefinition1.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(floatingIpDefinition1.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 
 	floatingIpDefinition2 := response.FloatingIpDefinitions[1]
 	assert.Equal(floatingIpDefinition2.Id, "88.17.34.109_32")
@@ -323,8 +323,8 @@ func TestListRangeDefinitions(t *testing.T) {
 	assert.Equal(floatingIpDefinition2.FloatingIp, "88.17.34.109/32")
 	assert.Equal(floatingIpDefinition2.AnchorIp, "95.10.126.12")
 	assert.Equal(floatingIpDefinition2.Status, "ACTIVE")
-	assert.Equal(floatingIpDefinition2.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(floatingIpDefinition2.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(floatingIpDefinition2.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(floatingIpDefinition2.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestListRangeDefinitionsPaginateAndFilter(t *testing.T) {
@@ -368,8 +368,8 @@ func TestListRangeDefinitionsPaginateAndFilter(t *testing.T) {
 	assert.Equal(floatingIpDefinition1.FloatingIp, "88.17.34.108/32")
 	assert.Equal(floatingIpDefinition1.AnchorIp, "95.10.126.1")
 	assert.Equal(floatingIpDefinition1.Status, "ACTIVE")
-	assert.Equal(floatingIpDefinition1.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(floatingIpDefinition1.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(floatingIpDefinition1.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(floatingIpDefinition1.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestListRangeDefinitionsServerErrors(t *testing.T) {
@@ -480,8 +480,8 @@ func TestCreateRangeDefinition(t *testing.T) {
 	assert.Equal(response.FloatingIp, "88.17.34.108/32")
 	assert.Equal(response.AnchorIp, "95.10.126.1")
 	assert.Equal(response.Status, "ACTIVE")
-	assert.Equal(response.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(response.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(response.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(response.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestCreateRangeDefinitionServerError(t *testing.T) {
@@ -591,8 +591,8 @@ func TestGetRangeDefinition(t *testing.T) {
 	assert.Equal(response.FloatingIp, "88.17.34.108/32")
 	assert.Equal(response.AnchorIp, "95.10.126.1")
 	assert.Equal(response.Status, "ACTIVE")
-	assert.Equal(response.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(response.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(response.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(response.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestGetRangeDefinitionServerErrors(t *testing.T) {
@@ -686,8 +686,8 @@ func TestUpdateRangeDefinition(t *testing.T) {
 	assert.Equal(response.FloatingIp, "88.17.34.108/32")
 	assert.Equal(response.AnchorIp, "95.10.126.1")
 	assert.Equal(response.Status, "ACTIVE")
-	assert.Equal(response.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(response.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(response.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(response.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestUpdateRangeDefinitionServerErrors(t *testing.T) {
@@ -798,8 +798,8 @@ func TestRemoveRangeDefinition(t *testing.T) {
 	assert.Equal(response.FloatingIp, "88.17.34.108/32")
 	assert.Equal(response.AnchorIp, "95.10.126.1")
 	assert.Equal(response.Status, "ACTIVE")
-	assert.Equal(response.CreatedAt, "2019-03-13T09:10:02+0000")
-	assert.Equal(response.UpdatedAt, "2019-03-13T09:10:02+0000")
+	assert.Equal(response.CreatedAt, MustTime("2019-03-13T09:10:02+0000"))
+	assert.Equal(response.UpdatedAt, MustTime("2019-03-13T09:10:02+0000"))
 }
 
 func TestRemoveRangeDefinitionServerErrors(t *testing.T) {
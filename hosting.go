@@ -0,0 +1,367 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const HOSTING_API_VERSION = "v2"
+
+type ResourceRecordSetType string
+
+const (
+	RESOURCE_RECORD_TYPE_A     ResourceRecordSetType = "A"
+	RESOURCE_RECORD_TYPE_AAAA  ResourceRecordSetType = "AAAA"
+	RESOURCE_RECORD_TYPE_CNAME ResourceRecordSetType = "CNAME"
+	RESOURCE_RECORD_TYPE_MX    ResourceRecordSetType = "MX"
+	RESOURCE_RECORD_TYPE_TXT   ResourceRecordSetType = "TXT"
+	RESOURCE_RECORD_TYPE_SRV   ResourceRecordSetType = "SRV"
+	RESOURCE_RECORD_TYPE_CAA   ResourceRecordSetType = "CAA"
+	RESOURCE_RECORD_TYPE_NS    ResourceRecordSetType = "NS"
+	RESOURCE_RECORD_TYPE_SOA   ResourceRecordSetType = "SOA"
+)
+
+var RESOURCE_RECORD_SET_TTLS = []int{60, 300, 1800, 3600, 14400, 28800, 43200, 86400}
+
+type HostingApi struct{}
+
+type HostingDomains struct {
+	Domains  []HostingDomain `json:"domains"`
+	Metadata Metadata        `json:"_metadata"`
+}
+
+type HostingDomain struct {
+	DomainName  string   `json:"domainName"`
+	ContractId  string   `json:"contractId"`
+	Status      string   `json:"status"`
+	Suspended   bool     `json:"suspended"`
+	Type        string   `json:"type"`
+	Nameservers []string `json:"nameservers"`
+	Dnssec      bool     `json:"dnssec"`
+}
+
+type ResourceRecordSets struct {
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	Metadata           Metadata            `json:"_metadata"`
+}
+
+type ResourceRecordSet struct {
+	Name     string                `json:"name"`
+	Type     ResourceRecordSetType `json:"type"`
+	Content  []string              `json:"content"`
+	Ttl      int                   `json:"ttl"`
+	Editable bool                  `json:"editable"`
+}
+
+type HostingDnssec struct {
+	Status  string             `json:"status"`
+	Nsec3   HostingDnssecNsec3 `json:"nsec3"`
+	DnsKeys []HostingDnsKey    `json:"dnsKeys"`
+}
+
+type HostingDnssecNsec3 struct {
+	Active bool `json:"active"`
+}
+
+type HostingDnsKey struct {
+	Flags     int    `json:"flags"`
+	Algorithm int    `json:"algorithm"`
+	PublicKey string `json:"publicKey"`
+}
+
+type InvalidResourceRecordSetError struct {
+	Name   string
+	Type   ResourceRecordSetType
+	Reason string
+}
+
+func (e *InvalidResourceRecordSetError) Error() string {
+	return fmt.Sprintf("invalid %s record set %q: %s", e.Type, e.Name, e.Reason)
+}
+
+func (rrs ResourceRecordSet) Validate() error {
+	invalid := func(format string, args ...interface{}) error {
+		return &InvalidResourceRecordSetError{Name: rrs.Name, Type: rrs.Type, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	if !isValidDomainName(strings.TrimPrefix(rrs.Name, "*.")) {
+		return invalid("name is not a valid domain name")
+	}
+	if rrs.Ttl < RESOURCE_RECORD_SET_TTLS[0] {
+		return invalid("ttl %d is below the minimum of %d", rrs.Ttl, RESOURCE_RECORD_SET_TTLS[0])
+	}
+	if !isValidResourceRecordSetTtl(rrs.Ttl) {
+		return invalid("ttl %d is not one of %v", rrs.Ttl, RESOURCE_RECORD_SET_TTLS)
+	}
+	if len(rrs.Content) == 0 {
+		return invalid("content is empty")
+	}
+
+	switch rrs.Type {
+	case RESOURCE_RECORD_TYPE_CNAME, RESOURCE_RECORD_TYPE_SOA:
+		if len(rrs.Content) > 1 {
+			return invalid("only one content value is allowed")
+		}
+	}
+
+	for _, content := range rrs.Content {
+		if err := validateResourceRecordContent(rrs.Type, content); err != nil {
+			return invalid("content %q: %v", content, err)
+		}
+	}
+	return nil
+}
+
+func validateResourceRecordContent(recordType ResourceRecordSetType, content string) error {
+	fields := strings.Fields(content)
+	switch recordType {
+	case RESOURCE_RECORD_TYPE_A:
+		if ip := net.ParseIP(content); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("not an IPv4 address")
+		}
+	case RESOURCE_RECORD_TYPE_AAAA:
+		if ip := net.ParseIP(content); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("not an IPv6 address")
+		}
+	case RESOURCE_RECORD_TYPE_CNAME, RESOURCE_RECORD_TYPE_NS:
+		if !isValidDomainName(content) {
+			return fmt.Errorf("not a valid domain name")
+		}
+	case RESOURCE_RECORD_TYPE_MX:
+		if len(fields) != 2 {
+			return fmt.Errorf("expected \"<priority> <exchange>\"")
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 16); err != nil {
+			return fmt.Errorf("priority %q is not a number between 0 and 65535", fields[0])
+		}
+		if !isValidDomainName(fields[1]) {
+			return fmt.Errorf("exchange %q is not a valid domain name", fields[1])
+		}
+	case RESOURCE_RECORD_TYPE_TXT:
+		if strings.TrimSpace(content) == "" {
+			return fmt.Errorf("text is empty")
+		}
+	case RESOURCE_RECORD_TYPE_SRV:
+		if len(fields) != 4 {
+			return fmt.Errorf("expected \"<priority> <weight> <port> <target>\"")
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if _, err := strconv.ParseUint(fields[i], 10, 16); err != nil {
+				return fmt.Errorf("%s %q is not a number between 0 and 65535", name, fields[i])
+			}
+		}
+		if fields[3] != "." && !isValidDomainName(fields[3]) {
+			return fmt.Errorf("target %q is not a valid domain name", fields[3])
+		}
+	case RESOURCE_RECORD_TYPE_CAA:
+		parts := strings.SplitN(content, " ", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("expected \"<flags> <tag> \\\"<value>\\\"\"")
+		}
+		if _, err := strconv.ParseUint(parts[0], 10, 8); err != nil {
+			return fmt.Errorf("flags %q is not a number between 0 and 255", parts[0])
+		}
+		switch parts[1] {
+		case "issue", "issuewild", "iodef":
+		default:
+			return fmt.Errorf("tag %q is not one of issue, issuewild or iodef", parts[1])
+		}
+		if len(parts[2]) < 2 || !strings.HasPrefix(parts[2], "\"") || !strings.HasSuffix(parts[2], "\"") {
+			return fmt.Errorf("value must be quoted")
+		}
+	case RESOURCE_RECORD_TYPE_SOA:
+		if len(fields) != 7 {
+			return fmt.Errorf("expected \"<mname> <rname> <serial> <refresh> <retry> <expire> <minimum>\"")
+		}
+		for _, field := range fields[2:] {
+			if _, err := strconv.ParseUint(field, 10, 32); err != nil {
+				return fmt.Errorf("%q is not a number", field)
+			}
+		}
+	default:
+		return fmt.Errorf("record type is not supported")
+	}
+	return nil
+}
+
+func isValidDomainName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isValidResourceRecordSetTtl(ttl int) bool {
+	for _, validTtl := range RESOURCE_RECORD_SET_TTLS {
+		if ttl == validTtl {
+			return true
+		}
+	}
+	return false
+}
+
+func (ha HostingApi) getPath(endpoint string) string {
+	return "/hosting/" + HOSTING_API_VERSION + endpoint
+}
+
+func (ha HostingApi) ListDomains(args ...int) (*HostingDomains, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+
+	path := ha.getPath("/domains?" + v.Encode())
+	result := &HostingDomains{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) GetDomain(domainName string) (*HostingDomain, error) {
+	path := ha.getPath("/domains/" + domainName)
+	result := &HostingDomain{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) ListResourceRecordSets(domainName string, args ...interface{}) (*ResourceRecordSets, error) {
+	v := url.Values{}
+	if len(args) >= 1 {
+		v.Add("offset", fmt.Sprint(args[0]))
+	}
+	if len(args) >= 2 {
+		v.Add("limit", fmt.Sprint(args[1]))
+	}
+	if len(args) >= 3 {
+		v.Add("type", fmt.Sprint(args[2]))
+	}
+
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets?" + v.Encode())
+	result := &ResourceRecordSets{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) CreateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error) {
+	if err := resourceRecordSet.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"name":    resourceRecordSet.Name,
+		"type":    resourceRecordSet.Type,
+		"content": resourceRecordSet.Content,
+		"ttl":     resourceRecordSet.Ttl,
+	}
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets")
+	result := &ResourceRecordSet{}
+	if err := doRequest(http.MethodPost, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) GetResourceRecordSet(domainName, name string, recordType ResourceRecordSetType) (*ResourceRecordSet, error) {
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/" + name + "/" + string(recordType))
+	result := &ResourceRecordSet{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) UpdateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error) {
+	if err := resourceRecordSet.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"content": resourceRecordSet.Content,
+		"ttl":     resourceRecordSet.Ttl,
+	}
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/" + resourceRecordSet.Name + "/" + string(resourceRecordSet.Type))
+	result := &ResourceRecordSet{}
+	if err := doRequest(http.MethodPut, path, result, payload); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) DeleteResourceRecordSet(domainName, name string, recordType ResourceRecordSetType) error {
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/" + name + "/" + string(recordType))
+	return doRequest(http.MethodDelete, path)
+}
+
+func (ha HostingApi) ValidateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) error {
+	if err := resourceRecordSet.Validate(); err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"name":    resourceRecordSet.Name,
+		"type":    resourceRecordSet.Type,
+		"content": resourceRecordSet.Content,
+		"ttl":     resourceRecordSet.Ttl,
+	}
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/validateSet")
+	return doRequest(http.MethodPost, path, nil, payload)
+}
+
+func (ha HostingApi) ValidateZone(domainName, bindZone string) error {
+	payload := map[string]string{"content": bindZone}
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/import/validate")
+	return doRequest(http.MethodPost, path, nil, payload)
+}
+
+func (ha HostingApi) ImportZone(domainName, bindZone string, overwrite bool) error {
+	payload := map[string]interface{}{
+		"content":   bindZone,
+		"overwrite": overwrite,
+	}
+	path := ha.getPath("/domains/" + domainName + "/resourceRecordSets/import")
+	return doRequest(http.MethodPost, path, nil, payload)
+}
+
+func (ha HostingApi) GetDnssec(domainName string) (*HostingDnssec, error) {
+	path := ha.getPath("/domains/" + domainName + "/dnssec")
+	result := &HostingDnssec{}
+	if err := doRequest(http.MethodGet, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (ha HostingApi) EnableDnssec(domainName string) error {
+	payload := map[string]string{"status": "ENABLED"}
+	path := ha.getPath("/domains/" + domainName + "/dnssec")
+	return doRequest(http.MethodPut, path, nil, payload)
+}
+
+func (ha HostingApi) DisableDnssec(domainName string) error {
+	payload := map[string]string{"status": "DISABLED"}
+	path := ha.getPath("/domains/" + domainName + "/dnssec")
+	return doRequest(http.MethodPut, path, nil, payload)
+}
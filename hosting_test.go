@@ -0,0 +1,1645 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostingListDomains(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"domains": [
+				{
+					"domainName": "example.com",
+					"contractId": "123456",
+					"status": "ACTIVE",
+					"suspended": false,
+					"type": "DOMAIN",
+					"nameservers": ["ns1.leaseweb.nl", "ns2.leaseweb.nl"],
+					"dnssec": true
+				},
+				{
+					"domainName": "example.org",
+					"status": "ACTIVE",
+					"suspended": true,
+					"type": "DNS_ONLY"
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := HostingApi{}.ListDomains()
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(response.Metadata.Offset, 0)
+	assert.Equal(response.Metadata.Limit, 10)
+	assert.Equal(len(response.Domains), 2)
+
+	assert.Equal(response.Domains[0].DomainName, "example.com")
+	assert.Equal(response.Domains[0].ContractId, "123456")
+	assert.Equal(response.Domains[0].Status, "ACTIVE")
+	assert.False(response.Domains[0].Suspended)
+	assert.Equal(response.Domains[0].Type, "DOMAIN")
+	assert.Equal(response.Domains[0].Nameservers, []string{"ns1.leaseweb.nl", "ns2.leaseweb.nl"})
+	assert.True(response.Domains[0].Dnssec)
+	assert.Equal(response.Domains[1].DomainName, "example.org")
+	assert.True(response.Domains[1].Suspended)
+	assert.Equal(response.Domains[1].Type, "DNS_ONLY")
+}
+
+func TestHostingListDomainsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListDomains()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListDomains()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListDomains()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListDomains()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListDomains()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingListDomainsPaginate(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "domains": [{"domainName": "example.com"}]}`)
+	})
+	defer teardown()
+
+	response, err := HostingApi{}.ListDomains(1, 10)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 11)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(len(response.Domains), 1)
+	assert.Equal(response.Domains[0].DomainName, "example.com")
+}
+
+func TestHostingGetDomain(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"domainName": "example.com",
+			"contractId": "123456",
+			"status": "ACTIVE",
+			"suspended": false,
+			"type": "DOMAIN",
+			"nameservers": ["ns1.leaseweb.nl"],
+			"dnssec": false
+		}`)
+	})
+	defer teardown()
+
+	domain, err := HostingApi{}.GetDomain("example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(domain.DomainName, "example.com")
+	assert.Equal(domain.ContractId, "123456")
+	assert.Equal(domain.Status, "ACTIVE")
+	assert.False(domain.Suspended)
+	assert.Equal(domain.Type, "DOMAIN")
+	assert.Equal(domain.Nameservers, []string{"ns1.leaseweb.nl"})
+	assert.False(domain.Dnssec)
+}
+
+func TestHostingGetDomainServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDomain("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDomain("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDomain("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDomain("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDomain("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingListResourceRecordSets(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"_metadata": {
+				"limit": 10,
+				"offset": 0,
+				"totalCount": 2
+			},
+			"resourceRecordSets": [
+				{
+					"name": "example.com.",
+					"type": "SOA",
+					"content": ["ns.leaseweb.nl. postmaster.leaseweb.nl. 2019010101 10800 3600 604800 3600"],
+					"ttl": 86400,
+					"editable": false
+				},
+				{
+					"name": "www.example.com.",
+					"type": "A",
+					"content": ["192.0.2.1", "192.0.2.2"],
+					"ttl": 3600,
+					"editable": true
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	response, err := HostingApi{}.ListResourceRecordSets("example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.TotalCount, 2)
+	assert.Equal(len(response.ResourceRecordSets), 2)
+
+	assert.Equal(response.ResourceRecordSets[0].Name, "example.com.")
+	assert.Equal(response.ResourceRecordSets[0].Type, RESOURCE_RECORD_TYPE_SOA)
+	assert.Equal(response.ResourceRecordSets[0].Content, []string{"ns.leaseweb.nl. postmaster.leaseweb.nl. 2019010101 10800 3600 604800 3600"})
+	assert.Equal(response.ResourceRecordSets[0].Ttl, 86400)
+	assert.False(response.ResourceRecordSets[0].Editable)
+	assert.Equal(response.ResourceRecordSets[1].Name, "www.example.com.")
+	assert.Equal(response.ResourceRecordSets[1].Type, RESOURCE_RECORD_TYPE_A)
+	assert.Equal(response.ResourceRecordSets[1].Content, []string{"192.0.2.1", "192.0.2.2"})
+	assert.Equal(response.ResourceRecordSets[1].Ttl, 3600)
+	assert.True(response.ResourceRecordSets[1].Editable)
+}
+
+func TestHostingListResourceRecordSetsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListResourceRecordSets("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListResourceRecordSets("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListResourceRecordSets("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListResourceRecordSets("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.ListResourceRecordSets("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingListResourceRecordSetsFilterAndPagination(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "MX", r.URL.Query().Get("type"))
+		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 2}, "resourceRecordSets": [{"name": "example.com.", "type": "MX", "content": ["10 mail.example.com."], "ttl": 3600}]}`)
+	})
+	defer teardown()
+
+	response, err := HostingApi{}.ListResourceRecordSets("example.com", 1, 10, RESOURCE_RECORD_TYPE_MX)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata.Offset, 1)
+	assert.Equal(len(response.ResourceRecordSets), 1)
+	assert.Equal(response.ResourceRecordSets[0].Type, RESOURCE_RECORD_TYPE_MX)
+}
+
+func TestHostingCreateResourceRecordSet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets", r.URL.Path)
+		payload := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]interface{}{
+			"name":    "example.com.",
+			"type":    "MX",
+			"content": []interface{}{"10 mail.example.com."},
+			"ttl":     float64(3600),
+		}, payload)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"name": "example.com.",
+			"type": "MX",
+			"content": ["10 mail.example.com."],
+			"ttl": 3600,
+			"editable": true
+		}`)
+	})
+	defer teardown()
+
+	resourceRecordSet := ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600}
+	resp, err := HostingApi{}.CreateResourceRecordSet("example.com", resourceRecordSet)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Name, "example.com.")
+	assert.Equal(resp.Type, RESOURCE_RECORD_TYPE_MX)
+	assert.Equal(resp.Content, []string{"10 mail.example.com."})
+	assert.Equal(resp.Ttl, 3600)
+	assert.True(resp.Editable)
+}
+
+func TestHostingCreateResourceRecordSetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.CreateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.CreateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.CreateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.CreateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.CreateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com."}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingCreateResourceRecordSetInvalid(t *testing.T) {
+	requests := 0
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	defer teardown()
+
+	resourceRecordSet := ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"2001:db8::1"}, Ttl: 3600}
+	resp, err := HostingApi{}.CreateResourceRecordSet("example.com", resourceRecordSet)
+	assert := assert.New(t)
+	assert.Nil(resp)
+	assert.Equal(err.Error(), `invalid A record set "www.example.com.": content "2001:db8::1": not an IPv4 address`)
+	assert.Equal(requests, 0)
+}
+
+func TestHostingGetResourceRecordSet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/www.example.com./A", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"name": "www.example.com.",
+			"type": "A",
+			"content": ["192.0.2.1"],
+			"ttl": 300,
+			"editable": true
+		}`)
+	})
+	defer teardown()
+
+	resp, err := HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Name, "www.example.com.")
+	assert.Equal(resp.Type, RESOURCE_RECORD_TYPE_A)
+	assert.Equal(resp.Content, []string{"192.0.2.1"})
+	assert.Equal(resp.Ttl, 300)
+}
+
+func TestHostingGetResourceRecordSetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_A)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingUpdateResourceRecordSet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/www.example.com./AAAA", r.URL.Path)
+		payload := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]interface{}{
+			"content": []interface{}{"2001:db8::1"},
+			"ttl":     float64(60),
+		}, payload)
+		fmt.Fprintf(w, `{
+			"name": "www.example.com.",
+			"type": "AAAA",
+			"content": ["2001:db8::1"],
+			"ttl": 60,
+			"editable": true
+		}`)
+	})
+	defer teardown()
+
+	resourceRecordSet := ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60}
+	resp, err := HostingApi{}.UpdateResourceRecordSet("example.com", resourceRecordSet)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(resp.Type, RESOURCE_RECORD_TYPE_AAAA)
+	assert.Equal(resp.Content, []string{"2001:db8::1"})
+	assert.Equal(resp.Ttl, 60)
+}
+
+func TestHostingUpdateResourceRecordSetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.UpdateResourceRecordSet("example.com", ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.UpdateResourceRecordSet("example.com", ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.UpdateResourceRecordSet("example.com", ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.UpdateResourceRecordSet("example.com", ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.UpdateResourceRecordSet("example.com", ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 60})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingDeleteResourceRecordSet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/www.example.com./CNAME", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingDeleteResourceRecordSetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DeleteResourceRecordSet("example.com", "www.example.com.", RESOURCE_RECORD_TYPE_CNAME)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingValidateResourceRecordSet(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/validateSet", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	resourceRecordSet := ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600}
+	err := HostingApi{}.ValidateResourceRecordSet("example.com", resourceRecordSet)
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingValidateResourceRecordSetServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateResourceRecordSet("example.com", ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 -all\""}, Ttl: 3600})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingValidateZone(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/import/validate", r.URL.Path)
+		payload := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "www 3600 IN A 192.0.2.1\n", payload["content"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingValidateZoneServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ValidateZone("example.com", "www 3600 IN A 192.0.2.1\n")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingImportZone(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/resourceRecordSets/import", r.URL.Path)
+		payload := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "www 3600 IN A 192.0.2.1\n", payload["content"])
+		assert.Equal(t, true, payload["overwrite"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingImportZoneServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.ImportZone("example.com", "www 3600 IN A 192.0.2.1\n", true)
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingGetDnssec(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/dnssec", r.URL.Path)
+		fmt.Fprintf(w, `{
+			"status": "ENABLED",
+			"nsec3": {
+				"active": true
+			},
+			"dnsKeys": [
+				{
+					"flags": 257,
+					"algorithm": 13,
+					"publicKey": "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ=="
+				}
+			]
+		}`)
+	})
+	defer teardown()
+
+	dnssec, err := HostingApi{}.GetDnssec("example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(dnssec.Status, "ENABLED")
+	assert.True(dnssec.Nsec3.Active)
+	assert.Equal(len(dnssec.DnsKeys), 1)
+	assert.Equal(dnssec.DnsKeys[0].Flags, 257)
+	assert.Equal(dnssec.DnsKeys[0].Algorithm, 13)
+	assert.Equal(dnssec.DnsKeys[0].PublicKey, "mdsswUyr3DPW132mOi8V9xESWE8jTo0dxCjjnopKl+GqJxpVXckHAeF+KkxLbxILfDLUT0rAK9iUzy1L53eKGQ==")
+}
+
+func TestHostingGetDnssecServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return HostingApi{}.GetDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingEnableDnssec(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/dnssec", r.URL.Path)
+		payload := map[string]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]string{"status": "ENABLED"}, payload)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := HostingApi{}.EnableDnssec("example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingEnableDnssecServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.EnableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.EnableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.EnableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.EnableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.EnableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestHostingDisableDnssec(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/hosting/v2/domains/example.com/dnssec", r.URL.Path)
+		payload := map[string]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]string{"status": "DISABLED"}, payload)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+
+	err := HostingApi{}.DisableDnssec("example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+}
+
+func TestHostingDisableDnssecServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DisableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DisableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DisableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DisableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return nil, HostingApi{}.DisableDnssec("example.com")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestResourceRecordSetValidate(t *testing.T) {
+	validSets := []ResourceRecordSet{
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1", "192.0.2.2"}, Ttl: 300},
+		{Name: "*.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1"}, Ttl: 60},
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 3600},
+		{Name: "ftp.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com.", "20 backup.example.com."}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 include:_spf.example.com -all\""}, Ttl: 3600},
+		{Name: "_sip._tcp.example.com.", Type: RESOURCE_RECORD_TYPE_SRV, Content: []string{"10 60 5060 sip.example.com."}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_CAA, Content: []string{"0 issue \"letsencrypt.org\"", "0 iodef \"mailto:security@example.com\""}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_NS, Content: []string{"ns1.leaseweb.nl."}, Ttl: 86400},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_SOA, Content: []string{"ns.leaseweb.nl. postmaster.leaseweb.nl. 2019010101 10800 3600 604800 3600"}, Ttl: 86400},
+	}
+	for _, resourceRecordSet := range validSets {
+		assert.Nil(t, resourceRecordSet.Validate(), "%s %s", resourceRecordSet.Type, resourceRecordSet.Name)
+	}
+
+	invalidSets := []struct {
+		ResourceRecordSet ResourceRecordSet
+		Reason            string
+	}{
+		{ResourceRecordSet{Name: "www..example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1"}, Ttl: 300}, "name is not a valid domain name"},
+		{ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1"}, Ttl: 120}, "ttl 120 is not one of [60 300 1800 3600 14400 28800 43200 86400]"},
+		{ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Ttl: 300}, "content is empty"},
+		{ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.300"}, Ttl: 300}, `content "192.0.2.300": not an IPv4 address`},
+		{ResourceRecordSet{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"192.0.2.1"}, Ttl: 300}, `content "192.0.2.1": not an IPv6 address`},
+		{ResourceRecordSet{Name: "ftp.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"a.example.com.", "b.example.com."}, Ttl: 300}, "only one content value is allowed"},
+		{ResourceRecordSet{Name: "ftp.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"-bad.example.com."}, Ttl: 300}, `content "-bad.example.com.": not a valid domain name`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"mail.example.com."}, Ttl: 300}, `content "mail.example.com.": expected "<priority> <exchange>"`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"70000 mail.example.com."}, Ttl: 300}, `content "70000 mail.example.com.": priority "70000" is not a number between 0 and 65535`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{" "}, Ttl: 300}, `content " ": text is empty`},
+		{ResourceRecordSet{Name: "_sip._tcp.example.com.", Type: RESOURCE_RECORD_TYPE_SRV, Content: []string{"10 60 sip.example.com."}, Ttl: 300}, `content "10 60 sip.example.com.": expected "<priority> <weight> <port> <target>"`},
+		{ResourceRecordSet{Name: "_sip._tcp.example.com.", Type: RESOURCE_RECORD_TYPE_SRV, Content: []string{"10 60 port sip.example.com."}, Ttl: 300}, `content "10 60 port sip.example.com.": port "port" is not a number between 0 and 65535`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_CAA, Content: []string{"0 issuer \"letsencrypt.org\""}, Ttl: 300}, `content "0 issuer \"letsencrypt.org\"": tag "issuer" is not one of issue, issuewild or iodef`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_CAA, Content: []string{"0 issue letsencrypt.org"}, Ttl: 300}, `content "0 issue letsencrypt.org": value must be quoted`},
+		{ResourceRecordSet{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_SOA, Content: []string{"ns.leaseweb.nl. postmaster.leaseweb.nl. 1"}, Ttl: 300}, `content "ns.leaseweb.nl. postmaster.leaseweb.nl. 1": expected "<mname> <rname> <serial> <refresh> <retry> <expire> <minimum>"`},
+		{ResourceRecordSet{Name: "example.com.", Type: "PTR", Content: []string{"host.example.com."}, Ttl: 300}, `content "host.example.com.": record type is not supported`},
+	}
+	for _, invalidSet := range invalidSets {
+		err := invalidSet.ResourceRecordSet.Validate()
+		validationErr := &InvalidResourceRecordSetError{}
+		if assert.True(t, errors.As(err, &validationErr), invalidSet.Reason) {
+			assert.Equal(t, invalidSet.ResourceRecordSet.Name, validationErr.Name)
+			assert.Equal(t, invalidSet.ResourceRecordSet.Type, validationErr.Type)
+			assert.Equal(t, invalidSet.Reason, validationErr.Reason)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package leaseweb
+
+import "context"
+
+var (
+	_ AbuseService             = AbuseApi{}
+	_ CustomerAccountService   = CustomerAccountApi{}
+	_ DedicatedRackService     = DedicatedRackApi{}
+	_ DedicatedServerService   = DedicatedServerApi{}
+	_ DownloadService          = DownloadApi{}
+	_ FloatingIpService        = FloatingIpApi{}
+	_ HostingService           = HostingApi{}
+	_ InvoiceService           = InvoiceApi{}
+	_ IpManagementService      = IpManagementApi{}
+	_ NetworkEquipmentService  = NetworkEquipmentApi{}
+	_ PrivateCloudService      = PrivateCloudApi{}
+	_ PrivateNetworkingService = PrivateNetworkingApi{}
+	_ RemoteManagementService  = RemoteManagementApi{}
+	_ ServicesService          = ServicesApi{}
+	_ VirtualServerService     = VirtualServerApi{}
+)
+
+type AbuseService interface {
+	ListAbuseReports(args ...interface{}) (*AbuseReports, error)
+	GetAbuseReport(abuseReportId string) (*AbuseReport, error)
+	GetAbuseReportMessages(abuseReportId string, args ...int) (*AbuseMessages, error)
+	CreateNewAbuseReportMessage(abuseReportId string, body string) ([]string, error)
+	ListResolutionOptions(abuseReportId string) (*Resolutions, error)
+	ResolveAbuseReport(abuseReportId string, resolutions []string) error
+	GetAbuseReportAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*Download, error)
+	GetAbuseReportMessageAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*Download, error)
+	SaveAbuseReportAttachments(ctx context.Context, abuseReportId string, dir string) ([]DownloadedFile, error)
+}
+
+type CustomerAccountService interface {
+	GetCustomerAccount() (*CustomerAccount, error)
+	UpdateCustomerAccount(ad Address) error
+	ListContacts(args ...interface{}) (*Contacts, error)
+	CreateContact(newContact Contact) (*Contact, error)
+	DeleteContact(contactId string) error
+	GetContact(contactId string) (*Contact, error)
+	UpdateContact(contactId string, phone Phone, roles []string, args ...interface{}) error
+	AssignPrimaryRolesToContact(contactId string, roles []string) error
+}
+
+type DedicatedRackService interface {
+	List(args ...int) (*DedicatedRacks, error)
+	Get(rackId string) (*DedicatedRack, error)
+	Update(rackId, reference string) error
+	ListCredentials(rackId string, args ...int) (*DedicatedServerCredentials, error)
+	CreateCredential(rackId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	ListCredentialsByType(rackId, credentialType string, args ...int) (*DedicatedServerCredentials, error)
+	GetCredential(rackId, credentialType, username string) (*DedicatedServerCredential, error)
+	DeleteCredential(rackId, credentialType, username string) error
+	UpdateCredential(rackId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	GetDataTrafficMetrics(rackId string, args ...interface{}) (*DedicatedServerDataTrafficMetrics, error)
+	GetBandWidthMetrics(rackId string, args ...interface{}) (*BandWidthMetrics, error)
+	ListBandWidthNotificationSettings(rackId string, args ...int) (*BandWidthNotificationSettings, error)
+	CreateBandWidthNotificationSetting(rackId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error)
+	DeleteBandWidthNotificationSetting(rackId, notificationSettingId string) error
+	GetBandWidthNotificationSetting(rackId, notificationSettingId string) (*DedicatedServerNotificationSetting, error)
+	UpdateBandWidthNotificationSetting(rackId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error)
+	ListDataTrafficNotificationSettings(rackId string, args ...int) (*DataTrafficNotificationSettings, error)
+	CreateDataTrafficNotificationSetting(rackId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error)
+	DeleteDataTrafficNotificationSetting(rackId, notificationSettingId string) error
+	GetDataTrafficNotificationSetting(rackId, notificationSettingId string) (*DedicatedServerNotificationSetting, error)
+	UpdateDataTrafficNotificationSetting(rackId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error)
+	GetDdosNotificationSetting(rackId string) (*DedicatedServerDdosNotificationSetting, error)
+	UpdateDdosNotificationSetting(rackId string, payload map[string]string) error
+	ListNullRouteHistory(rackId string, args ...int) (*DedicatedServerNullRoutes, error)
+}
+
+type DedicatedServerService interface {
+	List(args ...interface{}) (*DedicatedServers, error)
+	Get(serverId string) (*DedicatedServer, error)
+	Update(serverId string, payload map[string]interface{}) error
+	GetHardwareInformation(serverId string) (*DedicatedServerHardware, error)
+	ListIps(serverId string, args ...interface{}) (*DedicatedServerIps, error)
+	GetIp(serverId, ip string) (*DedicatedServerIp, error)
+	UpdateIp(serverId, ip string, payload map[string]string) (*DedicatedServerIp, error)
+	NullRouteAnIp(serverId, ip string) (*DedicatedServerIp, error)
+	RemoveNullRouteAnIp(serverId, ip string) (*DedicatedServerIp, error)
+	ListNullRouteHistory(serverId string, args ...int) (*DedicatedServerNullRoutes, error)
+	ListNetworkInterfaces(serverId string, args ...interface{}) (*DedicatedServerNetworkInterfaces, error)
+	CloseAllNetworkInterfaces(serverId string) error
+	OpenAllNetworkInterfaces(serverId string) error
+	GetNetworkInterface(serverId, networkType string) (*DedicatedServerNetworkInterface, error)
+	CloseNetworkInterface(serverId, networkType string) error
+	OpenNetworkInterface(serverId, networkType string) error
+	DeleteServerFromPrivateNetwork(serverId, privateNetworkId string) error
+	AddServerToPrivateNetwork(serverId, privateNetworkId string, linkSpeed int) error
+	DeleteDhcpReservation(serverId string) error
+	ListDhcpReservation(serverId string, args ...interface{}) (*DedicatedServerDhcpReservations, error)
+	CreateDhcpReservation(serverId string, payload map[string]string) error
+	CancelActiveJob(serverId string) (*DedicatedServerJob, error)
+	ExpireActiveJob(serverId string) (*DedicatedServerJob, error)
+	LunchHardwareScan(serverId string, payload map[string]interface{}) (*DedicatedServerJob, error)
+	LunchInstallation(serverId string, payload map[string]interface{}) (*DedicatedServerJob, error)
+	LunchIpmiRest(serverId string, payload map[string]interface{}) (*DedicatedServerJob, error)
+	ListJobs(serverId string, args ...int) (*DedicatedServerJobs, error)
+	GetJob(serverId, jobId string) (*DedicatedServerJob, error)
+	LunchRescueMode(serverId string, payload map[string]interface{}) (*DedicatedServerJob, error)
+	ListCredentials(serverId string, args ...int) (*DedicatedServerCredentials, error)
+	CreateCredential(serverId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	ListCredentialsByType(serverId, credentialType string, args ...int) (*DedicatedServerCredentials, error)
+	GetCredential(serverId, credentialType, username string) (*DedicatedServerCredential, error)
+	DeleteCredential(serverId, credentialType, username string) error
+	UpdateCredential(serverId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	GetDataTrafficMetrics(serverId string, args ...interface{}) (*DedicatedServerDataTrafficMetrics, error)
+	GetBandWidthMetrics(serverId string, args ...interface{}) (*BandWidthMetrics, error)
+	ListBandWidthNotificationSettings(serverId string, args ...int) (*BandWidthNotificationSettings, error)
+	CreateBandWidthNotificationSetting(serverId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error)
+	DeleteBandWidthNotificationSetting(serverId, notificationId string) error
+	GetBandWidthNotificationSetting(serverId, notificationId string) (*DedicatedServerNotificationSetting, error)
+	UpdateBandWidthNotificationSetting(serverId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error)
+	ListDataTrafficNotificationSettings(serverId string, args ...int) (*DataTrafficNotificationSettings, error)
+	CreateDataTrafficNotificationSetting(serverId, frequency, threshold, unit string) (*DedicatedServerNotificationSetting, error)
+	DeleteDataTrafficNotificationSetting(serverId, notificationId string) error
+	GetDataTrafficNotificationSetting(serverId, notificationId string) (*DedicatedServerNotificationSetting, error)
+	UpdateDataTrafficNotificationSetting(serverId, notificationSettingId string, payload map[string]string) (*DedicatedServerNotificationSetting, error)
+	GetDdosNotificationSetting(serverId string) (*DedicatedServerDdosNotificationSetting, error)
+	UpdateDdosNotificationSetting(serverId string, payload map[string]string) error
+	PowerCycleServer(serverId string) error
+	GetPowerStatus(serverId string) (*DedicatedServerPowerStatus, error)
+	PowerOffServer(serverId string) error
+	PowerOnServer(serverId string) error
+	ListOperatingSystems(args ...interface{}) (*OperatingSystems, error)
+	GetOperatingSystem(operatingSystemId, controlPanelId string) (*OperatingSystem, error)
+	ListControlPanels(args ...interface{}) (*ControlPanels, error)
+	ListRescueImages(args ...interface{}) (*RescueImages, error)
+}
+
+type DownloadService interface {
+	Download(ctx context.Context, path string) (*Download, error)
+	DownloadFile(ctx context.Context, path string, dst string, options DownloadFileOptions) (*DownloadedFile, error)
+}
+
+type FloatingIpService interface {
+	ListRanges(args ...interface{}) (*FloatingIpRanges, error)
+	GetRange(rangeId string) (*FloatingIpRange, error)
+	ListRangeDefinitions(rangeId string, args ...interface{}) (*FloatingIpDefinitions, error)
+	CreateRangeDefinition(rangeId string, floatingIp string, anchorIp string) (*FloatingIpDefinition, error)
+	GetRangeDefinition(rangeId string, floatingIpDefinitionId string) (*FloatingIpDefinition, error)
+	UpdateRangeDefinition(rangeId string, floatingIpDefinitionId string, anchorIp string) (*FloatingIpDefinition, error)
+	RemoveRangeDefinition(rangeId string, floatingIpDefinitionId string) (*FloatingIpDefinition, error)
+}
+
+type HostingService interface {
+	ListDomains(args ...int) (*HostingDomains, error)
+	GetDomain(domainName string) (*HostingDomain, error)
+	ListResourceRecordSets(domainName string, args ...interface{}) (*ResourceRecordSets, error)
+	CreateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error)
+	GetResourceRecordSet(domainName, name string, recordType ResourceRecordSetType) (*ResourceRecordSet, error)
+	UpdateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error)
+	DeleteResourceRecordSet(domainName, name string, recordType ResourceRecordSetType) error
+	ValidateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) error
+	ValidateZone(domainName, bindZone string) error
+	ImportZone(domainName, bindZone string, overwrite bool) error
+	GetDnssec(domainName string) (*HostingDnssec, error)
+	EnableDnssec(domainName string) error
+	DisableDnssec(domainName string) error
+}
+
+type InvoiceService interface {
+	ListInvoices(args ...int) (*Invoices, error)
+	GetProForma(args ...int) (*ProForma, error)
+	GetInvoice(invoiceId string) (*Invoice, error)
+	DownloadInvoicePdf(ctx context.Context, invoiceId string) (*Download, error)
+	SyncInvoiceArchive(ctx context.Context, dir string) (*InvoiceArchiveSync, error)
+}
+
+type IpManagementService interface {
+	ListIps(params ...map[string]interface{}) (*Ips, error)
+	GetIp(ip string) (*Ip, error)
+	UpdateIp(ip, reverseLookup string) (*Ip, error)
+	NullRouteIp(ip string, params ...map[string]string) (*NullRoute, error)
+	RemoveNullRouteIp(ip string) error
+	ListNullRouteHistory(params ...map[string]interface{}) (*NullRoutes, error)
+	GetNullRouteHistory(id string) (*NullRoute, error)
+	UpdateNullRouteIp(id string, params ...map[string]string) (*NullRoute, error)
+}
+
+type NetworkEquipmentService interface {
+	List(args ...interface{}) (*NetworkEquipments, error)
+	Get(networkEquipmentId string) (*NetworkEquipment, error)
+	Update(networkEquipmentId string, payload map[string]interface{}) error
+	ListIps(networkEquipmentId string, args ...interface{}) (*DedicatedServerIps, error)
+	GetIp(networkEquipmentId, ip string) (*DedicatedServerIp, error)
+	UpdateIp(networkEquipmentId, ip string, payload map[string]string) (*DedicatedServerIp, error)
+	NullRouteAnIp(networkEquipmentId, ip string) (*DedicatedServerIp, error)
+	RemoveNullRouteAnIp(networkEquipmentId, ip string) (*DedicatedServerIp, error)
+	ListNullRouteHistory(networkEquipmentId string, args ...int) (*DedicatedServerNullRoutes, error)
+	ListCredentials(networkEquipmentId string, args ...int) (*DedicatedServerCredentials, error)
+	CreateCredential(networkEquipmentId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	ListCredentialsByType(networkEquipmentId, credentialType string, args ...int) (*DedicatedServerCredentials, error)
+	GetCredential(networkEquipmentId, credentialType, username string) (*DedicatedServerCredential, error)
+	DeleteCredential(networkEquipmentId, credentialType, username string) error
+	UpdateCredential(networkEquipmentId, credentialType, username, password string) (*DedicatedServerCredential, error)
+	PowerCycleNetworkEquipment(networkEquipmentId string) error
+	GetPowerStatus(networkEquipmentId string) (*DedicatedServerPowerStatus, error)
+	PowerOffNetworkEquipment(networkEquipmentId string) error
+	PowerOnNetworkEquipment(networkEquipmentId string) error
+}
+
+type PrivateCloudService interface {
+	ListPrivateClouds(args ...interface{}) (*PrivateClouds, error)
+	GetPrivateCloud(privateCloudId string) (*PrivateCloud, error)
+	ListCredentials(privateCloudId string, credentialType string, args ...int) (*Credentials, error)
+	GetCredentials(privateCloudId string, credentialType string, username string) (*Credential, error)
+	GetDataTrafficMetrics(privateCloudId string, args ...interface{}) (*DataTrafficMetrics, error)
+	GetBandWidthMetrics(privateCloudId string, args ...interface{}) (*BandWidthMetrics, error)
+	GetCpuMetrics(privateCloudId string, args ...interface{}) (*CpuMetrics, error)
+	GetMemoryMetrics(privateCloudId string, args ...interface{}) (*MemoryMetrics, error)
+	GetStorageMetrics(privateCloudId string, args ...interface{}) (*StorageMetrics, error)
+}
+
+type PrivateNetworkingService interface {
+	ListPrivateNetworks(args ...int) (*PrivateNetworks, error)
+	CreatePrivateNetwork(name string) (*PrivateNetwork, error)
+	GetPrivateNetwork(id string) (*PrivateNetwork, error)
+	UpdatePrivateNetwork(id, name string) (*PrivateNetwork, error)
+	DeletePrivateNetwork(id string) error
+	ListDhcpReservations(id string, args ...int) (*DhcpReservations, error)
+	CreateDhcpReservation(id, ip, mac string, sticky bool) (*DhcpReservation, error)
+	DeleteDhcpReservation(id, ip string) error
+}
+
+type RemoteManagementService interface {
+	ChangeCredentials(password string) error
+	ListProfiles(args ...int) (*Profiles, error)
+	DownloadProfile(ctx context.Context, profile Profile) (*Download, error)
+	GetProfile(ctx context.Context, datacenter string) ([]byte, error)
+	FindProfile(site string) (*Profile, error)
+	WriteOpenVpnConfig(ctx context.Context, location Location, username, password, dir string) (*OpenVpnConfig, error)
+}
+
+type ServicesService interface {
+	ListServices(args ...int) (*Services, error)
+	ListServicesWithFilter(filter ServiceFilter, args ...int) (*Services, error)
+	ListAllServices(filter ServiceFilter) ([]Service, error)
+	ListCancellationReasons() (*CancellationReasons, error)
+	GetService(id string) (*Service, error)
+	CancelService(id, reason, reasonCode string) error
+	UncancelService(id string) error
+}
+
+type VirtualServerService interface {
+	ListVirtualServers(args ...int) (*VirtualServers, error)
+	GetVirtualServer(virtualServerId string) (*VirtualServer, error)
+	UpdateVirtualServer(virtualServerId, reference string) (*VirtualServer, error)
+	PowerOn(virtualServerId string) (*VirtualServerResult, error)
+	PowerOff(virtualServerId string) (*VirtualServerResult, error)
+	Reboot(virtualServerId string) (*VirtualServerResult, error)
+	Reinstall(virtualServerId, operatingSystemId string) (*VirtualServerResult, error)
+	UpdateCredential(virtualServerId, username, credentialType, password string) error
+	ListCredentials(virtualServerId, credentialType string, args ...int) (*Credentials, error)
+	GetCredential(virtualServerId, username, credentialType string) (*Credential, error)
+	GetDataTrafficMetrics(virtualServerId string, args ...interface{}) (*DataTrafficMetrics, error)
+	ListTemplates(virtualServerId string, args ...int) (*Templates, error)
+}
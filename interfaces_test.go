@@ -0,0 +1,36 @@
+package leaseweb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceInterfacesAreComplete(t *testing.T) {
+	services := map[reflect.Type]interface{}{
+		reflect.TypeOf((*AbuseService)(nil)).Elem():             AbuseApi{},
+		reflect.TypeOf((*CustomerAccountService)(nil)).Elem():   CustomerAccountApi{},
+		reflect.TypeOf((*DedicatedRackService)(nil)).Elem():     DedicatedRackApi{},
+		reflect.TypeOf((*DedicatedServerService)(nil)).Elem():   DedicatedServerApi{},
+		reflect.TypeOf((*DownloadService)(nil)).Elem():          DownloadApi{},
+		reflect.TypeOf((*FloatingIpService)(nil)).Elem():        FloatingIpApi{},
+		reflect.TypeOf((*HostingService)(nil)).Elem():           HostingApi{},
+		reflect.TypeOf((*InvoiceService)(nil)).Elem():           InvoiceApi{},
+		reflect.TypeOf((*IpManagementService)(nil)).Elem():      IpManagementApi{},
+		reflect.TypeOf((*NetworkEquipmentService)(nil)).Elem():  NetworkEquipmentApi{},
+		reflect.TypeOf((*PrivateCloudService)(nil)).Elem():      PrivateCloudApi{},
+		reflect.TypeOf((*PrivateNetworkingService)(nil)).Elem(): PrivateNetworkingApi{},
+		reflect.TypeOf((*RemoteManagementService)(nil)).Elem():  RemoteManagementApi{},
+		reflect.TypeOf((*ServicesService)(nil)).Elem():          ServicesApi{},
+		reflect.TypeOf((*VirtualServerService)(nil)).Elem():     VirtualServerApi{},
+	}
+	for service, api := range services {
+		apiType := reflect.TypeOf(api)
+		for i := 0; i < apiType.NumMethod(); i++ {
+			method := apiType.Method(i)
+			_, ok := service.MethodByName(method.Name)
+			assert.True(t, ok, "%s is missing %s", service.Name(), method.Name)
+		}
+	}
+}
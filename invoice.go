@@ -1,56 +1,64 @@
 package leaseweb
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 )
 
-const INVOICE_API_VERSION = "v1"
+const (
+	INVOICE_API_VERSION = "v1"
+	INVOICE_PAGE_LIMIT  = 50
+)
 
 type InvoiceApi struct{}
 
 type Invoice struct {
 	Currency                string   `json:"currency"`
-	Date                    string   `json:"date"`
-	DueDate                 string   `json:"dueDate"`
+	Date                    Time     `json:"date"`
+	DueDate                 Time     `json:"dueDate"`
 	Id                      string   `json:"id"`
 	IsPartialPaymentAllowed bool     `json:"isPartialPaymentAllowed"`
-	OpenAmount              float32  `json:"openAmount"`
+	OpenAmount              Money    `json:"openAmount"`
 	Status                  string   `json:"status"`
-	TaxAmount               float32  `json:"taxAmount"`
-	Total                   float32  `json:"total"`
+	TaxAmount               Money    `json:"taxAmount"`
+	Total                   Money    `json:"total"`
 	Credits                 []Credit `json:"credits"`
 	Lines                   []Line   `json:"lineItems"`
 }
 
 type Credit struct {
-	Date      string  `json:"date"`
-	Id        string  `json:"id"`
-	TaxAmount float32 `json:"taxAmount"`
-	Total     float32 `json:"total"`
+	Date      Time   `json:"date"`
+	Id        string `json:"id"`
+	TaxAmount Money  `json:"taxAmount"`
+	Total     Money  `json:"total"`
 }
 
 type Line struct {
-	ContractId  string  `json:"contractId"`
-	EquipmentId string  `json:"equipmentId"`
-	Product     string  `json:"product"`
-	Quantity    int     `json:"quantity"`
-	Reference   string  `json:"reference"`
-	TotalAmount float32 `json:"totalAmount"`
-	UnitAmount  float32 `json:"unitAmount"`
+	ContractId  string `json:"contractId"`
+	EquipmentId string `json:"equipmentId"`
+	Product     string `json:"product"`
+	Quantity    int    `json:"quantity"`
+	Reference   string `json:"reference"`
+	TotalAmount Money  `json:"totalAmount"`
+	UnitAmount  Money  `json:"unitAmount"`
 }
 
 type Contract struct {
-	ContractId  string  `json:"contractId"`
-	Currency    string  `json:"currency"`
-	EndDate     string  `json:"endDate"`
-	EquipmentId string  `json:"equipmentId"`
-	PoNumber    string  `json:"poNumber"`
-	Price       float32 `json:"price"`
-	Product     string  `json:"product"`
-	Reference   string  `json:"reference"`
-	StartDate   string  `json:"startDate"`
+	ContractId  string `json:"contractId"`
+	Currency    string `json:"currency"`
+	EndDate     Time   `json:"endDate"`
+	EquipmentId string `json:"equipmentId"`
+	PoNumber    string `json:"poNumber"`
+	Price       Money  `json:"price"`
+	Product     string `json:"product"`
+	Reference   string `json:"reference"`
+	StartDate   Time   `json:"startDate"`
 }
 
 type Invoices struct {
@@ -58,16 +66,66 @@ type Invoices struct {
 	Metadata Metadata  `json:"_metadata"`
 }
 
+type InvoiceArchiveSync struct {
+	Downloaded []string
+	Skipped    []string
+}
+
 type ProForma struct {
 	Currency     string     `json:"currency"`
-	ProformaDate string     `json:"proformaDate"`
-	SubTotal     float32    `json:"subTotal"`
-	Total        float32    `json:"total"`
-	VatAmount    float32    `json:"vatAmount"`
+	ProformaDate Time       `json:"proformaDate"`
+	SubTotal     Money      `json:"subTotal"`
+	Total        Money      `json:"total"`
+	VatAmount    Money      `json:"vatAmount"`
 	Metadata     Metadata   `json:"_metadata"`
 	Contracts    []Contract `json:"contractItems"`
 }
 
+func (i *Invoice) UnmarshalJSON(b []byte) error {
+	type invoice Invoice
+	if err := json.Unmarshal(b, (*invoice)(i)); err != nil {
+		return err
+	}
+	i.OpenAmount = i.OpenAmount.WithCurrency(i.Currency)
+	i.TaxAmount = i.TaxAmount.WithCurrency(i.Currency)
+	i.Total = i.Total.WithCurrency(i.Currency)
+	for j := range i.Credits {
+		i.Credits[j].TaxAmount = i.Credits[j].TaxAmount.WithCurrency(i.Currency)
+		i.Credits[j].Total = i.Credits[j].Total.WithCurrency(i.Currency)
+	}
+	for j := range i.Lines {
+		i.Lines[j].TotalAmount = i.Lines[j].TotalAmount.WithCurrency(i.Currency)
+		i.Lines[j].UnitAmount = i.Lines[j].UnitAmount.WithCurrency(i.Currency)
+	}
+	return nil
+}
+
+func (c *Contract) UnmarshalJSON(b []byte) error {
+	type contract Contract
+	if err := json.Unmarshal(b, (*contract)(c)); err != nil {
+		return err
+	}
+	c.Price = c.Price.WithCurrency(c.Currency)
+	return nil
+}
+
+func (pf *ProForma) UnmarshalJSON(b []byte) error {
+	type proForma ProForma
+	if err := json.Unmarshal(b, (*proForma)(pf)); err != nil {
+		return err
+	}
+	pf.SubTotal = pf.SubTotal.WithCurrency(pf.Currency)
+	pf.Total = pf.Total.WithCurrency(pf.Currency)
+	pf.VatAmount = pf.VatAmount.WithCurrency(pf.Currency)
+	for i := range pf.Contracts {
+		if pf.Contracts[i].Currency == "" {
+			pf.Contracts[i].Currency = pf.Currency
+			pf.Contracts[i].Price = pf.Contracts[i].Price.WithCurrency(pf.Currency)
+		}
+	}
+	return nil
+}
+
 func (ia InvoiceApi) getPath(endpoint string) string {
 	return "/invoices/" + INVOICE_API_VERSION + endpoint
 }
@@ -114,3 +172,67 @@ func (ia InvoiceApi) GetInvoice(invoiceId string) (*Invoice, error) {
 	}
 	return result, nil
 }
+
+func (ia InvoiceApi) DownloadInvoicePdf(ctx context.Context, invoiceId string) (*Download, error) {
+	path := ia.getPath("/invoices/" + invoiceId + "/pdf")
+	return doDownload(ctx, path, 0)
+}
+
+func (ia InvoiceApi) SyncInvoiceArchive(ctx context.Context, dir string) (*InvoiceArchiveSync, error) {
+	invoices, err := listAllInvoices(ia)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	result := &InvoiceArchiveSync{}
+	for _, invoice := range invoices {
+		name := invoiceArchiveName(invoice)
+		full, err := ia.GetInvoice(invoice.Id)
+		if err != nil {
+			return result, err
+		}
+		sidecar, err := json.MarshalIndent(full, "", "  ")
+		if err != nil {
+			return result, err
+		}
+		if err = writeFileIfChanged(filepath.Join(dir, name+".json"), append(sidecar, '\n')); err != nil {
+			return result, err
+		}
+
+		pdfPath := filepath.Join(dir, name+".pdf")
+		if _, err = os.Stat(pdfPath); err == nil {
+			result.Skipped = append(result.Skipped, invoice.Id)
+			continue
+		}
+		path := ia.getPath("/invoices/" + invoice.Id + "/pdf")
+		if _, err = downloadFile(ctx, path, pdfPath, DownloadFileOptions{Resume: true}); err != nil {
+			return result, err
+		}
+		result.Downloaded = append(result.Downloaded, invoice.Id)
+	}
+	return result, nil
+}
+
+func listAllInvoices(service InvoiceService) ([]Invoice, error) {
+	return listAllPages(INVOICE_PAGE_LIMIT, func(offset, limit int) ([]Invoice, Metadata, error) {
+		invoices, err := service.ListInvoices(offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return invoices.Invoices, invoices.Metadata, nil
+	})
+}
+
+func invoiceArchiveName(invoice Invoice) string {
+	return sanitizeFilename(invoice.Date.DateString()+"_"+invoice.Id, invoice.Id)
+}
+
+func writeFileIfChanged(path string, content []byte) error {
+	if current, err := os.ReadFile(path); err == nil && bytes.Equal(current, content) {
+		return nil
+	}
+	return os.WriteFile(path, content, 0644)
+}
@@ -0,0 +1,373 @@
+package leaseweb
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	INVOICE_EXPORT_FORMAT_CSV        = "csv"
+	INVOICE_EXPORT_FORMAT_ACCOUNTING = "accounting"
+	INVOICE_ROW_TYPE_LINE            = "LINE"
+	INVOICE_ROW_TYPE_CREDIT          = "CREDIT"
+)
+
+var DEFAULT_INVOICE_TOTAL_TOLERANCE = MustMoney("0.01", "")
+
+var INVOICE_EXPORT_CSV_COLUMNS = []InvoiceExportColumn{
+	{Header: "invoiceId", Field: "invoiceId"},
+	{Header: "invoiceDate", Field: "invoiceDate"},
+	{Header: "dueDate", Field: "dueDate"},
+	{Header: "status", Field: "status"},
+	{Header: "type", Field: "type"},
+	{Header: "date", Field: "date"},
+	{Header: "creditId", Field: "creditId"},
+	{Header: "contractId", Field: "contractId"},
+	{Header: "equipmentId", Field: "equipmentId"},
+	{Header: "product", Field: "product"},
+	{Header: "reference", Field: "reference"},
+	{Header: "quantity", Field: "quantity"},
+	{Header: "unitAmount", Field: "unitAmount"},
+	{Header: "amount", Field: "amount"},
+	{Header: "taxAmount", Field: "taxAmount"},
+	{Header: "currency", Field: "currency"},
+	{Header: "invoiceTotal", Field: "invoiceTotal"},
+	{Header: "invoiceTaxAmount", Field: "invoiceTaxAmount"},
+}
+
+var INVOICE_EXPORT_ACCOUNTING_COLUMNS = []InvoiceExportColumn{
+	{Header: "Date", Field: "date"},
+	{Header: "Document", Field: "invoiceId"},
+	{Header: "Description", Field: "description"},
+	{Header: "Quantity", Field: "quantity"},
+	{Header: "Net", Field: "amount"},
+	{Header: "Tax", Field: "taxAmount"},
+	{Header: "Currency", Field: "currency"},
+}
+
+type InvoiceExportColumn struct {
+	Header string
+	Field  string
+}
+
+type InvoiceExportOptions struct {
+	Service          InvoiceService
+	Format           string
+	Columns          []InvoiceExportColumn
+	Comma            rune
+	DecimalSeparator string
+	From             time.Time
+	To               time.Time
+	Currency         string
+	ExchangeRates    map[string]string
+	Tolerance        *Money
+}
+
+type InvoiceExport struct {
+	Invoices      int
+	Rows          int
+	Discrepancies []InvoiceDiscrepancy
+}
+
+type InvoiceDiscrepancy struct {
+	InvoiceId string
+	Reason    string
+	Expected  Money
+	Actual    Money
+}
+
+type invoiceExportRow struct {
+	invoice Invoice
+	line    *Line
+	credit  *Credit
+	rate    *big.Rat
+}
+
+func (id InvoiceDiscrepancy) String() string {
+	return fmt.Sprintf("invoice %s: %s (expected %s, got %s)", id.InvoiceId, id.Reason, id.Expected.Format(2), id.Actual.Format(2))
+}
+
+func ExportInvoices(w io.Writer, options InvoiceExportOptions) (*InvoiceExport, error) {
+	if options.Service == nil {
+		options.Service = InvoiceApi{}
+	}
+	tolerance := DEFAULT_INVOICE_TOTAL_TOLERANCE
+	if options.Tolerance != nil {
+		if options.Tolerance.IsNegative() {
+			return nil, fmt.Errorf("invoice total tolerance %s must not be negative", options.Tolerance)
+		}
+		tolerance = *options.Tolerance
+	}
+	if options.ExchangeRates != nil {
+		exchangeRates := make(map[string]string, len(options.ExchangeRates))
+		for currency, rate := range options.ExchangeRates {
+			exchangeRates[strings.ToUpper(currency)] = rate
+		}
+		options.ExchangeRates = exchangeRates
+	}
+	switch options.Format {
+	case "", INVOICE_EXPORT_FORMAT_CSV:
+		if options.Columns == nil {
+			options.Columns = INVOICE_EXPORT_CSV_COLUMNS
+		}
+	case INVOICE_EXPORT_FORMAT_ACCOUNTING:
+		if options.Columns == nil {
+			options.Columns = INVOICE_EXPORT_ACCOUNTING_COLUMNS
+		}
+		if options.Comma == 0 {
+			options.Comma = ';'
+		}
+		if options.DecimalSeparator == "" {
+			options.DecimalSeparator = ","
+		}
+	default:
+		return nil, fmt.Errorf("unsupported invoice export format %q", options.Format)
+	}
+	for _, column := range options.Columns {
+		if _, err := (invoiceExportRow{}).field(column.Field, options); err != nil {
+			return nil, err
+		}
+	}
+
+	invoices, err := listAllInvoices(options.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	if options.Comma != 0 {
+		cw.Comma = options.Comma
+	}
+	header := make([]string, len(options.Columns))
+	for i, column := range options.Columns {
+		header[i] = column.Header
+	}
+	if err = cw.Write(header); err != nil {
+		return nil, err
+	}
+
+	result := &InvoiceExport{}
+	for _, listed := range invoices {
+		date := invoiceDay(listed.Date)
+		if (!options.From.IsZero() && date.Before(options.From)) || (!options.To.IsZero() && !date.Before(options.To)) {
+			continue
+		}
+
+		invoice, err := options.Service.GetInvoice(listed.Id)
+		if err != nil {
+			return result, err
+		}
+		rate, err := options.exchangeRate(invoice.Currency)
+		if err != nil {
+			return result, err
+		}
+
+		var rows []invoiceExportRow
+		for i := range invoice.Lines {
+			rows = append(rows, invoiceExportRow{invoice: *invoice, line: &invoice.Lines[i], rate: rate})
+		}
+		for i := range invoice.Credits {
+			rows = append(rows, invoiceExportRow{invoice: *invoice, credit: &invoice.Credits[i], rate: rate})
+		}
+		for _, row := range rows {
+			record := make([]string, len(options.Columns))
+			for i, column := range options.Columns {
+				if record[i], err = row.field(column.Field, options); err != nil {
+					return result, err
+				}
+			}
+			if err = cw.Write(record); err != nil {
+				return result, err
+			}
+		}
+
+		result.Invoices++
+		result.Rows += len(rows)
+		discrepancies, err := CheckInvoiceTotals(*invoice, tolerance)
+		if err != nil {
+			return result, err
+		}
+		result.Discrepancies = append(result.Discrepancies, discrepancies...)
+	}
+
+	cw.Flush()
+	return result, cw.Error()
+}
+
+func CheckInvoiceTotals(invoice Invoice, tolerance Money) ([]InvoiceDiscrepancy, error) {
+	var discrepancies []InvoiceDiscrepancy
+	add := func(reason string, expected Money, actual Money) error {
+		difference, err := expected.Sub(actual)
+		if err != nil {
+			return err
+		}
+		if difference.Abs().Cmp(tolerance) > 0 {
+			discrepancies = append(discrepancies, InvoiceDiscrepancy{InvoiceId: invoice.Id, Reason: reason, Expected: expected, Actual: actual})
+		}
+		return nil
+	}
+
+	var credited Money
+	for _, credit := range invoice.Credits {
+		if credit.TaxAmount.Abs().Cmp(credit.Total.Abs()) > 0 {
+			discrepancies = append(discrepancies, InvoiceDiscrepancy{InvoiceId: invoice.Id, Reason: fmt.Sprintf("credit %s tax exceeds its total", credit.Id), Expected: credit.Total, Actual: credit.TaxAmount})
+		}
+		var err error
+		if credited, err = credited.Add(credit.Total.Abs()); err != nil {
+			return nil, err
+		}
+	}
+	excess, err := credited.Sub(invoice.Total)
+	if err != nil {
+		return nil, err
+	}
+	if excess.Cmp(tolerance) > 0 {
+		discrepancies = append(discrepancies, InvoiceDiscrepancy{InvoiceId: invoice.Id, Reason: "credits exceed invoice total", Expected: invoice.Total, Actual: credited})
+	}
+
+	if len(invoice.Lines) == 0 {
+		return discrepancies, nil
+	}
+	sum := invoice.TaxAmount
+	for i, line := range invoice.Lines {
+		reason := fmt.Sprintf("line %d total differs from quantity times unit amount", i+1)
+		expected, err := line.UnitAmount.Mul(int64(line.Quantity))
+		if err != nil {
+			return nil, err
+		}
+		if err = add(reason, expected.Round(2), line.TotalAmount); err != nil {
+			return nil, err
+		}
+		if sum, err = sum.Add(line.TotalAmount); err != nil {
+			return nil, err
+		}
+	}
+	if err := add("line items plus tax differ from invoice total", sum, invoice.Total); err != nil {
+		return nil, err
+	}
+	return discrepancies, nil
+}
+
+func (ieo InvoiceExportOptions) exchangeRate(currency string) (*big.Rat, error) {
+	if ieo.Currency == "" || strings.EqualFold(ieo.Currency, currency) {
+		return big.NewRat(1, 1), nil
+	}
+	value, ok := ieo.ExchangeRates[strings.ToUpper(currency)]
+	if !ok {
+		return nil, fmt.Errorf("no exchange rate from %s to %s", currency, ieo.Currency)
+	}
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid exchange rate %q from %s to %s", value, currency, ieo.Currency)
+	}
+	return rate, nil
+}
+
+func (ier invoiceExportRow) field(name string, options InvoiceExportOptions) (string, error) {
+	amount := func(value Money) string {
+		if options.Currency != "" && ier.rate != nil {
+			value = value.Convert(ier.rate, strings.ToUpper(options.Currency))
+		}
+		formatted := value.Format(2)
+		if options.DecimalSeparator != "" {
+			formatted = strings.Replace(formatted, ".", options.DecimalSeparator, 1)
+		}
+		return formatted
+	}
+
+	invoice := ier.invoice
+	line := ier.line
+	if line == nil {
+		line = &Line{}
+	}
+	credit := ier.credit
+	if credit == nil {
+		credit = &Credit{}
+	}
+	creditNet, err := credit.Total.Sub(credit.TaxAmount)
+	if err != nil {
+		return "", err
+	}
+
+	switch name {
+	case "invoiceId":
+		return invoice.Id, nil
+	case "invoiceDate":
+		return invoice.Date.DateString(), nil
+	case "dueDate":
+		return invoice.DueDate.DateString(), nil
+	case "status":
+		return invoice.Status, nil
+	case "type":
+		if ier.credit != nil {
+			return INVOICE_ROW_TYPE_CREDIT, nil
+		}
+		return INVOICE_ROW_TYPE_LINE, nil
+	case "date":
+		if ier.credit != nil {
+			return credit.Date.DateString(), nil
+		}
+		return invoice.Date.DateString(), nil
+	case "creditId":
+		return credit.Id, nil
+	case "contractId":
+		return line.ContractId, nil
+	case "equipmentId":
+		return line.EquipmentId, nil
+	case "product":
+		return line.Product, nil
+	case "reference":
+		return line.Reference, nil
+	case "description":
+		if ier.credit != nil {
+			return "Credit " + credit.Id, nil
+		}
+		if line.Reference != "" {
+			return line.Product + " (" + line.Reference + ")", nil
+		}
+		return line.Product, nil
+	case "quantity":
+		if ier.credit != nil {
+			return "1", nil
+		}
+		return strconv.Itoa(line.Quantity), nil
+	case "unitAmount":
+		if ier.credit != nil {
+			return amount(creditNet.Neg()), nil
+		}
+		return amount(line.UnitAmount), nil
+	case "amount":
+		if ier.credit != nil {
+			return amount(creditNet.Neg()), nil
+		}
+		return amount(line.TotalAmount), nil
+	case "taxAmount":
+		if ier.credit != nil {
+			return amount(credit.TaxAmount.Neg()), nil
+		}
+		return "", nil
+	case "currency":
+		if options.Currency != "" {
+			return strings.ToUpper(options.Currency), nil
+		}
+		return invoice.Currency, nil
+	case "invoiceTotal":
+		return amount(invoice.Total), nil
+	case "invoiceTaxAmount":
+		return amount(invoice.TaxAmount), nil
+	}
+	return "", fmt.Errorf("unknown invoice export field %q", name)
+}
+
+func invoiceDay(date Time) time.Time {
+	if date.IsZero() {
+		return time.Time{}
+	}
+	year, month, day := date.Time.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
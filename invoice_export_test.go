@@ -0,0 +1,246 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportInvoicesCsv(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01"},
+			{"id": "00000003", "date": "2023-03-01"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID",
+			"total": 145.2, "taxAmount": 25.2, "lineItems": [
+				{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "quantity": 1, "reference": "web-01", "totalAmount": 100, "unitAmount": 100},
+				{"contractId": "1002", "equipmentId": "", "product": "IP Address", "quantity": 4, "reference": "", "totalAmount": 20, "unitAmount": 5}
+			], "credits": [
+				{"id": "C0001", "date": "2023-01-10", "total": 12.1, "taxAmount": 2.1}
+			]}`),
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01", "currency": "USD", "status": "OPEN",
+			"total": 121.05, "taxAmount": 21, "lineItems": [
+				{"contractId": "1001", "product": "Dedicated Server", "quantity": 3, "totalAmount": 100, "unitAmount": 33.32}
+			]}`),
+	}))
+	defer teardown()
+
+	var out bytes.Buffer
+	result, err := ExportInvoices(&out, InvoiceExportOptions{To: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(result.Invoices, 2)
+	assert.Equal(result.Rows, 4)
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	assert.Nil(err)
+	assert.Equal(len(rows), 5)
+	assert.Equal(rows[0][0], "invoiceId")
+	assert.Equal(rows[1], []string{"00000001", "2023-01-01", "2023-01-15", "PAID", "LINE", "2023-01-01", "", "1001", "12345", "Dedicated Server", "web-01", "1", "100.00", "100.00", "", "EUR", "145.20", "25.20"})
+	assert.Equal(rows[3], []string{"00000001", "2023-01-01", "2023-01-15", "PAID", "CREDIT", "2023-01-10", "C0001", "", "", "", "", "1", "-10.00", "-10.00", "-2.10", "EUR", "145.20", "25.20"})
+	assert.Equal(rows[4][4], "LINE")
+	assert.Equal(rows[4][15], "USD")
+}
+
+func TestExportInvoicesTotalsCheck(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01"},
+			{"id": "00000003", "date": "2023-03-01"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID",
+			"total": 145.2, "taxAmount": 25.2, "lineItems": [
+				{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "quantity": 1, "reference": "web-01", "totalAmount": 100, "unitAmount": 100},
+				{"contractId": "1002", "equipmentId": "", "product": "IP Address", "quantity": 4, "reference": "", "totalAmount": 20, "unitAmount": 5}
+			], "credits": [
+				{"id": "C0001", "date": "2023-01-10", "total": 12.1, "taxAmount": 2.1}
+			]}`),
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01", "currency": "USD", "status": "OPEN",
+			"total": 121.05, "taxAmount": 21, "lineItems": [
+				{"contractId": "1001", "product": "Dedicated Server", "quantity": 3, "totalAmount": 100, "unitAmount": 33.32}
+			]}`),
+		"GET /invoices/v1/invoices/00000003": respondWith(`{"id": "00000003", "date": "2023-03-01", "currency": "EUR", "status": "OPEN", "total": 0, "taxAmount": 0}`),
+	}))
+	defer teardown()
+
+	result, err := ExportInvoices(io.Discard, InvoiceExportOptions{})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(result.Discrepancies, []InvoiceDiscrepancy{
+		{InvoiceId: "00000002", Reason: "line 1 total differs from quantity times unit amount", Expected: MustMoney("99.96", "USD"), Actual: MustMoney("100", "USD")},
+		{InvoiceId: "00000002", Reason: "line items plus tax differ from invoice total", Expected: MustMoney("121", "USD"), Actual: MustMoney("121.05", "USD")},
+	})
+	assert.Equal(result.Discrepancies[1].String(), "invoice 00000002: line items plus tax differ from invoice total (expected 121.00, got 121.05)")
+
+	tolerance := MustMoney("0.1", "")
+	result, err = ExportInvoices(io.Discard, InvoiceExportOptions{Tolerance: &tolerance})
+	assert.Nil(err)
+	assert.Empty(result.Discrepancies)
+}
+
+func TestCheckInvoiceTotals(t *testing.T) {
+	invoice := Invoice{
+		Id:        "00000001",
+		Total:     MustMoney("121.01", "EUR"),
+		TaxAmount: MustMoney("21", "EUR"),
+		Lines:     []Line{{Quantity: 2, UnitAmount: MustMoney("50", "EUR"), TotalAmount: MustMoney("100", "EUR")}},
+	}
+	assert := assert.New(t)
+
+	discrepancies, err := CheckInvoiceTotals(invoice, DEFAULT_INVOICE_TOTAL_TOLERANCE)
+	assert.Nil(err)
+	assert.Empty(discrepancies)
+
+	discrepancies, err = CheckInvoiceTotals(invoice, Money{})
+	assert.Nil(err)
+	assert.Equal(discrepancies, []InvoiceDiscrepancy{
+		{InvoiceId: "00000001", Reason: "line items plus tax differ from invoice total", Expected: MustMoney("121", "EUR"), Actual: MustMoney("121.01", "EUR")},
+	})
+
+	invoice.Total = MustMoney("121", "EUR")
+	invoice.Credits = []Credit{
+		{Id: "C0001", Total: MustMoney("100", "EUR"), TaxAmount: MustMoney("17.36", "EUR")},
+		{Id: "C0002", Total: MustMoney("-30", "EUR"), TaxAmount: MustMoney("-40", "EUR")},
+	}
+	discrepancies, err = CheckInvoiceTotals(invoice, Money{})
+	assert.Nil(err)
+	assert.Equal(discrepancies, []InvoiceDiscrepancy{
+		{InvoiceId: "00000001", Reason: "credit C0002 tax exceeds its total", Expected: MustMoney("-30", "EUR"), Actual: MustMoney("-40", "EUR")},
+		{InvoiceId: "00000001", Reason: "credits exceed invoice total", Expected: MustMoney("121", "EUR"), Actual: MustMoney("130", "EUR")},
+	})
+}
+
+func TestExportInvoicesAccounting(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01"},
+			{"id": "00000003", "date": "2023-03-01"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID",
+			"total": 145.2, "taxAmount": 25.2, "lineItems": [
+				{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "quantity": 1, "reference": "web-01", "totalAmount": 100, "unitAmount": 100},
+				{"contractId": "1002", "equipmentId": "", "product": "IP Address", "quantity": 4, "reference": "", "totalAmount": 20, "unitAmount": 5}
+			], "credits": [
+				{"id": "C0001", "date": "2023-01-10", "total": 12.1, "taxAmount": 2.1}
+			]}`),
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01", "currency": "USD", "status": "OPEN",
+			"total": 121.05, "taxAmount": 21, "lineItems": [
+				{"contractId": "1001", "product": "Dedicated Server", "quantity": 3, "totalAmount": 100, "unitAmount": 33.32}
+			]}`),
+		"GET /invoices/v1/invoices/00000003": respondWith(`{"id": "00000003", "date": "2023-03-01", "currency": "EUR", "status": "OPEN", "total": 0, "taxAmount": 0}`),
+	}))
+	defer teardown()
+
+	var out bytes.Buffer
+	options := InvoiceExportOptions{
+		Format:        INVOICE_EXPORT_FORMAT_ACCOUNTING,
+		Currency:      "eur",
+		ExchangeRates: map[string]string{"usd": "0.9"},
+	}
+	_, err := ExportInvoices(&out, options)
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(lines, []string{
+		"Date;Document;Description;Quantity;Net;Tax;Currency",
+		"2023-01-01;00000001;Dedicated Server (web-01);1;100,00;;EUR",
+		"2023-01-01;00000001;IP Address;4;20,00;;EUR",
+		"2023-01-10;00000001;Credit C0001;1;-10,00;-2,10;EUR",
+		"2023-02-01;00000002;Dedicated Server;3;90,00;;EUR",
+	})
+}
+
+func TestExportInvoicesCustomColumns(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01"},
+			{"id": "00000003", "date": "2023-03-01"}
+		]}`),
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01", "currency": "USD", "status": "OPEN",
+			"total": 121.05, "taxAmount": 21, "lineItems": [
+				{"contractId": "1001", "product": "Dedicated Server", "quantity": 3, "totalAmount": 100, "unitAmount": 33.32}
+			]}`),
+		"GET /invoices/v1/invoices/00000003": respondWith(`{"id": "00000003", "date": "2023-03-01", "currency": "EUR", "status": "OPEN", "total": 0, "taxAmount": 0}`),
+	}))
+	defer teardown()
+
+	var out bytes.Buffer
+	options := InvoiceExportOptions{
+		Columns: []InvoiceExportColumn{{Header: "Invoice", Field: "invoiceId"}, {Header: "Total", Field: "amount"}},
+		From:    time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	_, err := ExportInvoices(&out, options)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(out.String(), "Invoice,Total\n00000002,100.00\n")
+}
+
+func TestExportInvoicesOptionErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := ExportInvoices(io.Discard, InvoiceExportOptions{Format: "xml"})
+	assert.Equal(err.Error(), `unsupported invoice export format "xml"`)
+
+	_, err = ExportInvoices(io.Discard, InvoiceExportOptions{Columns: []InvoiceExportColumn{{Header: "Foo", Field: "foo"}}})
+	assert.Equal(err.Error(), `unknown invoice export field "foo"`)
+
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01"},
+			{"id": "00000002", "date": "2023-02-01"},
+			{"id": "00000003", "date": "2023-03-01"}
+		]}`),
+		"GET /invoices/v1/invoices/00000001": respondWith(`{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID",
+			"total": 145.2, "taxAmount": 25.2, "lineItems": [
+				{"contractId": "1001", "equipmentId": "12345", "product": "Dedicated Server", "quantity": 1, "reference": "web-01", "totalAmount": 100, "unitAmount": 100},
+				{"contractId": "1002", "equipmentId": "", "product": "IP Address", "quantity": 4, "reference": "", "totalAmount": 20, "unitAmount": 5}
+			], "credits": [
+				{"id": "C0001", "date": "2023-01-10", "total": 12.1, "taxAmount": 2.1}
+			]}`),
+	}))
+	defer teardown()
+	_, err = ExportInvoices(io.Discard, InvoiceExportOptions{Currency: "GBP"})
+	assert.Equal(err.Error(), "no exchange rate from EUR to GBP")
+
+	_, err = ExportInvoices(io.Discard, InvoiceExportOptions{Currency: "GBP", ExchangeRates: map[string]string{"EUR": "0,85"}})
+	assert.Equal(err.Error(), `invalid exchange rate "0,85" from EUR to GBP`)
+
+	tolerance := MustMoney("-0.01", "")
+	_, err = ExportInvoices(io.Discard, InvoiceExportOptions{Tolerance: &tolerance})
+	assert.Equal(err.Error(), "invoice total tolerance -0.01 must not be negative")
+}
+
+func TestExportInvoicesServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ExportInvoices(io.Discard, InvoiceExportOptions{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -0,0 +1,200 @@
+package leaseweb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const DEFAULT_INVOICE_DUE_SOON_WINDOW = 7 * 24 * time.Hour
+
+var INVOICE_SETTLED_STATUSES = []string{"PAID", "CANCELED", "CREDITED"}
+
+type InvoicePaymentMonitorOptions struct {
+	Service       InvoiceService
+	Notifier      Notifier
+	DueSoonWindow time.Duration
+	Now           func() time.Time
+	// OnError receives the errors of the checks done by Run. Errors are
+	// dropped when it is nil.
+	OnError func(err error)
+}
+
+type InvoicePaymentMonitor struct {
+	options InvoicePaymentMonitorOptions
+}
+
+type InvoicePayment struct {
+	Invoice  Invoice `json:"invoice"`
+	DaysLeft int     `json:"daysLeft"`
+}
+
+type InvoicePaymentReport struct {
+	GeneratedAt    time.Time        `json:"generatedAt"`
+	DueSoon        []InvoicePayment `json:"dueSoon"`
+	Overdue        []InvoicePayment `json:"overdue"`
+	PartiallyPaid  []InvoicePayment `json:"partiallyPaid"`
+	OpenAmounts    map[string]Money `json:"openAmounts"`
+	OverdueAmounts map[string]Money `json:"overdueAmounts"`
+}
+
+func NewInvoicePaymentMonitor(options InvoicePaymentMonitorOptions) (*InvoicePaymentMonitor, error) {
+	if options.Service == nil {
+		options.Service = InvoiceApi{}
+	}
+	if options.Notifier == nil {
+		return nil, fmt.Errorf("invoice payment monitor requires a notifier")
+	}
+	if options.DueSoonWindow <= 0 {
+		options.DueSoonWindow = DEFAULT_INVOICE_DUE_SOON_WINDOW
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &InvoicePaymentMonitor{options: options}, nil
+}
+
+func (ipm *InvoicePaymentMonitor) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for ctx.Err() == nil {
+		if _, err := ipm.Check(); err != nil && ipm.options.OnError != nil {
+			ipm.options.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+	return ctx.Err()
+}
+
+func (ipm *InvoicePaymentMonitor) Check() (*InvoicePaymentReport, error) {
+	invoices, err := listAllInvoices(ipm.options.Service)
+	if err != nil {
+		return nil, err
+	}
+	report, err := ClassifyInvoicePayments(invoices, ipm.options.Now(), ipm.options.DueSoonWindow)
+	if err != nil {
+		return nil, err
+	}
+	if report.IsEmpty() {
+		return report, nil
+	}
+	return report, ipm.options.Notifier.Notify(report)
+}
+
+func ClassifyInvoicePayments(invoices []Invoice, now time.Time, dueSoonWindow time.Duration) (*InvoicePaymentReport, error) {
+	report := &InvoicePaymentReport{GeneratedAt: now, OpenAmounts: map[string]Money{}, OverdueAmounts: map[string]Money{}}
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	invoices = append([]Invoice(nil), invoices...)
+	sort.SliceStable(invoices, func(i, j int) bool {
+		return invoices[i].DueDate.Before(invoices[j].DueDate.Time)
+	})
+	for _, invoice := range invoices {
+		if !isInvoiceUnpaid(invoice) {
+			continue
+		}
+		if err := addOpenAmount(report.OpenAmounts, invoice); err != nil {
+			return nil, err
+		}
+
+		payment := InvoicePayment{Invoice: invoice}
+		if !invoice.DueDate.IsZero() {
+			dueDate := invoiceDay(invoice.DueDate)
+			payment.DaysLeft = int(dueDate.Sub(today).Hours() / 24)
+			if dueDate.Before(today) {
+				report.Overdue = append(report.Overdue, payment)
+				if err := addOpenAmount(report.OverdueAmounts, invoice); err != nil {
+					return nil, err
+				}
+			} else if dueDate.Sub(today) <= dueSoonWindow {
+				report.DueSoon = append(report.DueSoon, payment)
+			}
+		}
+		if invoice.IsPartialPaymentAllowed && invoice.OpenAmount.Cmp(invoice.Total) < 0 {
+			report.PartiallyPaid = append(report.PartiallyPaid, payment)
+		}
+	}
+	return report, nil
+}
+
+func (ipr *InvoicePaymentReport) IsEmpty() bool {
+	return len(ipr.DueSoon) == 0 && len(ipr.Overdue) == 0 && len(ipr.PartiallyPaid) == 0
+}
+
+func (ipr *InvoicePaymentReport) Subject() string {
+	return fmt.Sprintf("Invoices: %d overdue, %d due soon, %d partially paid", len(ipr.Overdue), len(ipr.DueSoon), len(ipr.PartiallyPaid))
+}
+
+func (ipr *InvoicePaymentReport) Body() string {
+	var b strings.Builder
+	writeInvoicePayments(&b, "Overdue", ipr.Overdue)
+	writeInvoicePayments(&b, "Due soon", ipr.DueSoon)
+	writeInvoicePayments(&b, "Partially paid", ipr.PartiallyPaid)
+	writeInvoiceAmounts(&b, "Overdue amounts", ipr.OverdueAmounts)
+	writeInvoiceAmounts(&b, "Open amounts", ipr.OpenAmounts)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func isInvoiceUnpaid(invoice Invoice) bool {
+	for _, status := range INVOICE_SETTLED_STATUSES {
+		if strings.EqualFold(invoice.Status, status) {
+			return false
+		}
+	}
+	return !invoice.OpenAmount.IsZero() && !invoice.OpenAmount.IsNegative()
+}
+
+func addOpenAmount(amounts map[string]Money, invoice Invoice) error {
+	currency := invoice.Currency
+	if currency == "" {
+		currency = invoice.OpenAmount.Currency
+	}
+	total, err := amounts[currency].Add(invoice.OpenAmount)
+	if err != nil {
+		return err
+	}
+	amounts[currency] = total.WithCurrency(currency)
+	return nil
+}
+
+func writeInvoicePayments(b *strings.Builder, title string, payments []InvoicePayment) {
+	if len(payments) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "%s (%d):\n", title, len(payments))
+	for _, payment := range payments {
+		invoice := payment.Invoice
+		fmt.Fprintf(b, "  %s  due %s  open %s of %s %s", invoice.Id, invoice.DueDate.DateString(), invoice.OpenAmount.Format(2), invoice.Total.Format(2), invoice.Currency)
+		switch {
+		case invoice.DueDate.IsZero():
+		case payment.DaysLeft < 0:
+			fmt.Fprintf(b, "  (%d days overdue)", -payment.DaysLeft)
+		default:
+			fmt.Fprintf(b, "  (%d days left)", payment.DaysLeft)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+}
+
+func writeInvoiceAmounts(b *strings.Builder, title string, amounts map[string]Money) {
+	if len(amounts) == 0 {
+		return
+	}
+	currencies := make([]string, 0, len(amounts))
+	for currency := range amounts {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	fmt.Fprintf(b, "%s:\n", title)
+	for _, currency := range currencies {
+		fmt.Fprintf(b, "  %s %s\n", amounts[currency].Format(2), currency)
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,182 @@
+package leaseweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoicePaymentMonitor(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 5}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID", "openAmount": 0, "total": 100},
+			{"id": "00000002", "date": "2023-02-01", "dueDate": "2023-03-15T00:00:00+00:00", "currency": "EUR", "status": "OPEN", "openAmount": 200.5, "total": 200.5},
+			{"id": "00000003", "date": "2023-02-01", "dueDate": "2023-03-01", "currency": "USD", "status": "OVERDUE", "openAmount": 50, "total": 50},
+			{"id": "00000004", "date": "2023-02-15", "dueDate": "2023-03-05", "currency": "EUR", "status": "OPEN", "openAmount": 40.25, "total": 100, "isPartialPaymentAllowed": true},
+			{"id": "00000005", "date": "2023-03-01", "dueDate": "2023-04-01", "currency": "EUR", "status": "OPEN", "openAmount": 10, "total": 10}
+		]}`),
+	}))
+	defer teardown()
+
+	notifier := &recordingNotifier{}
+	monitor, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{Notifier: notifier, Now: testClock})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	report, err := monitor.Check()
+	assert.Nil(err)
+	assert.Equal(len(report.Overdue), 2)
+	assert.Equal(report.Overdue[0].Invoice.Id, "00000003")
+	assert.Equal(report.Overdue[0].DaysLeft, -9)
+	assert.Equal(report.Overdue[1].Invoice.Id, "00000004")
+	assert.Equal(len(report.DueSoon), 1)
+	assert.Equal(report.DueSoon[0].Invoice.Id, "00000002")
+	assert.Equal(report.DueSoon[0].DaysLeft, 5)
+	assert.Equal(len(report.PartiallyPaid), 1)
+	assert.Equal(report.PartiallyPaid[0].Invoice.Id, "00000004")
+
+	assert.Equal(report.OpenAmounts, map[string]Money{"EUR": MustMoney("250.75", "EUR"), "USD": MustMoney("50", "USD")})
+	assert.Equal(report.OverdueAmounts, map[string]Money{"EUR": MustMoney("40.25", "EUR"), "USD": MustMoney("50", "USD")})
+
+	assert.Equal(len(notifier.notifications), 1)
+	assert.Equal(notifier.notifications[0].Subject(), "Invoices: 2 overdue, 1 due soon, 1 partially paid")
+	assert.Equal(notifier.notifications[0].Body(), `Overdue (2):
+  00000003  due 2023-03-01  open 50.00 of 50.00 USD  (9 days overdue)
+  00000004  due 2023-03-05  open 40.25 of 100.00 EUR  (5 days overdue)
+
+Due soon (1):
+  00000002  due 2023-03-15  open 200.50 of 200.50 EUR  (5 days left)
+
+Partially paid (1):
+  00000004  due 2023-03-05  open 40.25 of 100.00 EUR  (5 days overdue)
+
+Overdue amounts:
+  40.25 EUR
+  50.00 USD
+
+Open amounts:
+  250.75 EUR
+  50.00 USD
+`)
+}
+
+func TestInvoicePaymentMonitorNothingDue(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "invoices": [
+			{"id": "00000001", "date": "2023-01-01", "dueDate": "2023-01-15", "currency": "EUR", "status": "PAID", "openAmount": 0, "total": 100}
+		]}`),
+	}))
+	defer teardown()
+
+	notifier := &recordingNotifier{}
+	monitor, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{Notifier: notifier, Now: testClock})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	report, err := monitor.Check()
+	assert.Nil(err)
+	assert.True(report.IsEmpty())
+	assert.Empty(report.OpenAmounts)
+	assert.Empty(notifier.notifications)
+}
+
+func TestInvoicePaymentMonitorNotifierError(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "invoices": [
+			{"id": "00000003", "date": "2023-02-01", "dueDate": "2023-03-01", "currency": "USD", "status": "OVERDUE", "openAmount": 50, "total": 50}
+		]}`),
+	}))
+	defer teardown()
+
+	monitor, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{
+		Notifier: &recordingNotifier{err: errors.New("smtp down")},
+		Now:      testClock,
+	})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	report, err := monitor.Check()
+	assert.Equal(err.Error(), "smtp down")
+	assert.Equal(len(report.Overdue), 1)
+}
+
+func TestInvoicePaymentMonitorRunKeepsTickingOnError(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "invoices": [
+			{"id": "00000003", "date": "2023-02-01", "dueDate": "2023-03-01", "currency": "USD", "status": "OVERDUE", "openAmount": 50, "total": 50}
+		]}`),
+	}))
+	defer teardown()
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	errs := []error{}
+	monitor, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{
+		Notifier: &recordingNotifier{err: errors.New("smtp down")},
+		Now:      testClock,
+		OnError: func(err error) {
+			errs = append(errs, err)
+			if len(errs) == 2 {
+				cancel()
+			}
+		},
+	})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.ErrorIs(monitor.Run(runCtx, time.Millisecond), context.Canceled)
+	assert.Equal(len(errs), 2)
+	assert.Equal(errs[1].Error(), "smtp down")
+}
+
+func TestClassifyInvoicePaymentsDueSoonWindow(t *testing.T) {
+	invoices := []Invoice{
+		{Id: "00000001", Currency: "EUR", Status: "OPEN", DueDate: MustTime("2023-03-12"), OpenAmount: MustMoney("10", "EUR"), Total: MustMoney("10", "EUR")},
+		{Id: "00000002", Currency: "EUR", Status: "OPEN", DueDate: MustTime("2023-03-10T00:00:00+00:00"), OpenAmount: MustMoney("10", "EUR"), Total: MustMoney("10", "EUR")},
+	}
+
+	report, err := ClassifyInvoicePayments(invoices, testNow, 24*time.Hour)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Empty(report.Overdue)
+	assert.Equal(len(report.DueSoon), 1)
+	assert.Equal(report.DueSoon[0].Invoice.Id, "00000002")
+	assert.Equal(report.DueSoon[0].DaysLeft, 0)
+	assert.Equal(report.OpenAmounts["EUR"], MustMoney("20", "EUR"))
+	assert.Equal(invoices[0].Id, "00000001")
+}
+
+func TestNewInvoicePaymentMonitorRequiresNotifier(t *testing.T) {
+	_, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{})
+	assert.Equal(t, err.Error(), "invoice payment monitor requires a notifier")
+}
+
+func TestInvoicePaymentMonitorServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				monitor, err := NewInvoicePaymentMonitor(InvoicePaymentMonitorOptions{Notifier: &recordingNotifier{}})
+				if err != nil {
+					return nil, err
+				}
+				return monitor.Check()
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -0,0 +1,215 @@
+package leaseweb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	RECONCILIATION_MATCHED           = "MATCHED"
+	RECONCILIATION_PRICE_CHANGED     = "PRICE_CHANGED"
+	RECONCILIATION_NEW_CONTRACT      = "NEW_CONTRACT"
+	RECONCILIATION_REMOVED_CONTRACT  = "REMOVED_CONTRACT"
+	RECONCILIATION_UNEXPECTED_CHARGE = "UNEXPECTED_CHARGE"
+)
+
+type ProFormaReconciliationOptions struct {
+	Service   InvoiceService
+	InvoiceId string
+	Tolerance *Money
+}
+
+type ProFormaReconciliation struct {
+	ProFormaDate  Time
+	InvoiceId     string
+	Currency      string
+	ProFormaTotal Money
+	InvoiceTotal  Money
+	Items         []ReconciliationItem
+}
+
+type ReconciliationItem struct {
+	Status      string
+	ContractId  string
+	EquipmentId string
+	Product     string
+	Reference   string
+	Expected    Money
+	Actual      Money
+}
+
+func ReconcileProForma(options ProFormaReconciliationOptions) (*ProFormaReconciliation, error) {
+	if options.Service == nil {
+		options.Service = InvoiceApi{}
+	}
+
+	proForma, err := getFullProForma(options.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceId := options.InvoiceId
+	if invoiceId == "" {
+		if invoiceId, err = nextInvoiceId(options.Service, proForma.ProformaDate); err != nil {
+			return nil, err
+		}
+	}
+	invoice, err := options.Service.GetInvoice(invoiceId)
+	if err != nil {
+		return nil, err
+	}
+	return ReconcileInvoice(proForma, invoice, options.Tolerance)
+}
+
+func ReconcileInvoice(proForma *ProForma, invoice *Invoice, maxDifference *Money) (*ProFormaReconciliation, error) {
+	tolerance := DEFAULT_INVOICE_TOTAL_TOLERANCE
+	if maxDifference != nil {
+		if maxDifference.IsNegative() {
+			return nil, fmt.Errorf("reconciliation tolerance %s must not be negative", maxDifference)
+		}
+		tolerance = *maxDifference
+	}
+
+	invoiceTotal, err := invoice.Total.Sub(invoice.TaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	result := &ProFormaReconciliation{
+		ProFormaDate:  proForma.ProformaDate,
+		InvoiceId:     invoice.Id,
+		Currency:      invoice.Currency,
+		ProFormaTotal: proForma.SubTotal,
+		InvoiceTotal:  invoiceTotal,
+	}
+
+	matched := make([]bool, len(invoice.Lines))
+	find := func(contract Contract, matchEquipment bool) int {
+		for i, line := range invoice.Lines {
+			if !matched[i] && line.ContractId == contract.ContractId && (!matchEquipment || line.EquipmentId == contract.EquipmentId) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, contract := range proForma.Contracts {
+		item := ReconciliationItem{
+			ContractId:  contract.ContractId,
+			EquipmentId: contract.EquipmentId,
+			Product:     contract.Product,
+			Reference:   contract.Reference,
+			Expected:    contract.Price,
+		}
+		i := find(contract, true)
+		if i < 0 {
+			i = find(contract, false)
+		}
+		if i < 0 {
+			item.Status = RECONCILIATION_REMOVED_CONTRACT
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		matched[i] = true
+		item.Actual = invoice.Lines[i].TotalAmount
+		item.Status = RECONCILIATION_MATCHED
+		difference, err := item.Actual.Sub(item.Expected)
+		if err != nil {
+			return nil, err
+		}
+		if difference.Abs().Cmp(tolerance) > 0 {
+			item.Status = RECONCILIATION_PRICE_CHANGED
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	contracts := map[string]bool{}
+	for _, contract := range proForma.Contracts {
+		contracts[contract.ContractId] = true
+	}
+	for i, line := range invoice.Lines {
+		if matched[i] {
+			continue
+		}
+		item := ReconciliationItem{
+			Status:      RECONCILIATION_UNEXPECTED_CHARGE,
+			ContractId:  line.ContractId,
+			EquipmentId: line.EquipmentId,
+			Product:     line.Product,
+			Reference:   line.Reference,
+			Actual:      line.TotalAmount,
+		}
+		if line.ContractId != "" && !contracts[line.ContractId] {
+			item.Status = RECONCILIATION_NEW_CONTRACT
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+func (pfr *ProFormaReconciliation) Discrepancies() []ReconciliationItem {
+	var result []ReconciliationItem
+	for _, item := range pfr.Items {
+		if item.Status != RECONCILIATION_MATCHED {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (pfr *ProFormaReconciliation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Pro forma of %s against invoice %s\n", pfr.ProFormaDate.DateString(), pfr.InvoiceId)
+	fmt.Fprintf(&b, "Expected %s %s, invoiced %s %s\n", pfr.ProFormaTotal.Format(2), pfr.Currency, pfr.InvoiceTotal.Format(2), pfr.Currency)
+	discrepancies := pfr.Discrepancies()
+	if len(discrepancies) == 0 {
+		b.WriteString("No discrepancies\n")
+		return b.String()
+	}
+	for _, item := range discrepancies {
+		fmt.Fprintf(&b, "%-17s contract %s equipment %s %s: expected %s, invoiced %s\n",
+			item.Status, item.ContractId, item.EquipmentId, item.Product, item.Expected.Format(2), item.Actual.Format(2))
+	}
+	return b.String()
+}
+
+func getFullProForma(service InvoiceService) (*ProForma, error) {
+	var result *ProForma
+	contracts, err := listAllPages(INVOICE_PAGE_LIMIT, func(offset, limit int) ([]Contract, Metadata, error) {
+		proForma, err := service.GetProForma(offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		if result == nil {
+			result = proForma
+		}
+		return proForma.Contracts, proForma.Metadata, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Contracts = contracts
+	return result, nil
+}
+
+func nextInvoiceId(service InvoiceService, proFormaDate Time) (string, error) {
+	after := invoiceDay(proFormaDate)
+	invoices, err := listAllInvoices(service)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	var next time.Time
+	for _, invoice := range invoices {
+		date := invoiceDay(invoice.Date)
+		if !date.Before(after) && (id == "" || date.Before(next)) {
+			id, next = invoice.Id, date
+		}
+	}
+	if id == "" {
+		return "", fmt.Errorf("no invoice found on or after pro forma date %s", proFormaDate.DateString())
+	}
+	return id, nil
+}
@@ -0,0 +1,166 @@
+package leaseweb
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileProForma(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "invoices": [
+			{"id": "00000004", "date": "2023-04-01"},
+			{"id": "00000003", "date": "2023-03-01"},
+			{"id": "00000002", "date": "2023-02-01"}
+		]}`),
+		"GET /invoices/v1/invoices/proforma": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 0, "totalCount": 4}, "currency": "EUR", "proformaDate": "2023-03-01T00:00:00+00:00",
+					"subTotal": 380, "total": 459.8, "vatAmount": 79.8, "contractItems": [
+						{"contractId": "1001", "equipmentId": "12345", "price": 100, "product": "DEDICATED SERVER", "reference": "web-01"},
+						{"contractId": "1002", "equipmentId": "12346", "price": 150, "product": "DEDICATED SERVER", "reference": "web-02"}
+					]}`)
+				return
+			}
+			assert.Equal(t, "2", r.URL.Query().Get("offset"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 2, "totalCount": 4}, "currency": "EUR", "proformaDate": "2023-03-01T00:00:00+00:00",
+				"subTotal": 380, "total": 459.8, "vatAmount": 79.8, "contractItems": [
+					{"contractId": "1003", "equipmentId": "", "price": 30, "product": "IP ADDRESSES"},
+					{"contractId": "1004", "equipmentId": "12347", "price": 100, "product": "DEDICATED SERVER", "reference": "db-01"}
+				]}`)
+		},
+		"GET /invoices/v1/invoices/00000003": respondWith(`{"id": "00000003", "date": "2023-03-01", "currency": "EUR", "total": 508.2, "taxAmount": 88.2, "lineItems": [
+			{"contractId": "1001", "equipmentId": "12345", "product": "DEDICATED SERVER", "totalAmount": 100},
+			{"contractId": "1002", "equipmentId": "12346", "product": "DEDICATED SERVER", "totalAmount": 175},
+			{"contractId": "1003", "equipmentId": "", "product": "IP ADDRESSES", "totalAmount": 30},
+			{"contractId": "1003", "equipmentId": "", "product": "IP ADDRESSES", "totalAmount": 30},
+			{"contractId": "1005", "equipmentId": "12348", "product": "DEDICATED SERVER", "totalAmount": 80},
+			{"contractId": "", "equipmentId": "", "product": "REMOTE HANDS", "totalAmount": 5}
+		]}`),
+	}))
+	defer teardown()
+
+	result, err := ReconcileProForma(ProFormaReconciliationOptions{})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(result.InvoiceId, "00000003")
+	assert.Equal(result.ProFormaTotal, MustMoney("380", "EUR"))
+	assert.Equal(result.InvoiceTotal, MustMoney("420", "EUR"))
+
+	statuses := []string{}
+	for _, item := range result.Items {
+		statuses = append(statuses, item.ContractId+" "+item.Status)
+	}
+	assert.Equal(statuses, []string{
+		"1001 " + RECONCILIATION_MATCHED,
+		"1002 " + RECONCILIATION_PRICE_CHANGED,
+		"1003 " + RECONCILIATION_MATCHED,
+		"1004 " + RECONCILIATION_REMOVED_CONTRACT,
+		"1003 " + RECONCILIATION_UNEXPECTED_CHARGE,
+		"1005 " + RECONCILIATION_NEW_CONTRACT,
+		" " + RECONCILIATION_UNEXPECTED_CHARGE,
+	})
+	assert.Equal(result.Items[1].Expected, MustMoney("150", "EUR"))
+	assert.Equal(result.Items[1].Actual, MustMoney("175", "EUR"))
+	assert.Equal(len(result.Discrepancies()), 5)
+	assert.Contains(result.String(), "PRICE_CHANGED     contract 1002 equipment 12346 DEDICATED SERVER: expected 150.00, invoiced 175.00\n")
+}
+
+func TestReconcileProFormaNoInvoice(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": respondWith(`{"_metadata": {"limit": 50, "offset": 0, "totalCount": 1}, "invoices": [
+			{"id": "00000002", "date": "2023-02-01"}
+		]}`),
+		"GET /invoices/v1/invoices/proforma": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 0, "totalCount": 4}, "currency": "EUR", "proformaDate": "2023-03-01T00:00:00+00:00",
+					"subTotal": 380, "total": 459.8, "vatAmount": 79.8, "contractItems": [
+						{"contractId": "1001", "equipmentId": "12345", "price": 100, "product": "DEDICATED SERVER", "reference": "web-01"},
+						{"contractId": "1002", "equipmentId": "12346", "price": 150, "product": "DEDICATED SERVER", "reference": "web-02"}
+					]}`)
+				return
+			}
+			assert.Equal(t, "2", r.URL.Query().Get("offset"))
+			fmt.Fprintf(w, `{"_metadata": {"limit": 2, "offset": 2, "totalCount": 4}, "currency": "EUR", "proformaDate": "2023-03-01T00:00:00+00:00",
+				"subTotal": 380, "total": 459.8, "vatAmount": 79.8, "contractItems": [
+					{"contractId": "1003", "equipmentId": "", "price": 30, "product": "IP ADDRESSES"},
+					{"contractId": "1004", "equipmentId": "12347", "price": 100, "product": "DEDICATED SERVER", "reference": "db-01"}
+				]}`)
+		},
+		"GET /invoices/v1/invoices/00000003": respondWith(`{"id": "00000003", "date": "2023-03-01", "currency": "EUR", "total": 508.2, "taxAmount": 88.2, "lineItems": [
+			{"contractId": "1001", "equipmentId": "12345", "product": "DEDICATED SERVER", "totalAmount": 100},
+			{"contractId": "1002", "equipmentId": "12346", "product": "DEDICATED SERVER", "totalAmount": 175},
+			{"contractId": "1003", "equipmentId": "", "product": "IP ADDRESSES", "totalAmount": 30},
+			{"contractId": "1003", "equipmentId": "", "product": "IP ADDRESSES", "totalAmount": 30},
+			{"contractId": "1005", "equipmentId": "12348", "product": "DEDICATED SERVER", "totalAmount": 80},
+			{"contractId": "", "equipmentId": "", "product": "REMOTE HANDS", "totalAmount": 5}
+		]}`),
+	}))
+	defer teardown()
+
+	_, err := ReconcileProForma(ProFormaReconciliationOptions{})
+	assert.Equal(t, err.Error(), "no invoice found on or after pro forma date 2023-03-01")
+
+	result, err := ReconcileProForma(ProFormaReconciliationOptions{InvoiceId: "00000003"})
+	assert.Nil(t, err)
+	assert.Equal(t, result.InvoiceId, "00000003")
+}
+
+func TestReconcileInvoiceTolerance(t *testing.T) {
+	proForma := &ProForma{Contracts: []Contract{{ContractId: "1001", Price: MustMoney("100", "EUR")}}}
+	invoice := &Invoice{Id: "00000001", Lines: []Line{{ContractId: "1001", TotalAmount: MustMoney("100.5", "EUR")}}}
+
+	assert := assert.New(t)
+	result, err := ReconcileInvoice(proForma, invoice, nil)
+	assert.Nil(err)
+	assert.Equal(result.Items[0].Status, RECONCILIATION_PRICE_CHANGED)
+	tolerance := MustMoney("1", "")
+	result, err = ReconcileInvoice(proForma, invoice, &tolerance)
+	assert.Nil(err)
+	assert.Equal(result.Items[0].Status, RECONCILIATION_MATCHED)
+	assert.Empty(result.Discrepancies())
+	assert.Contains(result.String(), "No discrepancies\n")
+}
+
+func TestReconcileInvoiceZeroTolerance(t *testing.T) {
+	proForma := &ProForma{Contracts: []Contract{{ContractId: "1001", Price: MustMoney("100", "EUR")}}}
+	invoice := &Invoice{Id: "00000001", Lines: []Line{{ContractId: "1001", TotalAmount: MustMoney("100.01", "EUR")}}}
+
+	assert := assert.New(t)
+	result, err := ReconcileInvoice(proForma, invoice, nil)
+	assert.Nil(err)
+	assert.Equal(result.Items[0].Status, RECONCILIATION_MATCHED)
+	zero := Money{}
+	result, err = ReconcileInvoice(proForma, invoice, &zero)
+	assert.Nil(err)
+	assert.Equal(result.Items[0].Status, RECONCILIATION_PRICE_CHANGED)
+
+	negative := MustMoney("-0.01", "")
+	_, err = ReconcileInvoice(proForma, invoice, &negative)
+	assert.Equal(err.Error(), "reconciliation tolerance -0.01 must not be negative")
+}
+
+func TestReconcileProFormaServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ReconcileProForma(ProFormaReconciliationOptions{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
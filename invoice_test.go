@@ -1,8 +1,14 @@
 package leaseweb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,7 +22,7 @@ func TestListInvoices(t *testing.T) {
 			{
 				"currency": "EUR",
 				"date": "2022-01-06T00:00:00+00:00",
-				"dueDate": "2022-02-30T00:00:00+00:00",
+				"dueDate": "2022-02-28T00:00:00+00:00",
 				"id": "00000001",
 				"isPartialPaymentAllowed": true,
 				"openAmount": 1756.21,
@@ -51,24 +57,24 @@ func TestListInvoices(t *testing.T) {
 
 	invoices1 := response.Invoices[0]
 	assert.Equal(invoices1.Currency, "EUR")
-	assert.Equal(invoices1.Date, "2022-01-06T00:00:00+00:00")
-	assert.Equal(invoices1.DueDate, "2022-02-30T00:00:00+00:00")
+	assert.Equal(invoices1.Date, MustTime("2022-01-06T00:00:00+00:00"))
+	assert.Equal(invoices1.DueDate, MustTime("2022-02-28T00:00:00+00:00"))
 	assert.Equal(invoices1.IsPartialPaymentAllowed, true)
-	assert.Equal(invoices1.OpenAmount, float32(1756.21))
+	assert.Equal(invoices1.OpenAmount, MustMoney("1756.21", "EUR"))
 	assert.Equal(invoices1.Status, "OVERDUE")
-	assert.Equal(invoices1.TaxAmount, float32(0.0))
-	assert.Equal(invoices1.Total, float32(1756.21))
+	assert.Equal(invoices1.TaxAmount, MustMoney("0", "EUR"))
+	assert.Equal(invoices1.Total, MustMoney("1756.21", "EUR"))
 
 	invoices2 := response.Invoices[1]
 	assert.Equal(invoices2.Currency, "EUR")
-	assert.Equal(invoices2.Date, "2022-03-06T00:00:00+00:00")
-	assert.Equal(invoices2.DueDate, "2022-04-30T00:00:00+00:00")
+	assert.Equal(invoices2.Date, MustTime("2022-03-06T00:00:00+00:00"))
+	assert.Equal(invoices2.DueDate, MustTime("2022-04-30T00:00:00+00:00"))
 	assert.Equal(invoices2.Id, "00000002")
 	assert.Equal(invoices2.IsPartialPaymentAllowed, true)
-	assert.Equal(invoices2.OpenAmount, float32(34))
+	assert.Equal(invoices2.OpenAmount, MustMoney("34", "EUR"))
 	assert.Equal(invoices2.Status, "OPEN")
-	assert.Equal(invoices2.TaxAmount, float32(0.0))
-	assert.Equal(invoices2.Total, float32(34))
+	assert.Equal(invoices2.TaxAmount, MustMoney("0", "EUR"))
+	assert.Equal(invoices2.Total, MustMoney("34", "EUR"))
 }
 
 func TestListInvoicesBeEmpty(t *testing.T) {
@@ -233,32 +239,32 @@ func TestGetProForma(t *testing.T) {
 	assert.Equal(len(response.Contracts), 2)
 
 	assert.Equal(response.Currency, "EUR")
-	assert.Equal(response.ProformaDate, "2021-07-01T00:00:00+00:00")
-	assert.Equal(response.SubTotal, float32(300.04))
-	assert.Equal(response.Total, float32(352.55))
-	assert.Equal(response.VatAmount, float32(52.51))
+	assert.Equal(response.ProformaDate, MustTime("2021-07-01T00:00:00+00:00"))
+	assert.Equal(response.SubTotal, MustMoney("300.04", "EUR"))
+	assert.Equal(response.Total, MustMoney("352.55", "EUR"))
+	assert.Equal(response.VatAmount, MustMoney("52.51", "EUR"))
 
 	contract1 := response.Contracts[0]
 	assert.Equal(contract1.Currency, "EUR")
 	assert.Equal(contract1.ContractId, "50000103")
-	assert.Equal(contract1.EndDate, "2022-01-01T00:00:00+00:00")
+	assert.Equal(contract1.EndDate, MustTime("2022-01-01T00:00:00+00:00"))
 	assert.Equal(contract1.EquipmentId, "26430")
 	assert.Equal(contract1.PoNumber, "40002154000110")
-	assert.Equal(contract1.Price, float32(151.05))
+	assert.Equal(contract1.Price, MustMoney("151.05", "EUR"))
 	assert.Equal(contract1.Product, "DEDICATED SERVER")
 	assert.Equal(contract1.Reference, "this is a reference 1")
-	assert.Equal(contract1.StartDate, "2022-03-01T00:00:00+00:00")
+	assert.Equal(contract1.StartDate, MustTime("2022-03-01T00:00:00+00:00"))
 
 	contract2 := response.Contracts[1]
 	assert.Equal(contract2.Currency, "EUR")
 	assert.Equal(contract2.ContractId, "50000104")
-	assert.Equal(contract2.EndDate, "2021-01-01T00:00:00+00:00")
+	assert.Equal(contract2.EndDate, MustTime("2021-01-01T00:00:00+00:00"))
 	assert.Equal(contract2.EquipmentId, "26431")
 	assert.Equal(contract2.PoNumber, "40002154000111")
-	assert.Equal(contract2.Price, float32(150.05))
+	assert.Equal(contract2.Price, MustMoney("150.05", "EUR"))
 	assert.Equal(contract2.Product, "ATS")
 	assert.Equal(contract2.Reference, "this is a reference 2")
-	assert.Equal(contract2.StartDate, "2020-02-01T00:00:00+00:00")
+	assert.Equal(contract2.StartDate, MustTime("2020-02-01T00:00:00+00:00"))
 }
 
 func TestGetProFormaPaginate(t *testing.T) {
@@ -300,21 +306,21 @@ func TestGetProFormaPaginate(t *testing.T) {
 	assert.Equal(len(response.Contracts), 1)
 
 	assert.Equal(response.Currency, "EUR")
-	assert.Equal(response.ProformaDate, "2021-07-01T00:00:00+00:00")
-	assert.Equal(response.SubTotal, float32(300.04))
-	assert.Equal(response.Total, float32(352.55))
-	assert.Equal(response.VatAmount, float32(52.51))
+	assert.Equal(response.ProformaDate, MustTime("2021-07-01T00:00:00+00:00"))
+	assert.Equal(response.SubTotal, MustMoney("300.04", "EUR"))
+	assert.Equal(response.Total, MustMoney("352.55", "EUR"))
+	assert.Equal(response.VatAmount, MustMoney("52.51", "EUR"))
 
 	contract1 := response.Contracts[0]
 	assert.Equal(contract1.Currency, "EUR")
 	assert.Equal(contract1.ContractId, "50000103")
-	assert.Equal(contract1.EndDate, "2022-01-01T00:00:00+00:00")
+	assert.Equal(contract1.EndDate, MustTime("2022-01-01T00:00:00+00:00"))
 	assert.Equal(contract1.EquipmentId, "26430")
 	assert.Equal(contract1.PoNumber, "40002154000110")
-	assert.Equal(contract1.Price, float32(151.05))
+	assert.Equal(contract1.Price, MustMoney("151.05", "EUR"))
 	assert.Equal(contract1.Product, "DEDICATED SERVER")
 	assert.Equal(contract1.Reference, "this is a reference 1")
-	assert.Equal(contract1.StartDate, "2022-03-01T00:00:00+00:00")
+	assert.Equal(contract1.StartDate, MustTime("2022-03-01T00:00:00+00:00"))
 }
 
 func TestGetProFormaServerErrors(t *testing.T) {
@@ -438,27 +444,27 @@ func TestGetInvoice(t *testing.T) {
 	assert.Equal(len(response.Lines), 1)
 
 	assert.Equal(response.Currency, "EUR")
-	assert.Equal(response.Date, "2022-07-01T00:00:00+00:00")
-	assert.Equal(response.DueDate, "2019-05-30T00:00:00+00:00")
+	assert.Equal(response.Date, MustTime("2022-07-01T00:00:00+00:00"))
+	assert.Equal(response.DueDate, MustTime("2019-05-30T00:00:00+00:00"))
 	assert.Equal(response.Id, "00000001")
 	assert.Equal(response.IsPartialPaymentAllowed, true)
-	assert.Equal(response.OpenAmount, float32(1751.21))
+	assert.Equal(response.OpenAmount, MustMoney("1751.21", "EUR"))
 	assert.Equal(response.Status, "OPEN")
-	assert.Equal(response.TaxAmount, float32(0))
-	assert.Equal(response.Total, float32(1756.21))
+	assert.Equal(response.TaxAmount, MustMoney("0", "EUR"))
+	assert.Equal(response.Total, MustMoney("1756.21", "EUR"))
 
-	assert.Equal(response.Credits[0].Date, "2019-05-06T00:00:00+00:00")
+	assert.Equal(response.Credits[0].Date, MustTime("2019-05-06T00:00:00+00:00"))
 	assert.Equal(response.Credits[0].Id, "00001211")
-	assert.Equal(response.Credits[0].TaxAmount, float32(1.5))
-	assert.Equal(response.Credits[0].Total, float32(15))
+	assert.Equal(response.Credits[0].TaxAmount, MustMoney("1.5", "EUR"))
+	assert.Equal(response.Credits[0].Total, MustMoney("15", "EUR"))
 
 	assert.Equal(response.Lines[0].ContractId, "12345678")
 	assert.Equal(response.Lines[0].EquipmentId, "1234")
 	assert.Equal(response.Lines[0].Product, "Rackspace")
 	assert.Equal(response.Lines[0].Quantity, 1)
 	assert.Equal(response.Lines[0].Reference, "This is a reference")
-	assert.Equal(response.Lines[0].TotalAmount, float32(151.5))
-	assert.Equal(response.Lines[0].UnitAmount, float32(152.5))
+	assert.Equal(response.Lines[0].TotalAmount, MustMoney("151.5", "EUR"))
+	assert.Equal(response.Lines[0].UnitAmount, MustMoney("152.5", "EUR"))
 }
 
 func TestGetInvoiceServerErrors(t *testing.T) {
@@ -534,3 +540,278 @@ func TestGetInvoiceServerErrors(t *testing.T) {
 	}
 	assertServerErrorTests(t, serverErrorTests)
 }
+
+func TestDownloadInvoicePdf(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/invoices/v1/invoices/00000001/pdf", r.URL.Path)
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="00000001.pdf"`)
+		fmt.Fprint(w, "%PDF-1.4")
+	})
+	defer teardown()
+
+	download, err := InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.ContentType, "application/pdf")
+	assert.Equal(download.Filename, "00000001.pdf")
+	content, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(content), "%PDF-1.4")
+}
+
+func TestDownloadInvoicePdfServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.DownloadInvoicePdf(context.Background(), "00000001")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestSyncInvoiceArchive(t *testing.T) {
+	downloads := []string{}
+	status := "OPEN"
+	downloadPdf := func(w http.ResponseWriter, r *http.Request) {
+		downloads = append(downloads, path.Dir(r.URL.Path))
+		fmt.Fprint(w, "%PDF-1.4 "+path.Base(path.Dir(r.URL.Path)))
+	}
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /invoices/v1/invoices": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("offset") == "0" {
+				fmt.Fprintf(w, `{"_metadata": {"limit": 1, "offset": 0, "totalCount": 2}, "invoices": [
+					{"id": "00000001", "date": "2023-01-01", "currency": "EUR", "total": 10.5, "status": %q}
+				]}`, status)
+				return
+			}
+			fmt.Fprintf(w, `{"_metadata": {"limit": 1, "offset": 1, "totalCount": 2}, "invoices": [
+				{"id": "00000002", "date": "2023-02-01T00:00:00+00:00", "currency": "EUR", "total": 20, "status": "PAID"}
+			]}`)
+		},
+		"GET /invoices/v1/invoices/00000001": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"id": "00000001", "date": "2023-01-01", "currency": "EUR", "total": 10.5, "status": %q, "lineItems": [
+				{"contractId": "1001", "product": "Dedicated Server", "quantity": 1, "totalAmount": 10.5, "unitAmount": 10.5}
+			]}`, status)
+		},
+		"GET /invoices/v1/invoices/00000002": respondWith(`{"id": "00000002", "date": "2023-02-01T00:00:00+00:00", "currency": "EUR", "total": 20, "status": "PAID",
+			"lineItems": [], "credits": [{"id": "C0001", "date": "2023-02-10", "total": 5}]}`),
+		"GET /invoices/v1/invoices/00000001/pdf": downloadPdf,
+		"GET /invoices/v1/invoices/00000002/pdf": downloadPdf,
+	}))
+	defer teardown()
+
+	dir := t.TempDir()
+	result, err := InvoiceApi{}.SyncInvoiceArchive(context.Background(), dir)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(result.Downloaded, []string{"00000001", "00000002"})
+	assert.Empty(result.Skipped)
+
+	content, err := os.ReadFile(filepath.Join(dir, "2023-01-01_00000001.pdf"))
+	assert.Nil(err)
+	assert.Equal(string(content), "%PDF-1.4 00000001")
+	content, err = os.ReadFile(filepath.Join(dir, "2023-02-01_00000002.pdf"))
+	assert.Nil(err)
+	assert.Equal(string(content), "%PDF-1.4 00000002")
+
+	invoice := Invoice{}
+	content, err = os.ReadFile(filepath.Join(dir, "2023-01-01_00000001.json"))
+	assert.Nil(err)
+	assert.Contains(string(content), `"lineItems"`)
+	assert.Nil(json.Unmarshal(content, &invoice))
+	assert.Equal(invoice.Status, "OPEN")
+	assert.Equal(len(invoice.Lines), 1)
+	assert.Equal(invoice.Lines[0].Product, "Dedicated Server")
+	content, err = os.ReadFile(filepath.Join(dir, "2023-02-01_00000002.json"))
+	assert.Nil(err)
+	assert.Nil(json.Unmarshal(content, &invoice))
+	assert.Equal(len(invoice.Credits), 1)
+
+	status = "PAID"
+	result, err = InvoiceApi{}.SyncInvoiceArchive(context.Background(), dir)
+	assert.Nil(err)
+	assert.Empty(result.Downloaded)
+	assert.Equal(result.Skipped, []string{"00000001", "00000002"})
+	assert.Equal(len(downloads), 2)
+
+	content, err = os.ReadFile(filepath.Join(dir, "2023-01-01_00000001.json"))
+	assert.Nil(err)
+	assert.Nil(json.Unmarshal(content, &invoice))
+	assert.Equal(invoice.Status, "PAID")
+}
+
+func TestSyncInvoiceArchiveServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.SyncInvoiceArchive(context.Background(), t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.SyncInvoiceArchive(context.Background(), t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.SyncInvoiceArchive(context.Background(), t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.SyncInvoiceArchive(context.Background(), t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return InvoiceApi{}.SyncInvoiceArchive(context.Background(), t.TempDir())
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -48,11 +48,11 @@ type NullRoutes struct {
 type NullRoute struct {
 	Id                   string             `json:"id"`
 	Ip                   string             `json:"ip"`
-	NulledAt             string             `json:"nulledAt"`
+	NulledAt             Time               `json:"nulledAt"`
 	NulledBy             string             `json:"nulledBy"`
 	NullLevel            int                `json:"nullLevel"`
-	AutomatedUnnullingAt string             `json:"automatedUnnullingAt"`
-	UnnulledAt           string             `json:"unnulledAt"`
+	AutomatedUnnullingAt Time               `json:"automatedUnnullingAt"`
+	UnnulledAt           Time               `json:"unnulledAt"`
 	UnnulledBy           string             `json:"unnulledBy"`
 	TicketId             string             `json:"ticketId"`
 	Comment              string             `json:"comment"`
@@ -518,12 +518,12 @@ func TestIpManagementNullRouteIp(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(NullRoute.Id, "4534536")
 	assert.Equal(NullRoute.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute.AutomatedUnnullingAt, "2015-06-25T11:13:00Z")
+	assert.Equal(NullRoute.AutomatedUnnullingAt, MustTime("2015-06-25T11:13:00Z"))
 	assert.Equal(NullRoute.Comment, "This IP is evil")
 	assert.Equal(NullRoute.EquipmentId, "456")
 	assert.Equal(NullRoute.Ip, "192.0.2.1")
 	assert.Equal(NullRoute.NullLevel, 1)
-	assert.Equal(NullRoute.NulledAt, "2015-06-28T12:00:00Z")
+	assert.Equal(NullRoute.NulledAt, MustTime("2015-06-28T12:00:00Z"))
 	assert.Equal(NullRoute.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute.TicketId, "188612")
 	assert.Empty(NullRoute.UnnulledAt)
@@ -772,12 +772,12 @@ func TestIpManagementListNullRouteHistory(t *testing.T) {
 	NullRoute1 := response.NullRoutes[0]
 	assert.Equal(NullRoute1.Id, "4534536")
 	assert.Equal(NullRoute1.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute1.AutomatedUnnullingAt, "2015-06-28T13:00:00Z")
+	assert.Equal(NullRoute1.AutomatedUnnullingAt, MustTime("2015-06-28T13:00:00Z"))
 	assert.Equal(NullRoute1.Comment, "This IP is evil")
 	assert.Equal(NullRoute1.EquipmentId, "456")
 	assert.Equal(NullRoute1.Ip, "192.0.2.1")
 	assert.Equal(NullRoute1.NullLevel, 1)
-	assert.Equal(NullRoute1.NulledAt, "2015-06-28T12:00:00Z")
+	assert.Equal(NullRoute1.NulledAt, MustTime("2015-06-28T12:00:00Z"))
 	assert.Equal(NullRoute1.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute1.TicketId, "188612")
 	assert.Empty(NullRoute1.UnnulledAt)
@@ -786,15 +786,15 @@ func TestIpManagementListNullRouteHistory(t *testing.T) {
 
 	assert.Equal(NullRoute2.Id, "4534535")
 	assert.Equal(NullRoute2.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute2.AutomatedUnnullingAt, "2015-06-27T13:00:00Z")
+	assert.Equal(NullRoute2.AutomatedUnnullingAt, MustTime("2015-06-27T13:00:00Z"))
 	assert.Equal(NullRoute2.Comment, "This IP is evil")
 	assert.Equal(NullRoute2.EquipmentId, "456")
 	assert.Equal(NullRoute2.Ip, "192.0.2.1")
 	assert.Equal(NullRoute2.NullLevel, 1)
-	assert.Equal(NullRoute2.NulledAt, "2015-06-27T12:00:00Z")
+	assert.Equal(NullRoute2.NulledAt, MustTime("2015-06-27T12:00:00Z"))
 	assert.Equal(NullRoute2.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute2.TicketId, "188612")
-	assert.Equal(NullRoute2.UnnulledAt, "2015-06-27T13:00:05Z")
+	assert.Equal(NullRoute2.UnnulledAt, MustTime("2015-06-27T13:00:05Z"))
 	assert.Equal(NullRoute2.UnnulledBy, "UnnullRunner")
 }
 
@@ -842,12 +842,12 @@ func TestIpManagementListNullRouteHistoryPaginate(t *testing.T) {
 	NullRoute1 := response.NullRoutes[0]
 	assert.Equal(NullRoute1.Id, "4534536")
 	assert.Equal(NullRoute1.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute1.AutomatedUnnullingAt, "2015-06-28T13:00:00Z")
+	assert.Equal(NullRoute1.AutomatedUnnullingAt, MustTime("2015-06-28T13:00:00Z"))
 	assert.Equal(NullRoute1.Comment, "This IP is evil")
 	assert.Equal(NullRoute1.EquipmentId, "456")
 	assert.Equal(NullRoute1.Ip, "192.0.2.1")
 	assert.Equal(NullRoute1.NullLevel, 1)
-	assert.Equal(NullRoute1.NulledAt, "2015-06-28T12:00:00Z")
+	assert.Equal(NullRoute1.NulledAt, MustTime("2015-06-28T12:00:00Z"))
 	assert.Equal(NullRoute1.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute1.TicketId, "188612")
 	assert.Empty(NullRoute1.UnnulledAt)
@@ -958,12 +958,12 @@ func TestIpManagementGetNullRouteHistory(t *testing.T) {
 
 	assert.Equal(NullRoute.Id, "4534536")
 	assert.Equal(NullRoute.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute.AutomatedUnnullingAt, "2015-06-28T13:00:00Z")
+	assert.Equal(NullRoute.AutomatedUnnullingAt, MustTime("2015-06-28T13:00:00Z"))
 	assert.Equal(NullRoute.Comment, "This IP is evil")
 	assert.Equal(NullRoute.EquipmentId, "456")
 	assert.Equal(NullRoute.Ip, "192.0.2.1")
 	assert.Equal(NullRoute.NullLevel, 1)
-	assert.Equal(NullRoute.NulledAt, "2015-06-28T12:00:00Z")
+	assert.Equal(NullRoute.NulledAt, MustTime("2015-06-28T12:00:00Z"))
 	assert.Equal(NullRoute.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute.TicketId, "188612")
 	assert.Empty(NullRoute.UnnulledAt)
@@ -1079,12 +1079,12 @@ func TestIpManagementUpdateNullRouteHistory(t *testing.T) {
 
 	assert.Equal(NullRoute.Id, "4534536")
 	assert.Equal(NullRoute.AssignedContract.Id, "123456")
-	assert.Equal(NullRoute.AutomatedUnnullingAt, "2015-06-28T13:00:00Z")
+	assert.Equal(NullRoute.AutomatedUnnullingAt, MustTime("2015-06-28T13:00:00Z"))
 	assert.Equal(NullRoute.Comment, "This IP is evil")
 	assert.Equal(NullRoute.EquipmentId, "456")
 	assert.Equal(NullRoute.Ip, "192.0.2.1")
 	assert.Equal(NullRoute.NullLevel, 1)
-	assert.Equal(NullRoute.NulledAt, "2015-06-28T12:00:00Z")
+	assert.Equal(NullRoute.NulledAt, MustTime("2015-06-28T12:00:00Z"))
 	assert.Equal(NullRoute.NulledBy, "john.doe@example.com")
 	assert.Equal(NullRoute.TicketId, "188612")
 	assert.Empty(NullRoute.UnnulledAt)
@@ -0,0 +1,187 @@
+package leasewebtest
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type abuseReportState struct {
+	report      leaseweb.AbuseReport
+	messages    []leaseweb.AbuseMessage
+	resolutions []leaseweb.Resolution
+	attachments map[string][]byte
+}
+
+func (s *Server) AddAbuseReport(report leaseweb.AbuseReport, messages []leaseweb.AbuseMessage, resolutions []leaseweb.Resolution) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if report.Id == "" {
+		report.Id = s.nextId()
+	}
+	if report.Status == "" {
+		report.Status = "OPEN"
+	}
+	report.TotalMessagesCount = len(messages)
+	s.abuseReports = append(s.abuseReports, &abuseReportState{
+		report:      report,
+		messages:    messages,
+		resolutions: resolutions,
+	})
+}
+
+func (s *Server) SetAbuseAttachmentContent(abuseReportId string, attachmentId string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, state := range s.abuseReports {
+		if state.report.Id != abuseReportId {
+			continue
+		}
+		if state.attachments == nil {
+			state.attachments = map[string][]byte{}
+		}
+		state.attachments[attachmentId] = content
+	}
+}
+
+func (s *Server) handleAbuseReports(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		s.listAbuseReports(w, r)
+		return
+	}
+
+	var state *abuseReportState
+	for _, candidate := range s.abuseReports {
+		if candidate.report.Id == segments[0] {
+			state = candidate
+		}
+	}
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		writeJson(w, http.StatusOK, state.report)
+		return
+	}
+
+	switch segments[1] {
+	case "messages":
+		s.handleAbuseReportMessages(w, r, state)
+	case "attachments", "messageAttachments":
+		if len(segments) != 3 {
+			writeNotFound(w)
+			return
+		}
+		s.serveAbuseAttachment(w, state, segments[1], segments[2])
+	case "resolutions":
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		writeJson(w, http.StatusOK, leaseweb.Resolutions{Resolutions: state.resolutions})
+	case "resolve":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		payload := map[string][]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, resolution := range payload["resolutions"] {
+			if !hasResolution(state.resolutions, resolution) {
+				writeError(w, http.StatusBadRequest, "Unknown resolution "+resolution)
+				return
+			}
+		}
+		state.report.Status = "CLOSED"
+		state.report.UpdatedAt = now()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) listAbuseReports(w http.ResponseWriter, r *http.Request) {
+	var statuses []string
+	if status := r.URL.Query().Get("status"); status != "" {
+		statuses = strings.Split(status, ",")
+	}
+
+	reports := []leaseweb.AbuseReport{}
+	for _, state := range s.abuseReports {
+		if len(statuses) > 0 && !containsString(statuses, state.report.Status) {
+			continue
+		}
+		reports = append(reports, state.report)
+	}
+	page, metadata := paginate(r, reports)
+	writeJson(w, http.StatusOK, leaseweb.AbuseReports{AbuseReports: page, Metadata: metadata})
+}
+
+func (s *Server) handleAbuseReportMessages(w http.ResponseWriter, r *http.Request, state *abuseReportState) {
+	switch r.Method {
+	case http.MethodGet:
+		page, metadata := paginate(r, state.messages)
+		writeJson(w, http.StatusOK, leaseweb.AbuseMessages{Messages: page, Metadata: metadata})
+	case http.MethodPost:
+		payload := map[string]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		state.messages = append(state.messages, leaseweb.AbuseMessage{
+			PostedBy: "CUSTOMER",
+			PostedAt: now(),
+			Body:     payload["body"],
+		})
+		state.report.TotalMessagesCount = len(state.messages)
+		state.report.Status = "WAITING"
+		state.report.UpdatedAt = now()
+		writeJson(w, http.StatusAccepted, []string{"To make sure the request has been processed please see if the message is added to the list."})
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) serveAbuseAttachment(w http.ResponseWriter, state *abuseReportState, kind string, attachmentId string) {
+	attachments := state.report.Attachments
+	if kind == "messageAttachments" {
+		attachments = nil
+		for _, message := range state.messages {
+			attachments = append(attachments, message.Attachment)
+		}
+	}
+
+	content, ok := state.attachments[attachmentId]
+	for _, attachment := range attachments {
+		if ok && attachment.Id == attachmentId {
+			w.Header().Set("Content-Type", attachment.MimeType)
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+			w.Write(content)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func hasResolution(resolutions []leaseweb.Resolution, id string) bool {
+	for _, resolution := range resolutions {
+		if resolution.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
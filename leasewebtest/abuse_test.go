@@ -0,0 +1,77 @@
+package leasewebtest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestAbuseReportLifecycle(t *testing.T) {
+	s := newTestServer(t)
+	s.AddAbuseReport(
+		leaseweb.AbuseReport{Id: "000001", Subject: "Spam", DetectedIpAddresses: []string{"192.0.2.10"}},
+		nil,
+		[]leaseweb.Resolution{{Id: "CONTENT_REMOVED", Description: "The mentioned content has been removed."}},
+	)
+	s.AddAbuseReport(leaseweb.AbuseReport{Id: "000002", Status: "CLOSED"}, nil, nil)
+
+	api := leaseweb.AbuseApi{}
+	assert := assert.New(t)
+	reports, err := api.ListAbuseReports(0, []string{"OPEN", "WAITING"})
+	assert.Nil(err)
+	assert.Equal(reports.Metadata.TotalCount, 1)
+	assert.Equal(reports.AbuseReports[0].Id, "000001")
+
+	_, err = api.CreateNewAbuseReportMessage("000001", "We are looking into it.")
+	assert.Nil(err)
+	messages, err := api.GetAbuseReportMessages("000001")
+	assert.Nil(err)
+	assert.Equal(len(messages.Messages), 1)
+	assert.Equal(messages.Messages[0].Body, "We are looking into it.")
+
+	report, err := api.GetAbuseReport("000001")
+	assert.Nil(err)
+	assert.Equal(report.Status, "WAITING")
+	assert.Equal(report.TotalMessagesCount, 1)
+
+	assert.Equal(api.ResolveAbuseReport("000001", []string{"UNKNOWN"}).Error(), "Unknown resolution UNKNOWN")
+	assert.Nil(api.ResolveAbuseReport("000001", []string{"CONTENT_REMOVED"}))
+
+	report, err = api.GetAbuseReport("000001")
+	assert.Nil(err)
+	assert.Equal(report.Status, "CLOSED")
+}
+
+func TestServerAbuseAttachments(t *testing.T) {
+	s := newTestServer(t)
+	s.AddAbuseReport(
+		leaseweb.AbuseReport{Id: "000001", Attachments: []leaseweb.Attachment{{Id: "a1", MimeType: "text/plain", Filename: "evidence.txt"}}},
+		[]leaseweb.AbuseMessage{{Body: "Screenshot", Attachment: leaseweb.Attachment{Id: "m1", MimeType: "image/png", Filename: "screenshot.png"}}},
+		nil,
+	)
+	s.SetAbuseAttachmentContent("000001", "a1", []byte("spam headers"))
+	s.SetAbuseAttachmentContent("000001", "m1", []byte("png data"))
+
+	api := leaseweb.AbuseApi{}
+	assert := assert.New(t)
+	download, err := api.GetAbuseReportAttachments(context.Background(), "000001", "a1")
+	assert.Nil(err)
+	assert.Equal(download.Filename, "evidence.txt")
+	assert.Equal(download.ContentType, "text/plain")
+	download.Close()
+
+	_, err = api.GetAbuseReportAttachments(context.Background(), "000001", "m1")
+	assert.Equal(err.Error(), "Resource not found")
+
+	files, err := api.SaveAbuseReportAttachments(context.Background(), "000001", t.TempDir())
+	assert.Nil(err)
+	assert.Equal(len(files), 2)
+	content, err := os.ReadFile(files[1].Path)
+	assert.Nil(err)
+	assert.Equal(string(content), "png data")
+	assert.Equal(files[1].ContentType, "image/png")
+}
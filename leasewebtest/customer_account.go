@@ -0,0 +1,143 @@
+package leasewebtest
+
+import (
+	"net/http"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func (s *Server) SetCustomerAccount(account leaseweb.CustomerAccount) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.customerAccount = account
+}
+
+func (s *Server) AddContact(contact leaseweb.Contact) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if contact.Id == "" {
+		contact.Id = s.nextId()
+	}
+	s.contacts = append(s.contacts, &contact)
+}
+
+func (s *Server) handleCustomerAccount(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "details":
+		s.handleCustomerAccountDetails(w, r)
+	case len(segments) == 1 && segments[0] == "contacts":
+		s.handleContacts(w, r)
+	case len(segments) == 2 && segments[0] == "contacts":
+		s.handleContact(w, r, segments[1])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleCustomerAccountDetails(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJson(w, http.StatusOK, s.customerAccount)
+	case http.MethodPut:
+		payload := map[string]leaseweb.Address{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.customerAccount.Address = payload["address"]
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleContacts(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		var primaryRoles []string
+		if roles := r.URL.Query().Get("primaryRoles"); roles != "" {
+			primaryRoles = strings.Split(roles, ",")
+		}
+		contacts := []leaseweb.Contact{}
+		for _, contact := range s.contacts {
+			if len(primaryRoles) > 0 && !containsAnyString(contact.PrimaryRoles, primaryRoles) {
+				continue
+			}
+			contacts = append(contacts, *contact)
+		}
+		page, metadata := paginate(r, contacts)
+		writeJson(w, http.StatusOK, leaseweb.Contacts{Contacts: page, Metadata: metadata})
+	case http.MethodPost:
+		contact := leaseweb.Contact{}
+		if err := readPayload(r, &contact); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		contact.Id = s.nextId()
+		s.contacts = append(s.contacts, &contact)
+		writeJson(w, http.StatusCreated, contact)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleContact(w http.ResponseWriter, r *http.Request, contactId string) {
+	for i, contact := range s.contacts {
+		if contact.Id != contactId {
+			continue
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, contact)
+		case http.MethodPut:
+			payload := struct {
+				Phone       *leaseweb.Phone `json:"phone"`
+				Mobile      *leaseweb.Phone `json:"mobile"`
+				Roles       []string        `json:"roles"`
+				Description *string         `json:"description"`
+			}{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if payload.Phone != nil {
+				contact.Phone = *payload.Phone
+			}
+			if payload.Mobile != nil {
+				contact.Mobile = *payload.Mobile
+			}
+			if payload.Roles != nil {
+				contact.Roles = payload.Roles
+			}
+			if payload.Description != nil {
+				contact.Description = *payload.Description
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPost:
+			payload := map[string][]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			contact.PrimaryRoles = payload["roles"]
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			s.contacts = append(s.contacts[:i], s.contacts[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
+
+func containsAnyString(values []string, candidates []string) bool {
+	for _, candidate := range candidates {
+		if containsString(values, candidate) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestCustomerAccount(t *testing.T) {
+	s := newTestServer(t)
+	s.SetCustomerAccount(leaseweb.CustomerAccount{Name: "Acme", Address: leaseweb.Address{City: "Amsterdam"}})
+	s.AddContact(leaseweb.Contact{Id: "jane", FirstName: "Jane", PrimaryRoles: []string{"ABUSE"}})
+
+	api := leaseweb.CustomerAccountApi{}
+	assert := assert.New(t)
+	assert.Nil(api.UpdateCustomerAccount(leaseweb.Address{City: "Utrecht"}))
+	account, err := api.GetCustomerAccount()
+	assert.Nil(err)
+	assert.Equal(account.Name, "Acme")
+	assert.Equal(account.Address.City, "Utrecht")
+
+	contact, err := api.CreateContact(leaseweb.Contact{FirstName: "John", Email: "john@example.com"})
+	assert.Nil(err)
+	assert.NotEmpty(contact.Id)
+
+	contacts, err := api.ListContacts(0, 10)
+	assert.Nil(err)
+	assert.Equal(contacts.Metadata.TotalCount, 2)
+
+	contacts, err = api.ListContacts(0, 10, []string{"ABUSE"})
+	assert.Nil(err)
+	assert.Equal(len(contacts.Contacts), 1)
+	assert.Equal(contacts.Contacts[0].FirstName, "Jane")
+
+	assert.Nil(api.DeleteContact(contact.Id))
+	err = api.DeleteContact(contact.Id)
+	assert.Equal(err.Error(), "Resource not found")
+}
+
+func TestCustomerAccountContact(t *testing.T) {
+	s := newTestServer(t)
+	s.AddContact(leaseweb.Contact{Id: "jane", FirstName: "Jane", Roles: []string{"TECHNICAL"}})
+
+	api := leaseweb.CustomerAccountApi{}
+	assert := assert.New(t)
+	contact, err := api.GetContact("jane")
+	assert.Nil(err)
+	assert.Equal(contact.FirstName, "Jane")
+
+	phone := leaseweb.Phone{CountryCode: "NL", DialCode: "+31", Number: "201234567"}
+	mobile := leaseweb.Phone{CountryCode: "NL", DialCode: "+31", Number: "612345678"}
+	assert.Nil(api.UpdateContact("jane", phone, []string{"TECHNICAL", "ABUSE"}, mobile, "on call"))
+	assert.Nil(api.AssignPrimaryRolesToContact("jane", []string{"ABUSE"}))
+
+	contact, err = api.GetContact("jane")
+	assert.Nil(err)
+	assert.Equal(contact.Phone, phone)
+	assert.Equal(contact.Mobile, mobile)
+	assert.Equal(contact.Roles, []string{"TECHNICAL", "ABUSE"})
+	assert.Equal(contact.Description, "on call")
+	assert.Equal(contact.PrimaryRoles, []string{"ABUSE"})
+
+	_, err = api.GetContact("john")
+	assert.Equal(err.Error(), "Resource not found")
+}
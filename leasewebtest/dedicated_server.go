@@ -0,0 +1,458 @@
+package leasewebtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type dedicatedServerState struct {
+	server      leaseweb.DedicatedServer
+	powerStatus string
+	jobs        []*leaseweb.DedicatedServerJob
+	credentials []leaseweb.DedicatedServerCredential
+}
+
+func (s *Server) AddDedicatedServer(server leaseweb.DedicatedServer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if server.Id == "" {
+		server.Id = s.nextId()
+	}
+	s.servers = append(s.servers, &dedicatedServerState{server: server, powerStatus: "on"})
+}
+
+func (s *Server) findServer(serverId string) *dedicatedServerState {
+	for _, state := range s.servers {
+		if state.server.Id == serverId {
+			return state
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleDedicatedServers(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		s.listDedicatedServers(w, r)
+		return
+	}
+
+	state := s.findServer(segments[0])
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, state.server)
+		case http.MethodPut:
+			payload := map[string]interface{}{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if reference, ok := payload["reference"]; ok {
+				state.server.Contract.Reference = fmt.Sprint(reference)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	switch segments[1] {
+	case "powerCycle", "powerOn":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		state.powerStatus = "on"
+		w.WriteHeader(http.StatusAccepted)
+	case "powerOff":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		state.powerStatus = "off"
+		w.WriteHeader(http.StatusAccepted)
+	case "powerInfo":
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		result := leaseweb.DedicatedServerPowerStatus{}
+		result.Ipmi.Status = state.powerStatus
+		result.Pdu.Status = state.powerStatus
+		writeJson(w, http.StatusOK, result)
+	case "install":
+		s.launchJob(w, r, state, "install")
+	case "hardwareScan":
+		s.launchJob(w, r, state, "hardwareScan")
+	case "rescueMode":
+		s.launchJob(w, r, state, "rescueMode")
+	case "jobs":
+		s.handleJobs(w, r, state, segments[2:])
+	case "cancelActiveJob":
+		s.finishActiveJob(w, r, state, "CANCELED")
+	case "expireActiveJob":
+		s.finishActiveJob(w, r, state, "EXPIRED")
+	case "credentials":
+		s.handleServerCredentials(w, r, state, segments[2:])
+	case "ips":
+		s.handleServerIps(w, r, state, segments[2:])
+	case "nullRouteHistory":
+		s.listServerNullRouteHistory(w, r, state)
+	case "privateNetworks":
+		if len(segments) != 3 {
+			writeNotFound(w)
+			return
+		}
+		s.handleServerPrivateNetwork(w, r, state, segments[2])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) listDedicatedServers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	servers := []leaseweb.DedicatedServer{}
+	for _, state := range s.servers {
+		if site := query.Get("site"); site != "" && state.server.Location.Site != site {
+			continue
+		}
+		if reference := query.Get("reference"); reference != "" && state.server.Contract.Reference != reference {
+			continue
+		}
+		if ip := query.Get("ip"); ip != "" && !s.serverHasIp(state.server.Id, ip) {
+			continue
+		}
+		servers = append(servers, state.server)
+	}
+
+	page, metadata := paginate(r, servers)
+	writeJson(w, http.StatusOK, leaseweb.DedicatedServers{Servers: page, Metadata: metadata})
+}
+
+func (s *Server) launchJob(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, jobType string) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	for _, job := range state.jobs {
+		if job.IsRunning {
+			writeError(w, http.StatusConflict, "The server already has an active job.")
+			return
+		}
+	}
+
+	payload := map[string]interface{}{}
+	if err := readPayload(r, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job := &leaseweb.DedicatedServerJob{
+		CreatedAt: now(),
+		UpdatedAt: now(),
+		Flow:      "tasks",
+		IsRunning: true,
+		ServerId:  state.server.Id,
+		Status:    "ACTIVE",
+		Type:      jobType,
+		Uuid:      fmt.Sprintf("%s-job-%s", state.server.Id, s.nextId()),
+	}
+	if operatingSystemId, ok := payload["operatingSystemId"]; ok {
+		job.Payload.OperatingSystemId = fmt.Sprint(operatingSystemId)
+	}
+	job.Payload.ServerId = state.server.Id
+	job.Payload.JobType = jobType
+	job.Progress.Total = 100
+	job.Progress.InProgress = 1
+	state.jobs = append(state.jobs, job)
+	writeJson(w, http.StatusOK, job)
+}
+
+func (s *Server) advanceJob(job *leaseweb.DedicatedServerJob) {
+	if !job.IsRunning {
+		return
+	}
+	job.Progress.Percentage += s.JobStepPercentage
+	job.UpdatedAt = now()
+	if job.Progress.Percentage >= 100 {
+		job.Progress.Percentage = 100
+		job.Progress.InProgress = 0
+		job.Progress.Finished = 1
+		job.IsRunning = false
+		job.Status = "FINISHED"
+	}
+}
+
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, segments []string) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	if len(segments) == 0 {
+		jobs := []leaseweb.DedicatedServerJob{}
+		for _, job := range state.jobs {
+			s.advanceJob(job)
+			jobs = append(jobs, *job)
+		}
+		page, metadata := paginate(r, jobs)
+		writeJson(w, http.StatusOK, leaseweb.DedicatedServerJobs{Jobs: page, Metadata: metadata})
+		return
+	}
+
+	for _, job := range state.jobs {
+		if job.Uuid == segments[0] {
+			s.advanceJob(job)
+			writeJson(w, http.StatusOK, job)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) finishActiveJob(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, status string) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	for _, job := range state.jobs {
+		if job.IsRunning {
+			job.IsRunning = false
+			job.Status = status
+			job.UpdatedAt = now()
+			writeJson(w, http.StatusOK, job)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) handleServerCredentials(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, segments []string) {
+	switch len(segments) {
+	case 0:
+		switch r.Method {
+		case http.MethodGet:
+			page, metadata := paginate(r, state.credentials)
+			writeJson(w, http.StatusOK, leaseweb.DedicatedServerCredentials{Credentials: page, Metadata: metadata})
+		case http.MethodPost:
+			credential := leaseweb.DedicatedServerCredential{}
+			if err := readPayload(r, &credential); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for _, existing := range state.credentials {
+				if existing.Type == credential.Type && existing.Username == credential.Username {
+					writeError(w, http.StatusConflict, "Credential already exists.")
+					return
+				}
+			}
+			state.credentials = append(state.credentials, credential)
+			writeJson(w, http.StatusCreated, credential)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case 1:
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		credentials := []leaseweb.DedicatedServerCredential{}
+		for _, credential := range state.credentials {
+			if credential.Type == segments[0] {
+				credentials = append(credentials, credential)
+			}
+		}
+		page, metadata := paginate(r, credentials)
+		writeJson(w, http.StatusOK, leaseweb.DedicatedServerCredentials{Credentials: page, Metadata: metadata})
+	case 2:
+		for i, credential := range state.credentials {
+			if credential.Type != segments[0] || credential.Username != segments[1] {
+				continue
+			}
+			switch r.Method {
+			case http.MethodGet:
+				writeJson(w, http.StatusOK, credential)
+			case http.MethodPut:
+				payload := map[string]string{}
+				if err := readPayload(r, &payload); err != nil {
+					writeError(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				state.credentials[i].Password = payload["password"]
+				writeJson(w, http.StatusOK, state.credentials[i])
+			case http.MethodDelete:
+				state.credentials = append(state.credentials[:i], state.credentials[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				writeMethodNotAllowed(w)
+			}
+			return
+		}
+		writeNotFound(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) serverHasIp(serverId, ip string) bool {
+	for _, candidate := range s.ips {
+		if candidate.EquipmentId == serverId && candidate.Ip == ip {
+			return true
+		}
+	}
+	return false
+}
+
+func toDedicatedServerIp(ip *leaseweb.Ip) leaseweb.DedicatedServerIp {
+	return leaseweb.DedicatedServerIp{
+		Gateway:       ip.Subnet.Gateway,
+		Ip:            ip.Ip,
+		MainIp:        ip.Primary,
+		NetworkType:   "PUBLIC",
+		NullRouted:    ip.NullRouted,
+		ReverseLookup: ip.ReverseLookup,
+		Version:       ip.Version,
+	}
+}
+
+func (s *Server) handleServerIps(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, segments []string) {
+	if len(segments) == 0 {
+		ips := []leaseweb.DedicatedServerIp{}
+		for _, ip := range s.ips {
+			if ip.EquipmentId == state.server.Id {
+				ips = append(ips, toDedicatedServerIp(ip))
+			}
+		}
+		page, metadata := paginate(r, ips)
+		writeJson(w, http.StatusOK, leaseweb.DedicatedServerIps{Ips: page, Metadata: metadata})
+		return
+	}
+
+	ip := s.findIp(segments[0])
+	if ip == nil || ip.EquipmentId != state.server.Id {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, toDedicatedServerIp(ip))
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			ip.ReverseLookup = payload["reverseLookup"]
+			writeJson(w, http.StatusOK, toDedicatedServerIp(ip))
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	switch segments[1] {
+	case "null":
+		s.nullRoute(ip, map[string]string{})
+		writeJson(w, http.StatusAccepted, toDedicatedServerIp(ip))
+	case "unnull":
+		s.removeNullRoute(ip)
+		writeJson(w, http.StatusAccepted, toDedicatedServerIp(ip))
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) listServerNullRouteHistory(w http.ResponseWriter, r *http.Request, state *dedicatedServerState) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	nullRoutes := []leaseweb.DedicatedServerNullRoute{}
+	for _, nullRoute := range s.nullRoutes {
+		if nullRoute.EquipmentId != state.server.Id {
+			continue
+		}
+		nullRoutes = append(nullRoutes, leaseweb.DedicatedServerNullRoute{
+			AutomatedUnnullingAt: nullRoute.AutomatedUnnullingAt,
+			Comment:              nullRoute.Comment,
+			Ip:                   nullRoute.Ip,
+			NullLevel:            nullRoute.NullLevel,
+			NulledAt:             nullRoute.NulledAt,
+			TicketId:             nullRoute.TicketId,
+		})
+	}
+	page, metadata := paginate(r, nullRoutes)
+	writeJson(w, http.StatusOK, leaseweb.DedicatedServerNullRoutes{NullRoutes: page, Metadata: metadata})
+}
+
+func (s *Server) handleServerPrivateNetwork(w http.ResponseWriter, r *http.Request, state *dedicatedServerState, privateNetworkId string) {
+	privateNetwork := s.findPrivateNetwork(privateNetworkId)
+	if privateNetwork == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		payload := map[string]int{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, member := range privateNetwork.Servers {
+			if member == state.server.Id {
+				writeError(w, http.StatusConflict, "The server is already a member of the private network.")
+				return
+			}
+		}
+		privateNetwork.Servers = append(privateNetwork.Servers, state.server.Id)
+		privateNetwork.EquipmentCount = len(privateNetwork.Servers)
+		privateNetwork.UpdatedAt = now()
+		state.server.PrivateNetworks = append(state.server.PrivateNetworks, leaseweb.DedicatedServerPrivateNetwork{
+			Id:        privateNetwork.Id,
+			LinkSpeed: payload["linkSpeed"],
+			Status:    "CONFIGURED",
+		})
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		removed := false
+		for i, member := range privateNetwork.Servers {
+			if member == state.server.Id {
+				privateNetwork.Servers = append(privateNetwork.Servers[:i], privateNetwork.Servers[i+1:]...)
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			writeNotFound(w)
+			return
+		}
+		privateNetwork.EquipmentCount = len(privateNetwork.Servers)
+		privateNetwork.UpdatedAt = now()
+		for i, membership := range state.server.PrivateNetworks {
+			if membership.Id == privateNetwork.Id {
+				state.server.PrivateNetworks = append(state.server.PrivateNetworks[:i], state.server.PrivateNetworks[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func parseNullLevel(level string) int {
+	if nullLevel, err := strconv.Atoi(level); err == nil {
+		return nullLevel
+	}
+	return 1
+}
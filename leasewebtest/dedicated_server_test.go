@@ -0,0 +1,166 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestDedicatedServerUpdateReference(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345", Location: leaseweb.Location{Site: "AMS-01"}})
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "67890", Location: leaseweb.Location{Site: "FRA-10"}})
+
+	api := leaseweb.DedicatedServerApi{}
+	assert := assert.New(t)
+	assert.Nil(api.Update("12345", map[string]interface{}{"reference": "team-a"}))
+
+	server, err := api.Get("12345")
+	assert.Nil(err)
+	assert.Equal(server.Contract.Reference, "team-a")
+
+	servers, err := api.List(0, 10, "", "", "FRA-10")
+	assert.Nil(err)
+	assert.Equal(len(servers.Servers), 1)
+	assert.Equal(servers.Servers[0].Id, "67890")
+
+	_, err = api.Get("00000")
+	assert.Equal(err.Error(), "Resource not found")
+}
+
+func TestDedicatedServerPower(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+
+	api := leaseweb.DedicatedServerApi{}
+	assert := assert.New(t)
+	assert.Nil(api.PowerOffServer("12345"))
+	status, err := api.GetPowerStatus("12345")
+	assert.Nil(err)
+	assert.Equal(status.Ipmi.Status, "off")
+	assert.Equal(status.Pdu.Status, "off")
+
+	assert.Nil(api.PowerCycleServer("12345"))
+	status, err = api.GetPowerStatus("12345")
+	assert.Nil(err)
+	assert.Equal(status.Ipmi.Status, "on")
+}
+
+func TestDedicatedServerJobProgress(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+
+	api := leaseweb.DedicatedServerApi{}
+	assert := assert.New(t)
+	job, err := api.LunchInstallation("12345", map[string]interface{}{"operatingSystemId": "UBUNTU_22_04_64BIT"})
+	assert.Nil(err)
+	assert.Equal(job.Status, "ACTIVE")
+	assert.True(job.IsRunning)
+	assert.Equal(job.Payload.OperatingSystemId, "UBUNTU_22_04_64BIT")
+
+	_, err = api.LunchInstallation("12345", map[string]interface{}{})
+	assert.Equal(err.Error(), "The server already has an active job.")
+
+	job, err = api.GetJob("12345", job.Uuid)
+	assert.Nil(err)
+	assert.Equal(job.Status, "ACTIVE")
+	assert.Equal(job.Progress.Percentage, 50)
+
+	job, err = api.GetJob("12345", job.Uuid)
+	assert.Nil(err)
+	assert.Equal(job.Status, "FINISHED")
+	assert.False(job.IsRunning)
+	assert.Equal(job.Progress.Percentage, 100)
+
+	rescue, err := api.LunchRescueMode("12345", map[string]interface{}{})
+	assert.Nil(err)
+	canceled, err := api.CancelActiveJob("12345")
+	assert.Nil(err)
+	assert.Equal(canceled.Uuid, rescue.Uuid)
+	assert.Equal(canceled.Status, "CANCELED")
+
+	jobs, err := api.ListJobs("12345")
+	assert.Nil(err)
+	assert.Equal(jobs.Metadata.TotalCount, 2)
+}
+
+func TestDedicatedServerCredentials(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+
+	api := leaseweb.DedicatedServerApi{}
+	assert := assert.New(t)
+	_, err := api.CreateCredential("12345", "OPERATING_SYSTEM", "root", "secret")
+	assert.Nil(err)
+	_, err = api.CreateCredential("12345", "OPERATING_SYSTEM", "root", "secret")
+	assert.Equal(err.Error(), "Credential already exists.")
+
+	credential, err := api.UpdateCredential("12345", "OPERATING_SYSTEM", "root", "new-secret")
+	assert.Nil(err)
+	assert.Equal(credential.Password, "new-secret")
+
+	credentials, err := api.ListCredentialsByType("12345", "OPERATING_SYSTEM")
+	assert.Nil(err)
+	assert.Equal(len(credentials.Credentials), 1)
+
+	assert.Nil(api.DeleteCredential("12345", "OPERATING_SYSTEM", "root"))
+	_, err = api.GetCredential("12345", "OPERATING_SYSTEM", "root")
+	assert.Equal(err.Error(), "Resource not found")
+}
+
+func TestDedicatedServerNullRouteIp(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.10", EquipmentId: "12345", Version: 4})
+
+	api := leaseweb.DedicatedServerApi{}
+	assert := assert.New(t)
+	ip, err := api.NullRouteAnIp("12345", "192.0.2.10")
+	assert.Nil(err)
+	assert.True(ip.NullRouted)
+
+	ips, err := api.ListIps("12345")
+	assert.Nil(err)
+	assert.True(ips.Ips[0].NullRouted)
+
+	history, err := api.ListNullRouteHistory("12345")
+	assert.Nil(err)
+	assert.Equal(len(history.NullRoutes), 1)
+	assert.Equal(history.NullRoutes[0].Ip, "192.0.2.10")
+
+	ip, err = api.RemoveNullRouteAnIp("12345", "192.0.2.10")
+	assert.Nil(err)
+	assert.False(ip.NullRouted)
+}
+
+func TestDedicatedServerPrivateNetworkMembership(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+
+	assert := assert.New(t)
+	privateNetwork, err := leaseweb.PrivateNetworkingApi{}.CreatePrivateNetwork("backend")
+	assert.Nil(err)
+
+	api := leaseweb.DedicatedServerApi{}
+	assert.Nil(api.AddServerToPrivateNetwork("12345", privateNetwork.Id, 1000))
+
+	server, err := api.Get("12345")
+	assert.Nil(err)
+	assert.Equal(len(server.PrivateNetworks), 1)
+	assert.Equal(server.PrivateNetworks[0].LinkSpeed, 1000)
+
+	privateNetwork, err = leaseweb.PrivateNetworkingApi{}.GetPrivateNetwork(privateNetwork.Id)
+	assert.Nil(err)
+	assert.Equal(privateNetwork.EquipmentCount, 1)
+	assert.Equal(privateNetwork.Servers, []string{"12345"})
+
+	assert.NotNil(leaseweb.PrivateNetworkingApi{}.DeletePrivateNetwork(privateNetwork.Id))
+	assert.Nil(api.DeleteServerFromPrivateNetwork("12345", privateNetwork.Id))
+
+	server, err = api.Get("12345")
+	assert.Nil(err)
+	assert.Empty(server.PrivateNetworks)
+	assert.Nil(leaseweb.PrivateNetworkingApi{}.DeletePrivateNetwork(privateNetwork.Id))
+}
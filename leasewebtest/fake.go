@@ -0,0 +1,58 @@
+package leasewebtest
+
+//go:generate go run ./internal/fakegen -source ../interfaces.go -output fakes.go
+
+import (
+	"fmt"
+	"sync"
+)
+
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+type CallRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+type NotImplementedError struct {
+	Method string
+}
+
+func (nie *NotImplementedError) Error() string {
+	return fmt.Sprintf("leasewebtest: %s is not implemented by the fake", nie.Method)
+}
+
+func notImplemented(method string) error {
+	return &NotImplementedError{Method: method}
+}
+
+func (cr *CallRecorder) record(method string, args ...interface{}) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.calls = append(cr.calls, Call{Method: method, Args: args})
+}
+
+func (cr *CallRecorder) Calls() []Call {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	return append([]Call(nil), cr.calls...)
+}
+
+func (cr *CallRecorder) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range cr.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (cr *CallRecorder) Reset() {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.calls = nil
+}
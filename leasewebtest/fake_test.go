@@ -0,0 +1,53 @@
+package leasewebtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func rebootAll(api leaseweb.DedicatedServerService, serverIds []string) error {
+	for _, serverId := range serverIds {
+		if err := api.PowerCycleServer(serverId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestFakeRecordsCalls(t *testing.T) {
+	fake := &FakeDedicatedServerService{
+		PowerCycleServerFunc: func(serverId string) error {
+			if serverId == "broken" {
+				return errors.New("power cycle failed")
+			}
+			return nil
+		},
+	}
+
+	assert := assert.New(t)
+	assert.Nil(rebootAll(fake, []string{"12345", "67890"}))
+	assert.Equal(fake.CallsTo("PowerCycleServer"), []Call{
+		{Method: "PowerCycleServer", Args: []interface{}{"12345"}},
+		{Method: "PowerCycleServer", Args: []interface{}{"67890"}},
+	})
+
+	fake.Reset()
+	assert.Equal(rebootAll(fake, []string{"broken", "12345"}).Error(), "power cycle failed")
+	assert.Equal(len(fake.Calls()), 1)
+}
+
+func TestFakeNotImplemented(t *testing.T) {
+	fake := &FakeInvoiceService{}
+
+	result, err := fake.ListInvoices(0, 10)
+	assert := assert.New(t)
+	assert.Nil(result)
+	notImplementedErr, ok := err.(*NotImplementedError)
+	assert.True(ok)
+	assert.Equal(notImplementedErr.Method, "InvoiceService.ListInvoices")
+	assert.Equal(fake.Calls(), []Call{{Method: "ListInvoices", Args: []interface{}{[]int{0, 10}}}})
+}
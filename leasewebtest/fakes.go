@@ -0,0 +1,1860 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package leasewebtest
+
+import (
+	"context"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+var _ leaseweb.AbuseService = &FakeAbuseService{}
+
+type FakeAbuseService struct {
+	CallRecorder
+
+	ListAbuseReportsFunc                 func(...interface{}) (*leaseweb.AbuseReports, error)
+	GetAbuseReportFunc                   func(string) (*leaseweb.AbuseReport, error)
+	GetAbuseReportMessagesFunc           func(string, ...int) (*leaseweb.AbuseMessages, error)
+	CreateNewAbuseReportMessageFunc      func(string, string) ([]string, error)
+	ListResolutionOptionsFunc            func(string) (*leaseweb.Resolutions, error)
+	ResolveAbuseReportFunc               func(string, []string) error
+	GetAbuseReportAttachmentsFunc        func(context.Context, string, string) (*leaseweb.Download, error)
+	GetAbuseReportMessageAttachmentsFunc func(context.Context, string, string) (*leaseweb.Download, error)
+	SaveAbuseReportAttachmentsFunc       func(context.Context, string, string) ([]leaseweb.DownloadedFile, error)
+}
+
+func (f *FakeAbuseService) ListAbuseReports(args ...interface{}) (*leaseweb.AbuseReports, error) {
+	f.record("ListAbuseReports", args)
+	if f.ListAbuseReportsFunc == nil {
+		return nil, notImplemented("AbuseService.ListAbuseReports")
+	}
+	return f.ListAbuseReportsFunc(args...)
+}
+
+func (f *FakeAbuseService) GetAbuseReport(abuseReportId string) (*leaseweb.AbuseReport, error) {
+	f.record("GetAbuseReport", abuseReportId)
+	if f.GetAbuseReportFunc == nil {
+		return nil, notImplemented("AbuseService.GetAbuseReport")
+	}
+	return f.GetAbuseReportFunc(abuseReportId)
+}
+
+func (f *FakeAbuseService) GetAbuseReportMessages(abuseReportId string, args ...int) (*leaseweb.AbuseMessages, error) {
+	f.record("GetAbuseReportMessages", abuseReportId, args)
+	if f.GetAbuseReportMessagesFunc == nil {
+		return nil, notImplemented("AbuseService.GetAbuseReportMessages")
+	}
+	return f.GetAbuseReportMessagesFunc(abuseReportId, args...)
+}
+
+func (f *FakeAbuseService) CreateNewAbuseReportMessage(abuseReportId string, body string) ([]string, error) {
+	f.record("CreateNewAbuseReportMessage", abuseReportId, body)
+	if f.CreateNewAbuseReportMessageFunc == nil {
+		return nil, notImplemented("AbuseService.CreateNewAbuseReportMessage")
+	}
+	return f.CreateNewAbuseReportMessageFunc(abuseReportId, body)
+}
+
+func (f *FakeAbuseService) ListResolutionOptions(abuseReportId string) (*leaseweb.Resolutions, error) {
+	f.record("ListResolutionOptions", abuseReportId)
+	if f.ListResolutionOptionsFunc == nil {
+		return nil, notImplemented("AbuseService.ListResolutionOptions")
+	}
+	return f.ListResolutionOptionsFunc(abuseReportId)
+}
+
+func (f *FakeAbuseService) ResolveAbuseReport(abuseReportId string, resolutions []string) error {
+	f.record("ResolveAbuseReport", abuseReportId, resolutions)
+	if f.ResolveAbuseReportFunc == nil {
+		return notImplemented("AbuseService.ResolveAbuseReport")
+	}
+	return f.ResolveAbuseReportFunc(abuseReportId, resolutions)
+}
+
+func (f *FakeAbuseService) GetAbuseReportAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*leaseweb.Download, error) {
+	f.record("GetAbuseReportAttachments", ctx, abuseReportId, attachmentId)
+	if f.GetAbuseReportAttachmentsFunc == nil {
+		return nil, notImplemented("AbuseService.GetAbuseReportAttachments")
+	}
+	return f.GetAbuseReportAttachmentsFunc(ctx, abuseReportId, attachmentId)
+}
+
+func (f *FakeAbuseService) GetAbuseReportMessageAttachments(ctx context.Context, abuseReportId string, attachmentId string) (*leaseweb.Download, error) {
+	f.record("GetAbuseReportMessageAttachments", ctx, abuseReportId, attachmentId)
+	if f.GetAbuseReportMessageAttachmentsFunc == nil {
+		return nil, notImplemented("AbuseService.GetAbuseReportMessageAttachments")
+	}
+	return f.GetAbuseReportMessageAttachmentsFunc(ctx, abuseReportId, attachmentId)
+}
+
+func (f *FakeAbuseService) SaveAbuseReportAttachments(ctx context.Context, abuseReportId string, dir string) ([]leaseweb.DownloadedFile, error) {
+	f.record("SaveAbuseReportAttachments", ctx, abuseReportId, dir)
+	if f.SaveAbuseReportAttachmentsFunc == nil {
+		return nil, notImplemented("AbuseService.SaveAbuseReportAttachments")
+	}
+	return f.SaveAbuseReportAttachmentsFunc(ctx, abuseReportId, dir)
+}
+
+var _ leaseweb.CustomerAccountService = &FakeCustomerAccountService{}
+
+type FakeCustomerAccountService struct {
+	CallRecorder
+
+	GetCustomerAccountFunc          func() (*leaseweb.CustomerAccount, error)
+	UpdateCustomerAccountFunc       func(leaseweb.Address) error
+	ListContactsFunc                func(...interface{}) (*leaseweb.Contacts, error)
+	CreateContactFunc               func(leaseweb.Contact) (*leaseweb.Contact, error)
+	DeleteContactFunc               func(string) error
+	GetContactFunc                  func(string) (*leaseweb.Contact, error)
+	UpdateContactFunc               func(string, leaseweb.Phone, []string, ...interface{}) error
+	AssignPrimaryRolesToContactFunc func(string, []string) error
+}
+
+func (f *FakeCustomerAccountService) GetCustomerAccount() (*leaseweb.CustomerAccount, error) {
+	f.record("GetCustomerAccount")
+	if f.GetCustomerAccountFunc == nil {
+		return nil, notImplemented("CustomerAccountService.GetCustomerAccount")
+	}
+	return f.GetCustomerAccountFunc()
+}
+
+func (f *FakeCustomerAccountService) UpdateCustomerAccount(ad leaseweb.Address) error {
+	f.record("UpdateCustomerAccount", ad)
+	if f.UpdateCustomerAccountFunc == nil {
+		return notImplemented("CustomerAccountService.UpdateCustomerAccount")
+	}
+	return f.UpdateCustomerAccountFunc(ad)
+}
+
+func (f *FakeCustomerAccountService) ListContacts(args ...interface{}) (*leaseweb.Contacts, error) {
+	f.record("ListContacts", args)
+	if f.ListContactsFunc == nil {
+		return nil, notImplemented("CustomerAccountService.ListContacts")
+	}
+	return f.ListContactsFunc(args...)
+}
+
+func (f *FakeCustomerAccountService) CreateContact(newContact leaseweb.Contact) (*leaseweb.Contact, error) {
+	f.record("CreateContact", newContact)
+	if f.CreateContactFunc == nil {
+		return nil, notImplemented("CustomerAccountService.CreateContact")
+	}
+	return f.CreateContactFunc(newContact)
+}
+
+func (f *FakeCustomerAccountService) DeleteContact(contactId string) error {
+	f.record("DeleteContact", contactId)
+	if f.DeleteContactFunc == nil {
+		return notImplemented("CustomerAccountService.DeleteContact")
+	}
+	return f.DeleteContactFunc(contactId)
+}
+
+func (f *FakeCustomerAccountService) GetContact(contactId string) (*leaseweb.Contact, error) {
+	f.record("GetContact", contactId)
+	if f.GetContactFunc == nil {
+		return nil, notImplemented("CustomerAccountService.GetContact")
+	}
+	return f.GetContactFunc(contactId)
+}
+
+func (f *FakeCustomerAccountService) UpdateContact(contactId string, phone leaseweb.Phone, roles []string, args ...interface{}) error {
+	f.record("UpdateContact", contactId, phone, roles, args)
+	if f.UpdateContactFunc == nil {
+		return notImplemented("CustomerAccountService.UpdateContact")
+	}
+	return f.UpdateContactFunc(contactId, phone, roles, args...)
+}
+
+func (f *FakeCustomerAccountService) AssignPrimaryRolesToContact(contactId string, roles []string) error {
+	f.record("AssignPrimaryRolesToContact", contactId, roles)
+	if f.AssignPrimaryRolesToContactFunc == nil {
+		return notImplemented("CustomerAccountService.AssignPrimaryRolesToContact")
+	}
+	return f.AssignPrimaryRolesToContactFunc(contactId, roles)
+}
+
+var _ leaseweb.DedicatedRackService = &FakeDedicatedRackService{}
+
+type FakeDedicatedRackService struct {
+	CallRecorder
+
+	ListFunc                                 func(...int) (*leaseweb.DedicatedRacks, error)
+	GetFunc                                  func(string) (*leaseweb.DedicatedRack, error)
+	UpdateFunc                               func(string, string) error
+	ListCredentialsFunc                      func(string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	CreateCredentialFunc                     func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	ListCredentialsByTypeFunc                func(string, string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	GetCredentialFunc                        func(string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	DeleteCredentialFunc                     func(string, string, string) error
+	UpdateCredentialFunc                     func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	GetDataTrafficMetricsFunc                func(string, ...interface{}) (*leaseweb.DedicatedServerDataTrafficMetrics, error)
+	GetBandWidthMetricsFunc                  func(string, ...interface{}) (*leaseweb.BandWidthMetrics, error)
+	ListBandWidthNotificationSettingsFunc    func(string, ...int) (*leaseweb.BandWidthNotificationSettings, error)
+	CreateBandWidthNotificationSettingFunc   func(string, string, string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	DeleteBandWidthNotificationSettingFunc   func(string, string) error
+	GetBandWidthNotificationSettingFunc      func(string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	UpdateBandWidthNotificationSettingFunc   func(string, string, map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	ListDataTrafficNotificationSettingsFunc  func(string, ...int) (*leaseweb.DataTrafficNotificationSettings, error)
+	CreateDataTrafficNotificationSettingFunc func(string, string, string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	DeleteDataTrafficNotificationSettingFunc func(string, string) error
+	GetDataTrafficNotificationSettingFunc    func(string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	UpdateDataTrafficNotificationSettingFunc func(string, string, map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	GetDdosNotificationSettingFunc           func(string) (*leaseweb.DedicatedServerDdosNotificationSetting, error)
+	UpdateDdosNotificationSettingFunc        func(string, map[string]string) error
+	ListNullRouteHistoryFunc                 func(string, ...int) (*leaseweb.DedicatedServerNullRoutes, error)
+}
+
+func (f *FakeDedicatedRackService) List(args ...int) (*leaseweb.DedicatedRacks, error) {
+	f.record("List", args)
+	if f.ListFunc == nil {
+		return nil, notImplemented("DedicatedRackService.List")
+	}
+	return f.ListFunc(args...)
+}
+
+func (f *FakeDedicatedRackService) Get(rackId string) (*leaseweb.DedicatedRack, error) {
+	f.record("Get", rackId)
+	if f.GetFunc == nil {
+		return nil, notImplemented("DedicatedRackService.Get")
+	}
+	return f.GetFunc(rackId)
+}
+
+func (f *FakeDedicatedRackService) Update(rackId string, reference string) error {
+	f.record("Update", rackId, reference)
+	if f.UpdateFunc == nil {
+		return notImplemented("DedicatedRackService.Update")
+	}
+	return f.UpdateFunc(rackId, reference)
+}
+
+func (f *FakeDedicatedRackService) ListCredentials(rackId string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentials", rackId, args)
+	if f.ListCredentialsFunc == nil {
+		return nil, notImplemented("DedicatedRackService.ListCredentials")
+	}
+	return f.ListCredentialsFunc(rackId, args...)
+}
+
+func (f *FakeDedicatedRackService) CreateCredential(rackId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("CreateCredential", rackId, credentialType, username, password)
+	if f.CreateCredentialFunc == nil {
+		return nil, notImplemented("DedicatedRackService.CreateCredential")
+	}
+	return f.CreateCredentialFunc(rackId, credentialType, username, password)
+}
+
+func (f *FakeDedicatedRackService) ListCredentialsByType(rackId string, credentialType string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentialsByType", rackId, credentialType, args)
+	if f.ListCredentialsByTypeFunc == nil {
+		return nil, notImplemented("DedicatedRackService.ListCredentialsByType")
+	}
+	return f.ListCredentialsByTypeFunc(rackId, credentialType, args...)
+}
+
+func (f *FakeDedicatedRackService) GetCredential(rackId string, credentialType string, username string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("GetCredential", rackId, credentialType, username)
+	if f.GetCredentialFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetCredential")
+	}
+	return f.GetCredentialFunc(rackId, credentialType, username)
+}
+
+func (f *FakeDedicatedRackService) DeleteCredential(rackId string, credentialType string, username string) error {
+	f.record("DeleteCredential", rackId, credentialType, username)
+	if f.DeleteCredentialFunc == nil {
+		return notImplemented("DedicatedRackService.DeleteCredential")
+	}
+	return f.DeleteCredentialFunc(rackId, credentialType, username)
+}
+
+func (f *FakeDedicatedRackService) UpdateCredential(rackId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("UpdateCredential", rackId, credentialType, username, password)
+	if f.UpdateCredentialFunc == nil {
+		return nil, notImplemented("DedicatedRackService.UpdateCredential")
+	}
+	return f.UpdateCredentialFunc(rackId, credentialType, username, password)
+}
+
+func (f *FakeDedicatedRackService) GetDataTrafficMetrics(rackId string, args ...interface{}) (*leaseweb.DedicatedServerDataTrafficMetrics, error) {
+	f.record("GetDataTrafficMetrics", rackId, args)
+	if f.GetDataTrafficMetricsFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetDataTrafficMetrics")
+	}
+	return f.GetDataTrafficMetricsFunc(rackId, args...)
+}
+
+func (f *FakeDedicatedRackService) GetBandWidthMetrics(rackId string, args ...interface{}) (*leaseweb.BandWidthMetrics, error) {
+	f.record("GetBandWidthMetrics", rackId, args)
+	if f.GetBandWidthMetricsFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetBandWidthMetrics")
+	}
+	return f.GetBandWidthMetricsFunc(rackId, args...)
+}
+
+func (f *FakeDedicatedRackService) ListBandWidthNotificationSettings(rackId string, args ...int) (*leaseweb.BandWidthNotificationSettings, error) {
+	f.record("ListBandWidthNotificationSettings", rackId, args)
+	if f.ListBandWidthNotificationSettingsFunc == nil {
+		return nil, notImplemented("DedicatedRackService.ListBandWidthNotificationSettings")
+	}
+	return f.ListBandWidthNotificationSettingsFunc(rackId, args...)
+}
+
+func (f *FakeDedicatedRackService) CreateBandWidthNotificationSetting(rackId string, frequency string, threshold string, unit string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("CreateBandWidthNotificationSetting", rackId, frequency, threshold, unit)
+	if f.CreateBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.CreateBandWidthNotificationSetting")
+	}
+	return f.CreateBandWidthNotificationSettingFunc(rackId, frequency, threshold, unit)
+}
+
+func (f *FakeDedicatedRackService) DeleteBandWidthNotificationSetting(rackId string, notificationSettingId string) error {
+	f.record("DeleteBandWidthNotificationSetting", rackId, notificationSettingId)
+	if f.DeleteBandWidthNotificationSettingFunc == nil {
+		return notImplemented("DedicatedRackService.DeleteBandWidthNotificationSetting")
+	}
+	return f.DeleteBandWidthNotificationSettingFunc(rackId, notificationSettingId)
+}
+
+func (f *FakeDedicatedRackService) GetBandWidthNotificationSetting(rackId string, notificationSettingId string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("GetBandWidthNotificationSetting", rackId, notificationSettingId)
+	if f.GetBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetBandWidthNotificationSetting")
+	}
+	return f.GetBandWidthNotificationSettingFunc(rackId, notificationSettingId)
+}
+
+func (f *FakeDedicatedRackService) UpdateBandWidthNotificationSetting(rackId string, notificationSettingId string, payload map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("UpdateBandWidthNotificationSetting", rackId, notificationSettingId, payload)
+	if f.UpdateBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.UpdateBandWidthNotificationSetting")
+	}
+	return f.UpdateBandWidthNotificationSettingFunc(rackId, notificationSettingId, payload)
+}
+
+func (f *FakeDedicatedRackService) ListDataTrafficNotificationSettings(rackId string, args ...int) (*leaseweb.DataTrafficNotificationSettings, error) {
+	f.record("ListDataTrafficNotificationSettings", rackId, args)
+	if f.ListDataTrafficNotificationSettingsFunc == nil {
+		return nil, notImplemented("DedicatedRackService.ListDataTrafficNotificationSettings")
+	}
+	return f.ListDataTrafficNotificationSettingsFunc(rackId, args...)
+}
+
+func (f *FakeDedicatedRackService) CreateDataTrafficNotificationSetting(rackId string, frequency string, threshold string, unit string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("CreateDataTrafficNotificationSetting", rackId, frequency, threshold, unit)
+	if f.CreateDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.CreateDataTrafficNotificationSetting")
+	}
+	return f.CreateDataTrafficNotificationSettingFunc(rackId, frequency, threshold, unit)
+}
+
+func (f *FakeDedicatedRackService) DeleteDataTrafficNotificationSetting(rackId string, notificationSettingId string) error {
+	f.record("DeleteDataTrafficNotificationSetting", rackId, notificationSettingId)
+	if f.DeleteDataTrafficNotificationSettingFunc == nil {
+		return notImplemented("DedicatedRackService.DeleteDataTrafficNotificationSetting")
+	}
+	return f.DeleteDataTrafficNotificationSettingFunc(rackId, notificationSettingId)
+}
+
+func (f *FakeDedicatedRackService) GetDataTrafficNotificationSetting(rackId string, notificationSettingId string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("GetDataTrafficNotificationSetting", rackId, notificationSettingId)
+	if f.GetDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetDataTrafficNotificationSetting")
+	}
+	return f.GetDataTrafficNotificationSettingFunc(rackId, notificationSettingId)
+}
+
+func (f *FakeDedicatedRackService) UpdateDataTrafficNotificationSetting(rackId string, notificationSettingId string, payload map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("UpdateDataTrafficNotificationSetting", rackId, notificationSettingId, payload)
+	if f.UpdateDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.UpdateDataTrafficNotificationSetting")
+	}
+	return f.UpdateDataTrafficNotificationSettingFunc(rackId, notificationSettingId, payload)
+}
+
+func (f *FakeDedicatedRackService) GetDdosNotificationSetting(rackId string) (*leaseweb.DedicatedServerDdosNotificationSetting, error) {
+	f.record("GetDdosNotificationSetting", rackId)
+	if f.GetDdosNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedRackService.GetDdosNotificationSetting")
+	}
+	return f.GetDdosNotificationSettingFunc(rackId)
+}
+
+func (f *FakeDedicatedRackService) UpdateDdosNotificationSetting(rackId string, payload map[string]string) error {
+	f.record("UpdateDdosNotificationSetting", rackId, payload)
+	if f.UpdateDdosNotificationSettingFunc == nil {
+		return notImplemented("DedicatedRackService.UpdateDdosNotificationSetting")
+	}
+	return f.UpdateDdosNotificationSettingFunc(rackId, payload)
+}
+
+func (f *FakeDedicatedRackService) ListNullRouteHistory(rackId string, args ...int) (*leaseweb.DedicatedServerNullRoutes, error) {
+	f.record("ListNullRouteHistory", rackId, args)
+	if f.ListNullRouteHistoryFunc == nil {
+		return nil, notImplemented("DedicatedRackService.ListNullRouteHistory")
+	}
+	return f.ListNullRouteHistoryFunc(rackId, args...)
+}
+
+var _ leaseweb.DedicatedServerService = &FakeDedicatedServerService{}
+
+type FakeDedicatedServerService struct {
+	CallRecorder
+
+	ListFunc                                 func(...interface{}) (*leaseweb.DedicatedServers, error)
+	GetFunc                                  func(string) (*leaseweb.DedicatedServer, error)
+	UpdateFunc                               func(string, map[string]interface{}) error
+	GetHardwareInformationFunc               func(string) (*leaseweb.DedicatedServerHardware, error)
+	ListIpsFunc                              func(string, ...interface{}) (*leaseweb.DedicatedServerIps, error)
+	GetIpFunc                                func(string, string) (*leaseweb.DedicatedServerIp, error)
+	UpdateIpFunc                             func(string, string, map[string]string) (*leaseweb.DedicatedServerIp, error)
+	NullRouteAnIpFunc                        func(string, string) (*leaseweb.DedicatedServerIp, error)
+	RemoveNullRouteAnIpFunc                  func(string, string) (*leaseweb.DedicatedServerIp, error)
+	ListNullRouteHistoryFunc                 func(string, ...int) (*leaseweb.DedicatedServerNullRoutes, error)
+	ListNetworkInterfacesFunc                func(string, ...interface{}) (*leaseweb.DedicatedServerNetworkInterfaces, error)
+	CloseAllNetworkInterfacesFunc            func(string) error
+	OpenAllNetworkInterfacesFunc             func(string) error
+	GetNetworkInterfaceFunc                  func(string, string) (*leaseweb.DedicatedServerNetworkInterface, error)
+	CloseNetworkInterfaceFunc                func(string, string) error
+	OpenNetworkInterfaceFunc                 func(string, string) error
+	DeleteServerFromPrivateNetworkFunc       func(string, string) error
+	AddServerToPrivateNetworkFunc            func(string, string, int) error
+	DeleteDhcpReservationFunc                func(string) error
+	ListDhcpReservationFunc                  func(string, ...interface{}) (*leaseweb.DedicatedServerDhcpReservations, error)
+	CreateDhcpReservationFunc                func(string, map[string]string) error
+	CancelActiveJobFunc                      func(string) (*leaseweb.DedicatedServerJob, error)
+	ExpireActiveJobFunc                      func(string) (*leaseweb.DedicatedServerJob, error)
+	LunchHardwareScanFunc                    func(string, map[string]interface{}) (*leaseweb.DedicatedServerJob, error)
+	LunchInstallationFunc                    func(string, map[string]interface{}) (*leaseweb.DedicatedServerJob, error)
+	LunchIpmiRestFunc                        func(string, map[string]interface{}) (*leaseweb.DedicatedServerJob, error)
+	ListJobsFunc                             func(string, ...int) (*leaseweb.DedicatedServerJobs, error)
+	GetJobFunc                               func(string, string) (*leaseweb.DedicatedServerJob, error)
+	LunchRescueModeFunc                      func(string, map[string]interface{}) (*leaseweb.DedicatedServerJob, error)
+	ListCredentialsFunc                      func(string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	CreateCredentialFunc                     func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	ListCredentialsByTypeFunc                func(string, string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	GetCredentialFunc                        func(string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	DeleteCredentialFunc                     func(string, string, string) error
+	UpdateCredentialFunc                     func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	GetDataTrafficMetricsFunc                func(string, ...interface{}) (*leaseweb.DedicatedServerDataTrafficMetrics, error)
+	GetBandWidthMetricsFunc                  func(string, ...interface{}) (*leaseweb.BandWidthMetrics, error)
+	ListBandWidthNotificationSettingsFunc    func(string, ...int) (*leaseweb.BandWidthNotificationSettings, error)
+	CreateBandWidthNotificationSettingFunc   func(string, string, string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	DeleteBandWidthNotificationSettingFunc   func(string, string) error
+	GetBandWidthNotificationSettingFunc      func(string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	UpdateBandWidthNotificationSettingFunc   func(string, string, map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	ListDataTrafficNotificationSettingsFunc  func(string, ...int) (*leaseweb.DataTrafficNotificationSettings, error)
+	CreateDataTrafficNotificationSettingFunc func(string, string, string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	DeleteDataTrafficNotificationSettingFunc func(string, string) error
+	GetDataTrafficNotificationSettingFunc    func(string, string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	UpdateDataTrafficNotificationSettingFunc func(string, string, map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error)
+	GetDdosNotificationSettingFunc           func(string) (*leaseweb.DedicatedServerDdosNotificationSetting, error)
+	UpdateDdosNotificationSettingFunc        func(string, map[string]string) error
+	PowerCycleServerFunc                     func(string) error
+	GetPowerStatusFunc                       func(string) (*leaseweb.DedicatedServerPowerStatus, error)
+	PowerOffServerFunc                       func(string) error
+	PowerOnServerFunc                        func(string) error
+	ListOperatingSystemsFunc                 func(...interface{}) (*leaseweb.OperatingSystems, error)
+	GetOperatingSystemFunc                   func(string, string) (*leaseweb.OperatingSystem, error)
+	ListControlPanelsFunc                    func(...interface{}) (*leaseweb.ControlPanels, error)
+	ListRescueImagesFunc                     func(...interface{}) (*leaseweb.RescueImages, error)
+}
+
+func (f *FakeDedicatedServerService) List(args ...interface{}) (*leaseweb.DedicatedServers, error) {
+	f.record("List", args)
+	if f.ListFunc == nil {
+		return nil, notImplemented("DedicatedServerService.List")
+	}
+	return f.ListFunc(args...)
+}
+
+func (f *FakeDedicatedServerService) Get(serverId string) (*leaseweb.DedicatedServer, error) {
+	f.record("Get", serverId)
+	if f.GetFunc == nil {
+		return nil, notImplemented("DedicatedServerService.Get")
+	}
+	return f.GetFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) Update(serverId string, payload map[string]interface{}) error {
+	f.record("Update", serverId, payload)
+	if f.UpdateFunc == nil {
+		return notImplemented("DedicatedServerService.Update")
+	}
+	return f.UpdateFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) GetHardwareInformation(serverId string) (*leaseweb.DedicatedServerHardware, error) {
+	f.record("GetHardwareInformation", serverId)
+	if f.GetHardwareInformationFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetHardwareInformation")
+	}
+	return f.GetHardwareInformationFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) ListIps(serverId string, args ...interface{}) (*leaseweb.DedicatedServerIps, error) {
+	f.record("ListIps", serverId, args)
+	if f.ListIpsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListIps")
+	}
+	return f.ListIpsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) GetIp(serverId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("GetIp", serverId, ip)
+	if f.GetIpFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetIp")
+	}
+	return f.GetIpFunc(serverId, ip)
+}
+
+func (f *FakeDedicatedServerService) UpdateIp(serverId string, ip string, payload map[string]string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("UpdateIp", serverId, ip, payload)
+	if f.UpdateIpFunc == nil {
+		return nil, notImplemented("DedicatedServerService.UpdateIp")
+	}
+	return f.UpdateIpFunc(serverId, ip, payload)
+}
+
+func (f *FakeDedicatedServerService) NullRouteAnIp(serverId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("NullRouteAnIp", serverId, ip)
+	if f.NullRouteAnIpFunc == nil {
+		return nil, notImplemented("DedicatedServerService.NullRouteAnIp")
+	}
+	return f.NullRouteAnIpFunc(serverId, ip)
+}
+
+func (f *FakeDedicatedServerService) RemoveNullRouteAnIp(serverId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("RemoveNullRouteAnIp", serverId, ip)
+	if f.RemoveNullRouteAnIpFunc == nil {
+		return nil, notImplemented("DedicatedServerService.RemoveNullRouteAnIp")
+	}
+	return f.RemoveNullRouteAnIpFunc(serverId, ip)
+}
+
+func (f *FakeDedicatedServerService) ListNullRouteHistory(serverId string, args ...int) (*leaseweb.DedicatedServerNullRoutes, error) {
+	f.record("ListNullRouteHistory", serverId, args)
+	if f.ListNullRouteHistoryFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListNullRouteHistory")
+	}
+	return f.ListNullRouteHistoryFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) ListNetworkInterfaces(serverId string, args ...interface{}) (*leaseweb.DedicatedServerNetworkInterfaces, error) {
+	f.record("ListNetworkInterfaces", serverId, args)
+	if f.ListNetworkInterfacesFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListNetworkInterfaces")
+	}
+	return f.ListNetworkInterfacesFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) CloseAllNetworkInterfaces(serverId string) error {
+	f.record("CloseAllNetworkInterfaces", serverId)
+	if f.CloseAllNetworkInterfacesFunc == nil {
+		return notImplemented("DedicatedServerService.CloseAllNetworkInterfaces")
+	}
+	return f.CloseAllNetworkInterfacesFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) OpenAllNetworkInterfaces(serverId string) error {
+	f.record("OpenAllNetworkInterfaces", serverId)
+	if f.OpenAllNetworkInterfacesFunc == nil {
+		return notImplemented("DedicatedServerService.OpenAllNetworkInterfaces")
+	}
+	return f.OpenAllNetworkInterfacesFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) GetNetworkInterface(serverId string, networkType string) (*leaseweb.DedicatedServerNetworkInterface, error) {
+	f.record("GetNetworkInterface", serverId, networkType)
+	if f.GetNetworkInterfaceFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetNetworkInterface")
+	}
+	return f.GetNetworkInterfaceFunc(serverId, networkType)
+}
+
+func (f *FakeDedicatedServerService) CloseNetworkInterface(serverId string, networkType string) error {
+	f.record("CloseNetworkInterface", serverId, networkType)
+	if f.CloseNetworkInterfaceFunc == nil {
+		return notImplemented("DedicatedServerService.CloseNetworkInterface")
+	}
+	return f.CloseNetworkInterfaceFunc(serverId, networkType)
+}
+
+func (f *FakeDedicatedServerService) OpenNetworkInterface(serverId string, networkType string) error {
+	f.record("OpenNetworkInterface", serverId, networkType)
+	if f.OpenNetworkInterfaceFunc == nil {
+		return notImplemented("DedicatedServerService.OpenNetworkInterface")
+	}
+	return f.OpenNetworkInterfaceFunc(serverId, networkType)
+}
+
+func (f *FakeDedicatedServerService) DeleteServerFromPrivateNetwork(serverId string, privateNetworkId string) error {
+	f.record("DeleteServerFromPrivateNetwork", serverId, privateNetworkId)
+	if f.DeleteServerFromPrivateNetworkFunc == nil {
+		return notImplemented("DedicatedServerService.DeleteServerFromPrivateNetwork")
+	}
+	return f.DeleteServerFromPrivateNetworkFunc(serverId, privateNetworkId)
+}
+
+func (f *FakeDedicatedServerService) AddServerToPrivateNetwork(serverId string, privateNetworkId string, linkSpeed int) error {
+	f.record("AddServerToPrivateNetwork", serverId, privateNetworkId, linkSpeed)
+	if f.AddServerToPrivateNetworkFunc == nil {
+		return notImplemented("DedicatedServerService.AddServerToPrivateNetwork")
+	}
+	return f.AddServerToPrivateNetworkFunc(serverId, privateNetworkId, linkSpeed)
+}
+
+func (f *FakeDedicatedServerService) DeleteDhcpReservation(serverId string) error {
+	f.record("DeleteDhcpReservation", serverId)
+	if f.DeleteDhcpReservationFunc == nil {
+		return notImplemented("DedicatedServerService.DeleteDhcpReservation")
+	}
+	return f.DeleteDhcpReservationFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) ListDhcpReservation(serverId string, args ...interface{}) (*leaseweb.DedicatedServerDhcpReservations, error) {
+	f.record("ListDhcpReservation", serverId, args)
+	if f.ListDhcpReservationFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListDhcpReservation")
+	}
+	return f.ListDhcpReservationFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) CreateDhcpReservation(serverId string, payload map[string]string) error {
+	f.record("CreateDhcpReservation", serverId, payload)
+	if f.CreateDhcpReservationFunc == nil {
+		return notImplemented("DedicatedServerService.CreateDhcpReservation")
+	}
+	return f.CreateDhcpReservationFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) CancelActiveJob(serverId string) (*leaseweb.DedicatedServerJob, error) {
+	f.record("CancelActiveJob", serverId)
+	if f.CancelActiveJobFunc == nil {
+		return nil, notImplemented("DedicatedServerService.CancelActiveJob")
+	}
+	return f.CancelActiveJobFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) ExpireActiveJob(serverId string) (*leaseweb.DedicatedServerJob, error) {
+	f.record("ExpireActiveJob", serverId)
+	if f.ExpireActiveJobFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ExpireActiveJob")
+	}
+	return f.ExpireActiveJobFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) LunchHardwareScan(serverId string, payload map[string]interface{}) (*leaseweb.DedicatedServerJob, error) {
+	f.record("LunchHardwareScan", serverId, payload)
+	if f.LunchHardwareScanFunc == nil {
+		return nil, notImplemented("DedicatedServerService.LunchHardwareScan")
+	}
+	return f.LunchHardwareScanFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) LunchInstallation(serverId string, payload map[string]interface{}) (*leaseweb.DedicatedServerJob, error) {
+	f.record("LunchInstallation", serverId, payload)
+	if f.LunchInstallationFunc == nil {
+		return nil, notImplemented("DedicatedServerService.LunchInstallation")
+	}
+	return f.LunchInstallationFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) LunchIpmiRest(serverId string, payload map[string]interface{}) (*leaseweb.DedicatedServerJob, error) {
+	f.record("LunchIpmiRest", serverId, payload)
+	if f.LunchIpmiRestFunc == nil {
+		return nil, notImplemented("DedicatedServerService.LunchIpmiRest")
+	}
+	return f.LunchIpmiRestFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) ListJobs(serverId string, args ...int) (*leaseweb.DedicatedServerJobs, error) {
+	f.record("ListJobs", serverId, args)
+	if f.ListJobsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListJobs")
+	}
+	return f.ListJobsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) GetJob(serverId string, jobId string) (*leaseweb.DedicatedServerJob, error) {
+	f.record("GetJob", serverId, jobId)
+	if f.GetJobFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetJob")
+	}
+	return f.GetJobFunc(serverId, jobId)
+}
+
+func (f *FakeDedicatedServerService) LunchRescueMode(serverId string, payload map[string]interface{}) (*leaseweb.DedicatedServerJob, error) {
+	f.record("LunchRescueMode", serverId, payload)
+	if f.LunchRescueModeFunc == nil {
+		return nil, notImplemented("DedicatedServerService.LunchRescueMode")
+	}
+	return f.LunchRescueModeFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) ListCredentials(serverId string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentials", serverId, args)
+	if f.ListCredentialsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListCredentials")
+	}
+	return f.ListCredentialsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) CreateCredential(serverId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("CreateCredential", serverId, credentialType, username, password)
+	if f.CreateCredentialFunc == nil {
+		return nil, notImplemented("DedicatedServerService.CreateCredential")
+	}
+	return f.CreateCredentialFunc(serverId, credentialType, username, password)
+}
+
+func (f *FakeDedicatedServerService) ListCredentialsByType(serverId string, credentialType string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentialsByType", serverId, credentialType, args)
+	if f.ListCredentialsByTypeFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListCredentialsByType")
+	}
+	return f.ListCredentialsByTypeFunc(serverId, credentialType, args...)
+}
+
+func (f *FakeDedicatedServerService) GetCredential(serverId string, credentialType string, username string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("GetCredential", serverId, credentialType, username)
+	if f.GetCredentialFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetCredential")
+	}
+	return f.GetCredentialFunc(serverId, credentialType, username)
+}
+
+func (f *FakeDedicatedServerService) DeleteCredential(serverId string, credentialType string, username string) error {
+	f.record("DeleteCredential", serverId, credentialType, username)
+	if f.DeleteCredentialFunc == nil {
+		return notImplemented("DedicatedServerService.DeleteCredential")
+	}
+	return f.DeleteCredentialFunc(serverId, credentialType, username)
+}
+
+func (f *FakeDedicatedServerService) UpdateCredential(serverId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("UpdateCredential", serverId, credentialType, username, password)
+	if f.UpdateCredentialFunc == nil {
+		return nil, notImplemented("DedicatedServerService.UpdateCredential")
+	}
+	return f.UpdateCredentialFunc(serverId, credentialType, username, password)
+}
+
+func (f *FakeDedicatedServerService) GetDataTrafficMetrics(serverId string, args ...interface{}) (*leaseweb.DedicatedServerDataTrafficMetrics, error) {
+	f.record("GetDataTrafficMetrics", serverId, args)
+	if f.GetDataTrafficMetricsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetDataTrafficMetrics")
+	}
+	return f.GetDataTrafficMetricsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) GetBandWidthMetrics(serverId string, args ...interface{}) (*leaseweb.BandWidthMetrics, error) {
+	f.record("GetBandWidthMetrics", serverId, args)
+	if f.GetBandWidthMetricsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetBandWidthMetrics")
+	}
+	return f.GetBandWidthMetricsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) ListBandWidthNotificationSettings(serverId string, args ...int) (*leaseweb.BandWidthNotificationSettings, error) {
+	f.record("ListBandWidthNotificationSettings", serverId, args)
+	if f.ListBandWidthNotificationSettingsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListBandWidthNotificationSettings")
+	}
+	return f.ListBandWidthNotificationSettingsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) CreateBandWidthNotificationSetting(serverId string, frequency string, threshold string, unit string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("CreateBandWidthNotificationSetting", serverId, frequency, threshold, unit)
+	if f.CreateBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.CreateBandWidthNotificationSetting")
+	}
+	return f.CreateBandWidthNotificationSettingFunc(serverId, frequency, threshold, unit)
+}
+
+func (f *FakeDedicatedServerService) DeleteBandWidthNotificationSetting(serverId string, notificationId string) error {
+	f.record("DeleteBandWidthNotificationSetting", serverId, notificationId)
+	if f.DeleteBandWidthNotificationSettingFunc == nil {
+		return notImplemented("DedicatedServerService.DeleteBandWidthNotificationSetting")
+	}
+	return f.DeleteBandWidthNotificationSettingFunc(serverId, notificationId)
+}
+
+func (f *FakeDedicatedServerService) GetBandWidthNotificationSetting(serverId string, notificationId string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("GetBandWidthNotificationSetting", serverId, notificationId)
+	if f.GetBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetBandWidthNotificationSetting")
+	}
+	return f.GetBandWidthNotificationSettingFunc(serverId, notificationId)
+}
+
+func (f *FakeDedicatedServerService) UpdateBandWidthNotificationSetting(serverId string, notificationSettingId string, payload map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("UpdateBandWidthNotificationSetting", serverId, notificationSettingId, payload)
+	if f.UpdateBandWidthNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.UpdateBandWidthNotificationSetting")
+	}
+	return f.UpdateBandWidthNotificationSettingFunc(serverId, notificationSettingId, payload)
+}
+
+func (f *FakeDedicatedServerService) ListDataTrafficNotificationSettings(serverId string, args ...int) (*leaseweb.DataTrafficNotificationSettings, error) {
+	f.record("ListDataTrafficNotificationSettings", serverId, args)
+	if f.ListDataTrafficNotificationSettingsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListDataTrafficNotificationSettings")
+	}
+	return f.ListDataTrafficNotificationSettingsFunc(serverId, args...)
+}
+
+func (f *FakeDedicatedServerService) CreateDataTrafficNotificationSetting(serverId string, frequency string, threshold string, unit string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("CreateDataTrafficNotificationSetting", serverId, frequency, threshold, unit)
+	if f.CreateDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.CreateDataTrafficNotificationSetting")
+	}
+	return f.CreateDataTrafficNotificationSettingFunc(serverId, frequency, threshold, unit)
+}
+
+func (f *FakeDedicatedServerService) DeleteDataTrafficNotificationSetting(serverId string, notificationId string) error {
+	f.record("DeleteDataTrafficNotificationSetting", serverId, notificationId)
+	if f.DeleteDataTrafficNotificationSettingFunc == nil {
+		return notImplemented("DedicatedServerService.DeleteDataTrafficNotificationSetting")
+	}
+	return f.DeleteDataTrafficNotificationSettingFunc(serverId, notificationId)
+}
+
+func (f *FakeDedicatedServerService) GetDataTrafficNotificationSetting(serverId string, notificationId string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("GetDataTrafficNotificationSetting", serverId, notificationId)
+	if f.GetDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetDataTrafficNotificationSetting")
+	}
+	return f.GetDataTrafficNotificationSettingFunc(serverId, notificationId)
+}
+
+func (f *FakeDedicatedServerService) UpdateDataTrafficNotificationSetting(serverId string, notificationSettingId string, payload map[string]string) (*leaseweb.DedicatedServerNotificationSetting, error) {
+	f.record("UpdateDataTrafficNotificationSetting", serverId, notificationSettingId, payload)
+	if f.UpdateDataTrafficNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.UpdateDataTrafficNotificationSetting")
+	}
+	return f.UpdateDataTrafficNotificationSettingFunc(serverId, notificationSettingId, payload)
+}
+
+func (f *FakeDedicatedServerService) GetDdosNotificationSetting(serverId string) (*leaseweb.DedicatedServerDdosNotificationSetting, error) {
+	f.record("GetDdosNotificationSetting", serverId)
+	if f.GetDdosNotificationSettingFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetDdosNotificationSetting")
+	}
+	return f.GetDdosNotificationSettingFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) UpdateDdosNotificationSetting(serverId string, payload map[string]string) error {
+	f.record("UpdateDdosNotificationSetting", serverId, payload)
+	if f.UpdateDdosNotificationSettingFunc == nil {
+		return notImplemented("DedicatedServerService.UpdateDdosNotificationSetting")
+	}
+	return f.UpdateDdosNotificationSettingFunc(serverId, payload)
+}
+
+func (f *FakeDedicatedServerService) PowerCycleServer(serverId string) error {
+	f.record("PowerCycleServer", serverId)
+	if f.PowerCycleServerFunc == nil {
+		return notImplemented("DedicatedServerService.PowerCycleServer")
+	}
+	return f.PowerCycleServerFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) GetPowerStatus(serverId string) (*leaseweb.DedicatedServerPowerStatus, error) {
+	f.record("GetPowerStatus", serverId)
+	if f.GetPowerStatusFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetPowerStatus")
+	}
+	return f.GetPowerStatusFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) PowerOffServer(serverId string) error {
+	f.record("PowerOffServer", serverId)
+	if f.PowerOffServerFunc == nil {
+		return notImplemented("DedicatedServerService.PowerOffServer")
+	}
+	return f.PowerOffServerFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) PowerOnServer(serverId string) error {
+	f.record("PowerOnServer", serverId)
+	if f.PowerOnServerFunc == nil {
+		return notImplemented("DedicatedServerService.PowerOnServer")
+	}
+	return f.PowerOnServerFunc(serverId)
+}
+
+func (f *FakeDedicatedServerService) ListOperatingSystems(args ...interface{}) (*leaseweb.OperatingSystems, error) {
+	f.record("ListOperatingSystems", args)
+	if f.ListOperatingSystemsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListOperatingSystems")
+	}
+	return f.ListOperatingSystemsFunc(args...)
+}
+
+func (f *FakeDedicatedServerService) GetOperatingSystem(operatingSystemId string, controlPanelId string) (*leaseweb.OperatingSystem, error) {
+	f.record("GetOperatingSystem", operatingSystemId, controlPanelId)
+	if f.GetOperatingSystemFunc == nil {
+		return nil, notImplemented("DedicatedServerService.GetOperatingSystem")
+	}
+	return f.GetOperatingSystemFunc(operatingSystemId, controlPanelId)
+}
+
+func (f *FakeDedicatedServerService) ListControlPanels(args ...interface{}) (*leaseweb.ControlPanels, error) {
+	f.record("ListControlPanels", args)
+	if f.ListControlPanelsFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListControlPanels")
+	}
+	return f.ListControlPanelsFunc(args...)
+}
+
+func (f *FakeDedicatedServerService) ListRescueImages(args ...interface{}) (*leaseweb.RescueImages, error) {
+	f.record("ListRescueImages", args)
+	if f.ListRescueImagesFunc == nil {
+		return nil, notImplemented("DedicatedServerService.ListRescueImages")
+	}
+	return f.ListRescueImagesFunc(args...)
+}
+
+var _ leaseweb.DownloadService = &FakeDownloadService{}
+
+type FakeDownloadService struct {
+	CallRecorder
+
+	DownloadFunc     func(context.Context, string) (*leaseweb.Download, error)
+	DownloadFileFunc func(context.Context, string, string, leaseweb.DownloadFileOptions) (*leaseweb.DownloadedFile, error)
+}
+
+func (f *FakeDownloadService) Download(ctx context.Context, path string) (*leaseweb.Download, error) {
+	f.record("Download", ctx, path)
+	if f.DownloadFunc == nil {
+		return nil, notImplemented("DownloadService.Download")
+	}
+	return f.DownloadFunc(ctx, path)
+}
+
+func (f *FakeDownloadService) DownloadFile(ctx context.Context, path string, dst string, options leaseweb.DownloadFileOptions) (*leaseweb.DownloadedFile, error) {
+	f.record("DownloadFile", ctx, path, dst, options)
+	if f.DownloadFileFunc == nil {
+		return nil, notImplemented("DownloadService.DownloadFile")
+	}
+	return f.DownloadFileFunc(ctx, path, dst, options)
+}
+
+var _ leaseweb.FloatingIpService = &FakeFloatingIpService{}
+
+type FakeFloatingIpService struct {
+	CallRecorder
+
+	ListRangesFunc            func(...interface{}) (*leaseweb.FloatingIpRanges, error)
+	GetRangeFunc              func(string) (*leaseweb.FloatingIpRange, error)
+	ListRangeDefinitionsFunc  func(string, ...interface{}) (*leaseweb.FloatingIpDefinitions, error)
+	CreateRangeDefinitionFunc func(string, string, string) (*leaseweb.FloatingIpDefinition, error)
+	GetRangeDefinitionFunc    func(string, string) (*leaseweb.FloatingIpDefinition, error)
+	UpdateRangeDefinitionFunc func(string, string, string) (*leaseweb.FloatingIpDefinition, error)
+	RemoveRangeDefinitionFunc func(string, string) (*leaseweb.FloatingIpDefinition, error)
+}
+
+func (f *FakeFloatingIpService) ListRanges(args ...interface{}) (*leaseweb.FloatingIpRanges, error) {
+	f.record("ListRanges", args)
+	if f.ListRangesFunc == nil {
+		return nil, notImplemented("FloatingIpService.ListRanges")
+	}
+	return f.ListRangesFunc(args...)
+}
+
+func (f *FakeFloatingIpService) GetRange(rangeId string) (*leaseweb.FloatingIpRange, error) {
+	f.record("GetRange", rangeId)
+	if f.GetRangeFunc == nil {
+		return nil, notImplemented("FloatingIpService.GetRange")
+	}
+	return f.GetRangeFunc(rangeId)
+}
+
+func (f *FakeFloatingIpService) ListRangeDefinitions(rangeId string, args ...interface{}) (*leaseweb.FloatingIpDefinitions, error) {
+	f.record("ListRangeDefinitions", rangeId, args)
+	if f.ListRangeDefinitionsFunc == nil {
+		return nil, notImplemented("FloatingIpService.ListRangeDefinitions")
+	}
+	return f.ListRangeDefinitionsFunc(rangeId, args...)
+}
+
+func (f *FakeFloatingIpService) CreateRangeDefinition(rangeId string, floatingIp string, anchorIp string) (*leaseweb.FloatingIpDefinition, error) {
+	f.record("CreateRangeDefinition", rangeId, floatingIp, anchorIp)
+	if f.CreateRangeDefinitionFunc == nil {
+		return nil, notImplemented("FloatingIpService.CreateRangeDefinition")
+	}
+	return f.CreateRangeDefinitionFunc(rangeId, floatingIp, anchorIp)
+}
+
+func (f *FakeFloatingIpService) GetRangeDefinition(rangeId string, floatingIpDefinitionId string) (*leaseweb.FloatingIpDefinition, error) {
+	f.record("GetRangeDefinition", rangeId, floatingIpDefinitionId)
+	if f.GetRangeDefinitionFunc == nil {
+		return nil, notImplemented("FloatingIpService.GetRangeDefinition")
+	}
+	return f.GetRangeDefinitionFunc(rangeId, floatingIpDefinitionId)
+}
+
+func (f *FakeFloatingIpService) UpdateRangeDefinition(rangeId string, floatingIpDefinitionId string, anchorIp string) (*leaseweb.FloatingIpDefinition, error) {
+	f.record("UpdateRangeDefinition", rangeId, floatingIpDefinitionId, anchorIp)
+	if f.UpdateRangeDefinitionFunc == nil {
+		return nil, notImplemented("FloatingIpService.UpdateRangeDefinition")
+	}
+	return f.UpdateRangeDefinitionFunc(rangeId, floatingIpDefinitionId, anchorIp)
+}
+
+func (f *FakeFloatingIpService) RemoveRangeDefinition(rangeId string, floatingIpDefinitionId string) (*leaseweb.FloatingIpDefinition, error) {
+	f.record("RemoveRangeDefinition", rangeId, floatingIpDefinitionId)
+	if f.RemoveRangeDefinitionFunc == nil {
+		return nil, notImplemented("FloatingIpService.RemoveRangeDefinition")
+	}
+	return f.RemoveRangeDefinitionFunc(rangeId, floatingIpDefinitionId)
+}
+
+var _ leaseweb.HostingService = &FakeHostingService{}
+
+type FakeHostingService struct {
+	CallRecorder
+
+	ListDomainsFunc               func(...int) (*leaseweb.HostingDomains, error)
+	GetDomainFunc                 func(string) (*leaseweb.HostingDomain, error)
+	ListResourceRecordSetsFunc    func(string, ...interface{}) (*leaseweb.ResourceRecordSets, error)
+	CreateResourceRecordSetFunc   func(string, leaseweb.ResourceRecordSet) (*leaseweb.ResourceRecordSet, error)
+	GetResourceRecordSetFunc      func(string, string, leaseweb.ResourceRecordSetType) (*leaseweb.ResourceRecordSet, error)
+	UpdateResourceRecordSetFunc   func(string, leaseweb.ResourceRecordSet) (*leaseweb.ResourceRecordSet, error)
+	DeleteResourceRecordSetFunc   func(string, string, leaseweb.ResourceRecordSetType) error
+	ValidateResourceRecordSetFunc func(string, leaseweb.ResourceRecordSet) error
+	ValidateZoneFunc              func(string, string) error
+	ImportZoneFunc                func(string, string, bool) error
+	GetDnssecFunc                 func(string) (*leaseweb.HostingDnssec, error)
+	EnableDnssecFunc              func(string) error
+	DisableDnssecFunc             func(string) error
+}
+
+func (f *FakeHostingService) ListDomains(args ...int) (*leaseweb.HostingDomains, error) {
+	f.record("ListDomains", args)
+	if f.ListDomainsFunc == nil {
+		return nil, notImplemented("HostingService.ListDomains")
+	}
+	return f.ListDomainsFunc(args...)
+}
+
+func (f *FakeHostingService) GetDomain(domainName string) (*leaseweb.HostingDomain, error) {
+	f.record("GetDomain", domainName)
+	if f.GetDomainFunc == nil {
+		return nil, notImplemented("HostingService.GetDomain")
+	}
+	return f.GetDomainFunc(domainName)
+}
+
+func (f *FakeHostingService) ListResourceRecordSets(domainName string, args ...interface{}) (*leaseweb.ResourceRecordSets, error) {
+	f.record("ListResourceRecordSets", domainName, args)
+	if f.ListResourceRecordSetsFunc == nil {
+		return nil, notImplemented("HostingService.ListResourceRecordSets")
+	}
+	return f.ListResourceRecordSetsFunc(domainName, args...)
+}
+
+func (f *FakeHostingService) CreateResourceRecordSet(domainName string, resourceRecordSet leaseweb.ResourceRecordSet) (*leaseweb.ResourceRecordSet, error) {
+	f.record("CreateResourceRecordSet", domainName, resourceRecordSet)
+	if f.CreateResourceRecordSetFunc == nil {
+		return nil, notImplemented("HostingService.CreateResourceRecordSet")
+	}
+	return f.CreateResourceRecordSetFunc(domainName, resourceRecordSet)
+}
+
+func (f *FakeHostingService) GetResourceRecordSet(domainName string, name string, recordType leaseweb.ResourceRecordSetType) (*leaseweb.ResourceRecordSet, error) {
+	f.record("GetResourceRecordSet", domainName, name, recordType)
+	if f.GetResourceRecordSetFunc == nil {
+		return nil, notImplemented("HostingService.GetResourceRecordSet")
+	}
+	return f.GetResourceRecordSetFunc(domainName, name, recordType)
+}
+
+func (f *FakeHostingService) UpdateResourceRecordSet(domainName string, resourceRecordSet leaseweb.ResourceRecordSet) (*leaseweb.ResourceRecordSet, error) {
+	f.record("UpdateResourceRecordSet", domainName, resourceRecordSet)
+	if f.UpdateResourceRecordSetFunc == nil {
+		return nil, notImplemented("HostingService.UpdateResourceRecordSet")
+	}
+	return f.UpdateResourceRecordSetFunc(domainName, resourceRecordSet)
+}
+
+func (f *FakeHostingService) DeleteResourceRecordSet(domainName string, name string, recordType leaseweb.ResourceRecordSetType) error {
+	f.record("DeleteResourceRecordSet", domainName, name, recordType)
+	if f.DeleteResourceRecordSetFunc == nil {
+		return notImplemented("HostingService.DeleteResourceRecordSet")
+	}
+	return f.DeleteResourceRecordSetFunc(domainName, name, recordType)
+}
+
+func (f *FakeHostingService) ValidateResourceRecordSet(domainName string, resourceRecordSet leaseweb.ResourceRecordSet) error {
+	f.record("ValidateResourceRecordSet", domainName, resourceRecordSet)
+	if f.ValidateResourceRecordSetFunc == nil {
+		return notImplemented("HostingService.ValidateResourceRecordSet")
+	}
+	return f.ValidateResourceRecordSetFunc(domainName, resourceRecordSet)
+}
+
+func (f *FakeHostingService) ValidateZone(domainName string, bindZone string) error {
+	f.record("ValidateZone", domainName, bindZone)
+	if f.ValidateZoneFunc == nil {
+		return notImplemented("HostingService.ValidateZone")
+	}
+	return f.ValidateZoneFunc(domainName, bindZone)
+}
+
+func (f *FakeHostingService) ImportZone(domainName string, bindZone string, overwrite bool) error {
+	f.record("ImportZone", domainName, bindZone, overwrite)
+	if f.ImportZoneFunc == nil {
+		return notImplemented("HostingService.ImportZone")
+	}
+	return f.ImportZoneFunc(domainName, bindZone, overwrite)
+}
+
+func (f *FakeHostingService) GetDnssec(domainName string) (*leaseweb.HostingDnssec, error) {
+	f.record("GetDnssec", domainName)
+	if f.GetDnssecFunc == nil {
+		return nil, notImplemented("HostingService.GetDnssec")
+	}
+	return f.GetDnssecFunc(domainName)
+}
+
+func (f *FakeHostingService) EnableDnssec(domainName string) error {
+	f.record("EnableDnssec", domainName)
+	if f.EnableDnssecFunc == nil {
+		return notImplemented("HostingService.EnableDnssec")
+	}
+	return f.EnableDnssecFunc(domainName)
+}
+
+func (f *FakeHostingService) DisableDnssec(domainName string) error {
+	f.record("DisableDnssec", domainName)
+	if f.DisableDnssecFunc == nil {
+		return notImplemented("HostingService.DisableDnssec")
+	}
+	return f.DisableDnssecFunc(domainName)
+}
+
+var _ leaseweb.InvoiceService = &FakeInvoiceService{}
+
+type FakeInvoiceService struct {
+	CallRecorder
+
+	ListInvoicesFunc       func(...int) (*leaseweb.Invoices, error)
+	GetProFormaFunc        func(...int) (*leaseweb.ProForma, error)
+	GetInvoiceFunc         func(string) (*leaseweb.Invoice, error)
+	DownloadInvoicePdfFunc func(context.Context, string) (*leaseweb.Download, error)
+	SyncInvoiceArchiveFunc func(context.Context, string) (*leaseweb.InvoiceArchiveSync, error)
+}
+
+func (f *FakeInvoiceService) ListInvoices(args ...int) (*leaseweb.Invoices, error) {
+	f.record("ListInvoices", args)
+	if f.ListInvoicesFunc == nil {
+		return nil, notImplemented("InvoiceService.ListInvoices")
+	}
+	return f.ListInvoicesFunc(args...)
+}
+
+func (f *FakeInvoiceService) GetProForma(args ...int) (*leaseweb.ProForma, error) {
+	f.record("GetProForma", args)
+	if f.GetProFormaFunc == nil {
+		return nil, notImplemented("InvoiceService.GetProForma")
+	}
+	return f.GetProFormaFunc(args...)
+}
+
+func (f *FakeInvoiceService) GetInvoice(invoiceId string) (*leaseweb.Invoice, error) {
+	f.record("GetInvoice", invoiceId)
+	if f.GetInvoiceFunc == nil {
+		return nil, notImplemented("InvoiceService.GetInvoice")
+	}
+	return f.GetInvoiceFunc(invoiceId)
+}
+
+func (f *FakeInvoiceService) DownloadInvoicePdf(ctx context.Context, invoiceId string) (*leaseweb.Download, error) {
+	f.record("DownloadInvoicePdf", ctx, invoiceId)
+	if f.DownloadInvoicePdfFunc == nil {
+		return nil, notImplemented("InvoiceService.DownloadInvoicePdf")
+	}
+	return f.DownloadInvoicePdfFunc(ctx, invoiceId)
+}
+
+func (f *FakeInvoiceService) SyncInvoiceArchive(ctx context.Context, dir string) (*leaseweb.InvoiceArchiveSync, error) {
+	f.record("SyncInvoiceArchive", ctx, dir)
+	if f.SyncInvoiceArchiveFunc == nil {
+		return nil, notImplemented("InvoiceService.SyncInvoiceArchive")
+	}
+	return f.SyncInvoiceArchiveFunc(ctx, dir)
+}
+
+var _ leaseweb.IpManagementService = &FakeIpManagementService{}
+
+type FakeIpManagementService struct {
+	CallRecorder
+
+	ListIpsFunc              func(...map[string]interface{}) (*leaseweb.Ips, error)
+	GetIpFunc                func(string) (*leaseweb.Ip, error)
+	UpdateIpFunc             func(string, string) (*leaseweb.Ip, error)
+	NullRouteIpFunc          func(string, ...map[string]string) (*leaseweb.NullRoute, error)
+	RemoveNullRouteIpFunc    func(string) error
+	ListNullRouteHistoryFunc func(...map[string]interface{}) (*leaseweb.NullRoutes, error)
+	GetNullRouteHistoryFunc  func(string) (*leaseweb.NullRoute, error)
+	UpdateNullRouteIpFunc    func(string, ...map[string]string) (*leaseweb.NullRoute, error)
+}
+
+func (f *FakeIpManagementService) ListIps(params ...map[string]interface{}) (*leaseweb.Ips, error) {
+	f.record("ListIps", params)
+	if f.ListIpsFunc == nil {
+		return nil, notImplemented("IpManagementService.ListIps")
+	}
+	return f.ListIpsFunc(params...)
+}
+
+func (f *FakeIpManagementService) GetIp(ip string) (*leaseweb.Ip, error) {
+	f.record("GetIp", ip)
+	if f.GetIpFunc == nil {
+		return nil, notImplemented("IpManagementService.GetIp")
+	}
+	return f.GetIpFunc(ip)
+}
+
+func (f *FakeIpManagementService) UpdateIp(ip string, reverseLookup string) (*leaseweb.Ip, error) {
+	f.record("UpdateIp", ip, reverseLookup)
+	if f.UpdateIpFunc == nil {
+		return nil, notImplemented("IpManagementService.UpdateIp")
+	}
+	return f.UpdateIpFunc(ip, reverseLookup)
+}
+
+func (f *FakeIpManagementService) NullRouteIp(ip string, params ...map[string]string) (*leaseweb.NullRoute, error) {
+	f.record("NullRouteIp", ip, params)
+	if f.NullRouteIpFunc == nil {
+		return nil, notImplemented("IpManagementService.NullRouteIp")
+	}
+	return f.NullRouteIpFunc(ip, params...)
+}
+
+func (f *FakeIpManagementService) RemoveNullRouteIp(ip string) error {
+	f.record("RemoveNullRouteIp", ip)
+	if f.RemoveNullRouteIpFunc == nil {
+		return notImplemented("IpManagementService.RemoveNullRouteIp")
+	}
+	return f.RemoveNullRouteIpFunc(ip)
+}
+
+func (f *FakeIpManagementService) ListNullRouteHistory(params ...map[string]interface{}) (*leaseweb.NullRoutes, error) {
+	f.record("ListNullRouteHistory", params)
+	if f.ListNullRouteHistoryFunc == nil {
+		return nil, notImplemented("IpManagementService.ListNullRouteHistory")
+	}
+	return f.ListNullRouteHistoryFunc(params...)
+}
+
+func (f *FakeIpManagementService) GetNullRouteHistory(id string) (*leaseweb.NullRoute, error) {
+	f.record("GetNullRouteHistory", id)
+	if f.GetNullRouteHistoryFunc == nil {
+		return nil, notImplemented("IpManagementService.GetNullRouteHistory")
+	}
+	return f.GetNullRouteHistoryFunc(id)
+}
+
+func (f *FakeIpManagementService) UpdateNullRouteIp(id string, params ...map[string]string) (*leaseweb.NullRoute, error) {
+	f.record("UpdateNullRouteIp", id, params)
+	if f.UpdateNullRouteIpFunc == nil {
+		return nil, notImplemented("IpManagementService.UpdateNullRouteIp")
+	}
+	return f.UpdateNullRouteIpFunc(id, params...)
+}
+
+var _ leaseweb.NetworkEquipmentService = &FakeNetworkEquipmentService{}
+
+type FakeNetworkEquipmentService struct {
+	CallRecorder
+
+	ListFunc                       func(...interface{}) (*leaseweb.NetworkEquipments, error)
+	GetFunc                        func(string) (*leaseweb.NetworkEquipment, error)
+	UpdateFunc                     func(string, map[string]interface{}) error
+	ListIpsFunc                    func(string, ...interface{}) (*leaseweb.DedicatedServerIps, error)
+	GetIpFunc                      func(string, string) (*leaseweb.DedicatedServerIp, error)
+	UpdateIpFunc                   func(string, string, map[string]string) (*leaseweb.DedicatedServerIp, error)
+	NullRouteAnIpFunc              func(string, string) (*leaseweb.DedicatedServerIp, error)
+	RemoveNullRouteAnIpFunc        func(string, string) (*leaseweb.DedicatedServerIp, error)
+	ListNullRouteHistoryFunc       func(string, ...int) (*leaseweb.DedicatedServerNullRoutes, error)
+	ListCredentialsFunc            func(string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	CreateCredentialFunc           func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	ListCredentialsByTypeFunc      func(string, string, ...int) (*leaseweb.DedicatedServerCredentials, error)
+	GetCredentialFunc              func(string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	DeleteCredentialFunc           func(string, string, string) error
+	UpdateCredentialFunc           func(string, string, string, string) (*leaseweb.DedicatedServerCredential, error)
+	PowerCycleNetworkEquipmentFunc func(string) error
+	GetPowerStatusFunc             func(string) (*leaseweb.DedicatedServerPowerStatus, error)
+	PowerOffNetworkEquipmentFunc   func(string) error
+	PowerOnNetworkEquipmentFunc    func(string) error
+}
+
+func (f *FakeNetworkEquipmentService) List(args ...interface{}) (*leaseweb.NetworkEquipments, error) {
+	f.record("List", args)
+	if f.ListFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.List")
+	}
+	return f.ListFunc(args...)
+}
+
+func (f *FakeNetworkEquipmentService) Get(networkEquipmentId string) (*leaseweb.NetworkEquipment, error) {
+	f.record("Get", networkEquipmentId)
+	if f.GetFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.Get")
+	}
+	return f.GetFunc(networkEquipmentId)
+}
+
+func (f *FakeNetworkEquipmentService) Update(networkEquipmentId string, payload map[string]interface{}) error {
+	f.record("Update", networkEquipmentId, payload)
+	if f.UpdateFunc == nil {
+		return notImplemented("NetworkEquipmentService.Update")
+	}
+	return f.UpdateFunc(networkEquipmentId, payload)
+}
+
+func (f *FakeNetworkEquipmentService) ListIps(networkEquipmentId string, args ...interface{}) (*leaseweb.DedicatedServerIps, error) {
+	f.record("ListIps", networkEquipmentId, args)
+	if f.ListIpsFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.ListIps")
+	}
+	return f.ListIpsFunc(networkEquipmentId, args...)
+}
+
+func (f *FakeNetworkEquipmentService) GetIp(networkEquipmentId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("GetIp", networkEquipmentId, ip)
+	if f.GetIpFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.GetIp")
+	}
+	return f.GetIpFunc(networkEquipmentId, ip)
+}
+
+func (f *FakeNetworkEquipmentService) UpdateIp(networkEquipmentId string, ip string, payload map[string]string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("UpdateIp", networkEquipmentId, ip, payload)
+	if f.UpdateIpFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.UpdateIp")
+	}
+	return f.UpdateIpFunc(networkEquipmentId, ip, payload)
+}
+
+func (f *FakeNetworkEquipmentService) NullRouteAnIp(networkEquipmentId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("NullRouteAnIp", networkEquipmentId, ip)
+	if f.NullRouteAnIpFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.NullRouteAnIp")
+	}
+	return f.NullRouteAnIpFunc(networkEquipmentId, ip)
+}
+
+func (f *FakeNetworkEquipmentService) RemoveNullRouteAnIp(networkEquipmentId string, ip string) (*leaseweb.DedicatedServerIp, error) {
+	f.record("RemoveNullRouteAnIp", networkEquipmentId, ip)
+	if f.RemoveNullRouteAnIpFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.RemoveNullRouteAnIp")
+	}
+	return f.RemoveNullRouteAnIpFunc(networkEquipmentId, ip)
+}
+
+func (f *FakeNetworkEquipmentService) ListNullRouteHistory(networkEquipmentId string, args ...int) (*leaseweb.DedicatedServerNullRoutes, error) {
+	f.record("ListNullRouteHistory", networkEquipmentId, args)
+	if f.ListNullRouteHistoryFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.ListNullRouteHistory")
+	}
+	return f.ListNullRouteHistoryFunc(networkEquipmentId, args...)
+}
+
+func (f *FakeNetworkEquipmentService) ListCredentials(networkEquipmentId string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentials", networkEquipmentId, args)
+	if f.ListCredentialsFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.ListCredentials")
+	}
+	return f.ListCredentialsFunc(networkEquipmentId, args...)
+}
+
+func (f *FakeNetworkEquipmentService) CreateCredential(networkEquipmentId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("CreateCredential", networkEquipmentId, credentialType, username, password)
+	if f.CreateCredentialFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.CreateCredential")
+	}
+	return f.CreateCredentialFunc(networkEquipmentId, credentialType, username, password)
+}
+
+func (f *FakeNetworkEquipmentService) ListCredentialsByType(networkEquipmentId string, credentialType string, args ...int) (*leaseweb.DedicatedServerCredentials, error) {
+	f.record("ListCredentialsByType", networkEquipmentId, credentialType, args)
+	if f.ListCredentialsByTypeFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.ListCredentialsByType")
+	}
+	return f.ListCredentialsByTypeFunc(networkEquipmentId, credentialType, args...)
+}
+
+func (f *FakeNetworkEquipmentService) GetCredential(networkEquipmentId string, credentialType string, username string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("GetCredential", networkEquipmentId, credentialType, username)
+	if f.GetCredentialFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.GetCredential")
+	}
+	return f.GetCredentialFunc(networkEquipmentId, credentialType, username)
+}
+
+func (f *FakeNetworkEquipmentService) DeleteCredential(networkEquipmentId string, credentialType string, username string) error {
+	f.record("DeleteCredential", networkEquipmentId, credentialType, username)
+	if f.DeleteCredentialFunc == nil {
+		return notImplemented("NetworkEquipmentService.DeleteCredential")
+	}
+	return f.DeleteCredentialFunc(networkEquipmentId, credentialType, username)
+}
+
+func (f *FakeNetworkEquipmentService) UpdateCredential(networkEquipmentId string, credentialType string, username string, password string) (*leaseweb.DedicatedServerCredential, error) {
+	f.record("UpdateCredential", networkEquipmentId, credentialType, username, password)
+	if f.UpdateCredentialFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.UpdateCredential")
+	}
+	return f.UpdateCredentialFunc(networkEquipmentId, credentialType, username, password)
+}
+
+func (f *FakeNetworkEquipmentService) PowerCycleNetworkEquipment(networkEquipmentId string) error {
+	f.record("PowerCycleNetworkEquipment", networkEquipmentId)
+	if f.PowerCycleNetworkEquipmentFunc == nil {
+		return notImplemented("NetworkEquipmentService.PowerCycleNetworkEquipment")
+	}
+	return f.PowerCycleNetworkEquipmentFunc(networkEquipmentId)
+}
+
+func (f *FakeNetworkEquipmentService) GetPowerStatus(networkEquipmentId string) (*leaseweb.DedicatedServerPowerStatus, error) {
+	f.record("GetPowerStatus", networkEquipmentId)
+	if f.GetPowerStatusFunc == nil {
+		return nil, notImplemented("NetworkEquipmentService.GetPowerStatus")
+	}
+	return f.GetPowerStatusFunc(networkEquipmentId)
+}
+
+func (f *FakeNetworkEquipmentService) PowerOffNetworkEquipment(networkEquipmentId string) error {
+	f.record("PowerOffNetworkEquipment", networkEquipmentId)
+	if f.PowerOffNetworkEquipmentFunc == nil {
+		return notImplemented("NetworkEquipmentService.PowerOffNetworkEquipment")
+	}
+	return f.PowerOffNetworkEquipmentFunc(networkEquipmentId)
+}
+
+func (f *FakeNetworkEquipmentService) PowerOnNetworkEquipment(networkEquipmentId string) error {
+	f.record("PowerOnNetworkEquipment", networkEquipmentId)
+	if f.PowerOnNetworkEquipmentFunc == nil {
+		return notImplemented("NetworkEquipmentService.PowerOnNetworkEquipment")
+	}
+	return f.PowerOnNetworkEquipmentFunc(networkEquipmentId)
+}
+
+var _ leaseweb.PrivateCloudService = &FakePrivateCloudService{}
+
+type FakePrivateCloudService struct {
+	CallRecorder
+
+	ListPrivateCloudsFunc     func(...interface{}) (*leaseweb.PrivateClouds, error)
+	GetPrivateCloudFunc       func(string) (*leaseweb.PrivateCloud, error)
+	ListCredentialsFunc       func(string, string, ...int) (*leaseweb.Credentials, error)
+	GetCredentialsFunc        func(string, string, string) (*leaseweb.Credential, error)
+	GetDataTrafficMetricsFunc func(string, ...interface{}) (*leaseweb.DataTrafficMetrics, error)
+	GetBandWidthMetricsFunc   func(string, ...interface{}) (*leaseweb.BandWidthMetrics, error)
+	GetCpuMetricsFunc         func(string, ...interface{}) (*leaseweb.CpuMetrics, error)
+	GetMemoryMetricsFunc      func(string, ...interface{}) (*leaseweb.MemoryMetrics, error)
+	GetStorageMetricsFunc     func(string, ...interface{}) (*leaseweb.StorageMetrics, error)
+}
+
+func (f *FakePrivateCloudService) ListPrivateClouds(args ...interface{}) (*leaseweb.PrivateClouds, error) {
+	f.record("ListPrivateClouds", args)
+	if f.ListPrivateCloudsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.ListPrivateClouds")
+	}
+	return f.ListPrivateCloudsFunc(args...)
+}
+
+func (f *FakePrivateCloudService) GetPrivateCloud(privateCloudId string) (*leaseweb.PrivateCloud, error) {
+	f.record("GetPrivateCloud", privateCloudId)
+	if f.GetPrivateCloudFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetPrivateCloud")
+	}
+	return f.GetPrivateCloudFunc(privateCloudId)
+}
+
+func (f *FakePrivateCloudService) ListCredentials(privateCloudId string, credentialType string, args ...int) (*leaseweb.Credentials, error) {
+	f.record("ListCredentials", privateCloudId, credentialType, args)
+	if f.ListCredentialsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.ListCredentials")
+	}
+	return f.ListCredentialsFunc(privateCloudId, credentialType, args...)
+}
+
+func (f *FakePrivateCloudService) GetCredentials(privateCloudId string, credentialType string, username string) (*leaseweb.Credential, error) {
+	f.record("GetCredentials", privateCloudId, credentialType, username)
+	if f.GetCredentialsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetCredentials")
+	}
+	return f.GetCredentialsFunc(privateCloudId, credentialType, username)
+}
+
+func (f *FakePrivateCloudService) GetDataTrafficMetrics(privateCloudId string, args ...interface{}) (*leaseweb.DataTrafficMetrics, error) {
+	f.record("GetDataTrafficMetrics", privateCloudId, args)
+	if f.GetDataTrafficMetricsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetDataTrafficMetrics")
+	}
+	return f.GetDataTrafficMetricsFunc(privateCloudId, args...)
+}
+
+func (f *FakePrivateCloudService) GetBandWidthMetrics(privateCloudId string, args ...interface{}) (*leaseweb.BandWidthMetrics, error) {
+	f.record("GetBandWidthMetrics", privateCloudId, args)
+	if f.GetBandWidthMetricsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetBandWidthMetrics")
+	}
+	return f.GetBandWidthMetricsFunc(privateCloudId, args...)
+}
+
+func (f *FakePrivateCloudService) GetCpuMetrics(privateCloudId string, args ...interface{}) (*leaseweb.CpuMetrics, error) {
+	f.record("GetCpuMetrics", privateCloudId, args)
+	if f.GetCpuMetricsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetCpuMetrics")
+	}
+	return f.GetCpuMetricsFunc(privateCloudId, args...)
+}
+
+func (f *FakePrivateCloudService) GetMemoryMetrics(privateCloudId string, args ...interface{}) (*leaseweb.MemoryMetrics, error) {
+	f.record("GetMemoryMetrics", privateCloudId, args)
+	if f.GetMemoryMetricsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetMemoryMetrics")
+	}
+	return f.GetMemoryMetricsFunc(privateCloudId, args...)
+}
+
+func (f *FakePrivateCloudService) GetStorageMetrics(privateCloudId string, args ...interface{}) (*leaseweb.StorageMetrics, error) {
+	f.record("GetStorageMetrics", privateCloudId, args)
+	if f.GetStorageMetricsFunc == nil {
+		return nil, notImplemented("PrivateCloudService.GetStorageMetrics")
+	}
+	return f.GetStorageMetricsFunc(privateCloudId, args...)
+}
+
+var _ leaseweb.PrivateNetworkingService = &FakePrivateNetworkingService{}
+
+type FakePrivateNetworkingService struct {
+	CallRecorder
+
+	ListPrivateNetworksFunc   func(...int) (*leaseweb.PrivateNetworks, error)
+	CreatePrivateNetworkFunc  func(string) (*leaseweb.PrivateNetwork, error)
+	GetPrivateNetworkFunc     func(string) (*leaseweb.PrivateNetwork, error)
+	UpdatePrivateNetworkFunc  func(string, string) (*leaseweb.PrivateNetwork, error)
+	DeletePrivateNetworkFunc  func(string) error
+	ListDhcpReservationsFunc  func(string, ...int) (*leaseweb.DhcpReservations, error)
+	CreateDhcpReservationFunc func(string, string, string, bool) (*leaseweb.DhcpReservation, error)
+	DeleteDhcpReservationFunc func(string, string) error
+}
+
+func (f *FakePrivateNetworkingService) ListPrivateNetworks(args ...int) (*leaseweb.PrivateNetworks, error) {
+	f.record("ListPrivateNetworks", args)
+	if f.ListPrivateNetworksFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.ListPrivateNetworks")
+	}
+	return f.ListPrivateNetworksFunc(args...)
+}
+
+func (f *FakePrivateNetworkingService) CreatePrivateNetwork(name string) (*leaseweb.PrivateNetwork, error) {
+	f.record("CreatePrivateNetwork", name)
+	if f.CreatePrivateNetworkFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.CreatePrivateNetwork")
+	}
+	return f.CreatePrivateNetworkFunc(name)
+}
+
+func (f *FakePrivateNetworkingService) GetPrivateNetwork(id string) (*leaseweb.PrivateNetwork, error) {
+	f.record("GetPrivateNetwork", id)
+	if f.GetPrivateNetworkFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.GetPrivateNetwork")
+	}
+	return f.GetPrivateNetworkFunc(id)
+}
+
+func (f *FakePrivateNetworkingService) UpdatePrivateNetwork(id string, name string) (*leaseweb.PrivateNetwork, error) {
+	f.record("UpdatePrivateNetwork", id, name)
+	if f.UpdatePrivateNetworkFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.UpdatePrivateNetwork")
+	}
+	return f.UpdatePrivateNetworkFunc(id, name)
+}
+
+func (f *FakePrivateNetworkingService) DeletePrivateNetwork(id string) error {
+	f.record("DeletePrivateNetwork", id)
+	if f.DeletePrivateNetworkFunc == nil {
+		return notImplemented("PrivateNetworkingService.DeletePrivateNetwork")
+	}
+	return f.DeletePrivateNetworkFunc(id)
+}
+
+func (f *FakePrivateNetworkingService) ListDhcpReservations(id string, args ...int) (*leaseweb.DhcpReservations, error) {
+	f.record("ListDhcpReservations", id, args)
+	if f.ListDhcpReservationsFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.ListDhcpReservations")
+	}
+	return f.ListDhcpReservationsFunc(id, args...)
+}
+
+func (f *FakePrivateNetworkingService) CreateDhcpReservation(id string, ip string, mac string, sticky bool) (*leaseweb.DhcpReservation, error) {
+	f.record("CreateDhcpReservation", id, ip, mac, sticky)
+	if f.CreateDhcpReservationFunc == nil {
+		return nil, notImplemented("PrivateNetworkingService.CreateDhcpReservation")
+	}
+	return f.CreateDhcpReservationFunc(id, ip, mac, sticky)
+}
+
+func (f *FakePrivateNetworkingService) DeleteDhcpReservation(id string, ip string) error {
+	f.record("DeleteDhcpReservation", id, ip)
+	if f.DeleteDhcpReservationFunc == nil {
+		return notImplemented("PrivateNetworkingService.DeleteDhcpReservation")
+	}
+	return f.DeleteDhcpReservationFunc(id, ip)
+}
+
+var _ leaseweb.RemoteManagementService = &FakeRemoteManagementService{}
+
+type FakeRemoteManagementService struct {
+	CallRecorder
+
+	ChangeCredentialsFunc  func(string) error
+	ListProfilesFunc       func(...int) (*leaseweb.Profiles, error)
+	DownloadProfileFunc    func(context.Context, leaseweb.Profile) (*leaseweb.Download, error)
+	GetProfileFunc         func(context.Context, string) ([]byte, error)
+	FindProfileFunc        func(string) (*leaseweb.Profile, error)
+	WriteOpenVpnConfigFunc func(context.Context, leaseweb.Location, string, string, string) (*leaseweb.OpenVpnConfig, error)
+}
+
+func (f *FakeRemoteManagementService) ChangeCredentials(password string) error {
+	f.record("ChangeCredentials", password)
+	if f.ChangeCredentialsFunc == nil {
+		return notImplemented("RemoteManagementService.ChangeCredentials")
+	}
+	return f.ChangeCredentialsFunc(password)
+}
+
+func (f *FakeRemoteManagementService) ListProfiles(args ...int) (*leaseweb.Profiles, error) {
+	f.record("ListProfiles", args)
+	if f.ListProfilesFunc == nil {
+		return nil, notImplemented("RemoteManagementService.ListProfiles")
+	}
+	return f.ListProfilesFunc(args...)
+}
+
+func (f *FakeRemoteManagementService) DownloadProfile(ctx context.Context, profile leaseweb.Profile) (*leaseweb.Download, error) {
+	f.record("DownloadProfile", ctx, profile)
+	if f.DownloadProfileFunc == nil {
+		return nil, notImplemented("RemoteManagementService.DownloadProfile")
+	}
+	return f.DownloadProfileFunc(ctx, profile)
+}
+
+func (f *FakeRemoteManagementService) GetProfile(ctx context.Context, datacenter string) ([]byte, error) {
+	f.record("GetProfile", ctx, datacenter)
+	if f.GetProfileFunc == nil {
+		return nil, notImplemented("RemoteManagementService.GetProfile")
+	}
+	return f.GetProfileFunc(ctx, datacenter)
+}
+
+func (f *FakeRemoteManagementService) FindProfile(site string) (*leaseweb.Profile, error) {
+	f.record("FindProfile", site)
+	if f.FindProfileFunc == nil {
+		return nil, notImplemented("RemoteManagementService.FindProfile")
+	}
+	return f.FindProfileFunc(site)
+}
+
+func (f *FakeRemoteManagementService) WriteOpenVpnConfig(ctx context.Context, location leaseweb.Location, username string, password string, dir string) (*leaseweb.OpenVpnConfig, error) {
+	f.record("WriteOpenVpnConfig", ctx, location, username, password, dir)
+	if f.WriteOpenVpnConfigFunc == nil {
+		return nil, notImplemented("RemoteManagementService.WriteOpenVpnConfig")
+	}
+	return f.WriteOpenVpnConfigFunc(ctx, location, username, password, dir)
+}
+
+var _ leaseweb.ServicesService = &FakeServicesService{}
+
+type FakeServicesService struct {
+	CallRecorder
+
+	ListServicesFunc            func(...int) (*leaseweb.Services, error)
+	ListServicesWithFilterFunc  func(leaseweb.ServiceFilter, ...int) (*leaseweb.Services, error)
+	ListAllServicesFunc         func(leaseweb.ServiceFilter) ([]leaseweb.Service, error)
+	ListCancellationReasonsFunc func() (*leaseweb.CancellationReasons, error)
+	GetServiceFunc              func(string) (*leaseweb.Service, error)
+	CancelServiceFunc           func(string, string, string) error
+	UncancelServiceFunc         func(string) error
+}
+
+func (f *FakeServicesService) ListServices(args ...int) (*leaseweb.Services, error) {
+	f.record("ListServices", args)
+	if f.ListServicesFunc == nil {
+		return nil, notImplemented("ServicesService.ListServices")
+	}
+	return f.ListServicesFunc(args...)
+}
+
+func (f *FakeServicesService) ListServicesWithFilter(filter leaseweb.ServiceFilter, args ...int) (*leaseweb.Services, error) {
+	f.record("ListServicesWithFilter", filter, args)
+	if f.ListServicesWithFilterFunc == nil {
+		return nil, notImplemented("ServicesService.ListServicesWithFilter")
+	}
+	return f.ListServicesWithFilterFunc(filter, args...)
+}
+
+func (f *FakeServicesService) ListAllServices(filter leaseweb.ServiceFilter) ([]leaseweb.Service, error) {
+	f.record("ListAllServices", filter)
+	if f.ListAllServicesFunc == nil {
+		return nil, notImplemented("ServicesService.ListAllServices")
+	}
+	return f.ListAllServicesFunc(filter)
+}
+
+func (f *FakeServicesService) ListCancellationReasons() (*leaseweb.CancellationReasons, error) {
+	f.record("ListCancellationReasons")
+	if f.ListCancellationReasonsFunc == nil {
+		return nil, notImplemented("ServicesService.ListCancellationReasons")
+	}
+	return f.ListCancellationReasonsFunc()
+}
+
+func (f *FakeServicesService) GetService(id string) (*leaseweb.Service, error) {
+	f.record("GetService", id)
+	if f.GetServiceFunc == nil {
+		return nil, notImplemented("ServicesService.GetService")
+	}
+	return f.GetServiceFunc(id)
+}
+
+func (f *FakeServicesService) CancelService(id string, reason string, reasonCode string) error {
+	f.record("CancelService", id, reason, reasonCode)
+	if f.CancelServiceFunc == nil {
+		return notImplemented("ServicesService.CancelService")
+	}
+	return f.CancelServiceFunc(id, reason, reasonCode)
+}
+
+func (f *FakeServicesService) UncancelService(id string) error {
+	f.record("UncancelService", id)
+	if f.UncancelServiceFunc == nil {
+		return notImplemented("ServicesService.UncancelService")
+	}
+	return f.UncancelServiceFunc(id)
+}
+
+var _ leaseweb.VirtualServerService = &FakeVirtualServerService{}
+
+type FakeVirtualServerService struct {
+	CallRecorder
+
+	ListVirtualServersFunc    func(...int) (*leaseweb.VirtualServers, error)
+	GetVirtualServerFunc      func(string) (*leaseweb.VirtualServer, error)
+	UpdateVirtualServerFunc   func(string, string) (*leaseweb.VirtualServer, error)
+	PowerOnFunc               func(string) (*leaseweb.VirtualServerResult, error)
+	PowerOffFunc              func(string) (*leaseweb.VirtualServerResult, error)
+	RebootFunc                func(string) (*leaseweb.VirtualServerResult, error)
+	ReinstallFunc             func(string, string) (*leaseweb.VirtualServerResult, error)
+	UpdateCredentialFunc      func(string, string, string, string) error
+	ListCredentialsFunc       func(string, string, ...int) (*leaseweb.Credentials, error)
+	GetCredentialFunc         func(string, string, string) (*leaseweb.Credential, error)
+	GetDataTrafficMetricsFunc func(string, ...interface{}) (*leaseweb.DataTrafficMetrics, error)
+	ListTemplatesFunc         func(string, ...int) (*leaseweb.Templates, error)
+}
+
+func (f *FakeVirtualServerService) ListVirtualServers(args ...int) (*leaseweb.VirtualServers, error) {
+	f.record("ListVirtualServers", args)
+	if f.ListVirtualServersFunc == nil {
+		return nil, notImplemented("VirtualServerService.ListVirtualServers")
+	}
+	return f.ListVirtualServersFunc(args...)
+}
+
+func (f *FakeVirtualServerService) GetVirtualServer(virtualServerId string) (*leaseweb.VirtualServer, error) {
+	f.record("GetVirtualServer", virtualServerId)
+	if f.GetVirtualServerFunc == nil {
+		return nil, notImplemented("VirtualServerService.GetVirtualServer")
+	}
+	return f.GetVirtualServerFunc(virtualServerId)
+}
+
+func (f *FakeVirtualServerService) UpdateVirtualServer(virtualServerId string, reference string) (*leaseweb.VirtualServer, error) {
+	f.record("UpdateVirtualServer", virtualServerId, reference)
+	if f.UpdateVirtualServerFunc == nil {
+		return nil, notImplemented("VirtualServerService.UpdateVirtualServer")
+	}
+	return f.UpdateVirtualServerFunc(virtualServerId, reference)
+}
+
+func (f *FakeVirtualServerService) PowerOn(virtualServerId string) (*leaseweb.VirtualServerResult, error) {
+	f.record("PowerOn", virtualServerId)
+	if f.PowerOnFunc == nil {
+		return nil, notImplemented("VirtualServerService.PowerOn")
+	}
+	return f.PowerOnFunc(virtualServerId)
+}
+
+func (f *FakeVirtualServerService) PowerOff(virtualServerId string) (*leaseweb.VirtualServerResult, error) {
+	f.record("PowerOff", virtualServerId)
+	if f.PowerOffFunc == nil {
+		return nil, notImplemented("VirtualServerService.PowerOff")
+	}
+	return f.PowerOffFunc(virtualServerId)
+}
+
+func (f *FakeVirtualServerService) Reboot(virtualServerId string) (*leaseweb.VirtualServerResult, error) {
+	f.record("Reboot", virtualServerId)
+	if f.RebootFunc == nil {
+		return nil, notImplemented("VirtualServerService.Reboot")
+	}
+	return f.RebootFunc(virtualServerId)
+}
+
+func (f *FakeVirtualServerService) Reinstall(virtualServerId string, operatingSystemId string) (*leaseweb.VirtualServerResult, error) {
+	f.record("Reinstall", virtualServerId, operatingSystemId)
+	if f.ReinstallFunc == nil {
+		return nil, notImplemented("VirtualServerService.Reinstall")
+	}
+	return f.ReinstallFunc(virtualServerId, operatingSystemId)
+}
+
+func (f *FakeVirtualServerService) UpdateCredential(virtualServerId string, username string, credentialType string, password string) error {
+	f.record("UpdateCredential", virtualServerId, username, credentialType, password)
+	if f.UpdateCredentialFunc == nil {
+		return notImplemented("VirtualServerService.UpdateCredential")
+	}
+	return f.UpdateCredentialFunc(virtualServerId, username, credentialType, password)
+}
+
+func (f *FakeVirtualServerService) ListCredentials(virtualServerId string, credentialType string, args ...int) (*leaseweb.Credentials, error) {
+	f.record("ListCredentials", virtualServerId, credentialType, args)
+	if f.ListCredentialsFunc == nil {
+		return nil, notImplemented("VirtualServerService.ListCredentials")
+	}
+	return f.ListCredentialsFunc(virtualServerId, credentialType, args...)
+}
+
+func (f *FakeVirtualServerService) GetCredential(virtualServerId string, username string, credentialType string) (*leaseweb.Credential, error) {
+	f.record("GetCredential", virtualServerId, username, credentialType)
+	if f.GetCredentialFunc == nil {
+		return nil, notImplemented("VirtualServerService.GetCredential")
+	}
+	return f.GetCredentialFunc(virtualServerId, username, credentialType)
+}
+
+func (f *FakeVirtualServerService) GetDataTrafficMetrics(virtualServerId string, args ...interface{}) (*leaseweb.DataTrafficMetrics, error) {
+	f.record("GetDataTrafficMetrics", virtualServerId, args)
+	if f.GetDataTrafficMetricsFunc == nil {
+		return nil, notImplemented("VirtualServerService.GetDataTrafficMetrics")
+	}
+	return f.GetDataTrafficMetricsFunc(virtualServerId, args...)
+}
+
+func (f *FakeVirtualServerService) ListTemplates(virtualServerId string, args ...int) (*leaseweb.Templates, error) {
+	f.record("ListTemplates", virtualServerId, args)
+	if f.ListTemplatesFunc == nil {
+		return nil, notImplemented("VirtualServerService.ListTemplates")
+	}
+	return f.ListTemplatesFunc(virtualServerId, args...)
+}
@@ -0,0 +1,130 @@
+package leasewebtest
+
+import (
+	"net/http"
+	"strings"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type floatingIpRangeState struct {
+	ipRange     leaseweb.FloatingIpRange
+	definitions []*leaseweb.FloatingIpDefinition
+}
+
+func (s *Server) AddFloatingIpRange(ipRange leaseweb.FloatingIpRange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ipRange.Id == "" {
+		ipRange.Id = s.nextId()
+	}
+	s.floatingRanges = append(s.floatingRanges, &floatingIpRangeState{ipRange: ipRange})
+}
+
+func (s *Server) handleFloatingIps(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		query := r.URL.Query()
+		ranges := []leaseweb.FloatingIpRange{}
+		for _, state := range s.floatingRanges {
+			if location := query.Get("location"); location != "" && state.ipRange.Location != location {
+				continue
+			}
+			ranges = append(ranges, state.ipRange)
+		}
+		page, metadata := paginate(r, ranges)
+		writeJson(w, http.StatusOK, leaseweb.FloatingIpRanges{Ranges: page, Metadata: metadata})
+		return
+	}
+
+	var state *floatingIpRangeState
+	for _, candidate := range s.floatingRanges {
+		if candidate.ipRange.Id == segments[0] {
+			state = candidate
+		}
+	}
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeJson(w, http.StatusOK, state.ipRange)
+	case len(segments) == 2 && segments[1] == "floatingIpDefinitions":
+		s.handleFloatingIpDefinitions(w, r, state)
+	case len(segments) == 3 && segments[1] == "floatingIpDefinitions":
+		s.handleFloatingIpDefinition(w, r, state, segments[2])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleFloatingIpDefinitions(w http.ResponseWriter, r *http.Request, state *floatingIpRangeState) {
+	switch r.Method {
+	case http.MethodGet:
+		definitions := []leaseweb.FloatingIpDefinition{}
+		for _, definition := range state.definitions {
+			definitions = append(definitions, *definition)
+		}
+		page, metadata := paginate(r, definitions)
+		writeJson(w, http.StatusOK, leaseweb.FloatingIpDefinitions{FloatingIpDefinitions: page, Metadata: metadata})
+	case http.MethodPost:
+		payload := map[string]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, definition := range state.definitions {
+			if definition.FloatingIp == payload["floatingIp"] {
+				writeError(w, http.StatusConflict, "The floating IP is already defined.")
+				return
+			}
+		}
+		definition := &leaseweb.FloatingIpDefinition{
+			Id:         strings.ReplaceAll(payload["floatingIp"], "/", "_"),
+			RangeId:    state.ipRange.Id,
+			Location:   state.ipRange.Location,
+			Type:       state.ipRange.Type,
+			CustomerId: state.ipRange.CustomerId,
+			SalesOrgId: state.ipRange.SalesOrgId,
+			FloatingIp: payload["floatingIp"],
+			AnchorIp:   payload["anchorIp"],
+			Status:     "ACTIVE",
+			CreatedAt:  now(),
+			UpdatedAt:  now(),
+		}
+		state.definitions = append(state.definitions, definition)
+		writeJson(w, http.StatusCreated, definition)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleFloatingIpDefinition(w http.ResponseWriter, r *http.Request, state *floatingIpRangeState, definitionId string) {
+	for i, definition := range state.definitions {
+		if definition.Id != definitionId {
+			continue
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, definition)
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			definition.AnchorIp = payload["anchorIp"]
+			definition.UpdatedAt = now()
+			writeJson(w, http.StatusOK, definition)
+		case http.MethodDelete:
+			state.definitions = append(state.definitions[:i], state.definitions[i+1:]...)
+			definition.Status = "REMOVING"
+			writeJson(w, http.StatusAccepted, definition)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
@@ -0,0 +1,40 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestFloatingIpDefinitions(t *testing.T) {
+	s := newTestServer(t)
+	s.AddFloatingIpRange(leaseweb.FloatingIpRange{Id: "88.17.0.0_17", Range: "88.17.0.0/17", Location: "AMS-01", Type: "SITE"})
+
+	api := leaseweb.FloatingIpApi{}
+	assert := assert.New(t)
+	definition, err := api.CreateRangeDefinition("88.17.0.0_17", "88.17.34.108/32", "95.10.126.1")
+	assert.Nil(err)
+	assert.Equal(definition.Id, "88.17.34.108_32")
+	assert.Equal(definition.Location, "AMS-01")
+
+	_, err = api.CreateRangeDefinition("88.17.0.0_17", "88.17.34.108/32", "95.10.126.1")
+	assert.Equal(err.Error(), "The floating IP is already defined.")
+
+	definition, err = api.UpdateRangeDefinition("88.17.0.0_17", definition.Id, "95.10.126.2")
+	assert.Nil(err)
+	assert.Equal(definition.AnchorIp, "95.10.126.2")
+
+	definitions, err := api.ListRangeDefinitions("88.17.0.0_17")
+	assert.Nil(err)
+	assert.Equal(definitions.Metadata.TotalCount, 1)
+	assert.Equal(definitions.FloatingIpDefinitions[0].AnchorIp, "95.10.126.2")
+
+	removed, err := api.RemoveRangeDefinition("88.17.0.0_17", definition.Id)
+	assert.Nil(err)
+	assert.Equal(removed.Status, "REMOVING")
+
+	_, err = api.GetRangeDefinition("88.17.0.0_17", definition.Id)
+	assert.Equal(err.Error(), "Resource not found")
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const SDK_IMPORT_PATH = "leaseweb-go-sdk"
+
+type generator struct {
+	imports map[string]string
+	used    map[string]bool
+	buf     bytes.Buffer
+}
+
+func main() {
+	source := flag.String("source", "../interfaces.go", "file declaring the service interfaces")
+	output := flag.String("output", "fakes.go", "file to write the fakes to")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{imports: map[string]string{}, used: map[string]bool{}}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		g.imports[name] = path
+	}
+
+	var services []*ast.TypeSpec
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if _, ok := spec.Type.(*ast.InterfaceType); ok && strings.HasSuffix(spec.Name.Name, "Service") {
+			services = append(services, spec)
+		}
+		return false
+	})
+
+	for _, service := range services {
+		g.writeFake(service)
+	}
+
+	src, err := format.Source(g.header())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (g *generator) header() []byte {
+	var header bytes.Buffer
+	fmt.Fprintln(&header, "// Code generated by fakegen. DO NOT EDIT.")
+	fmt.Fprintln(&header)
+	fmt.Fprintln(&header, "package leasewebtest")
+	fmt.Fprintln(&header)
+	fmt.Fprintln(&header, "import (")
+	var names []string
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&header, "\t%q\n", g.imports[name])
+	}
+	fmt.Fprintln(&header)
+	fmt.Fprintf(&header, "\tleaseweb %q\n", SDK_IMPORT_PATH)
+	fmt.Fprintln(&header, ")")
+	header.Write(g.buf.Bytes())
+	return header.Bytes()
+}
+
+func (g *generator) writeFake(service *ast.TypeSpec) {
+	fakeName := "Fake" + service.Name.Name
+	methods := service.Type.(*ast.InterfaceType).Methods.List
+
+	fmt.Fprintf(&g.buf, "\nvar _ leaseweb.%s = &%s{}\n\n", service.Name.Name, fakeName)
+	fmt.Fprintf(&g.buf, "type %s struct {\n\tCallRecorder\n\n", fakeName)
+	for _, method := range methods {
+		fn := method.Type.(*ast.FuncType)
+		fmt.Fprintf(&g.buf, "\t%sFunc func%s\n", method.Names[0].Name, g.signature(fn, false))
+	}
+	fmt.Fprintf(&g.buf, "}\n")
+
+	for _, method := range methods {
+		name := method.Names[0].Name
+		fn := method.Type.(*ast.FuncType)
+		params := g.paramNames(fn)
+
+		fmt.Fprintf(&g.buf, "\nfunc (f *%s) %s%s {\n", fakeName, name, g.signature(fn, true))
+		fmt.Fprintf(&g.buf, "\tf.record(%s)\n", strings.Join(append([]string{strconv.Quote(name)}, params...), ", "))
+		fmt.Fprintf(&g.buf, "\tif f.%sFunc == nil {\n", name)
+		fmt.Fprintf(&g.buf, "\t\t%s\n", g.notImplemented(fn, service.Name.Name+"."+name))
+		fmt.Fprintf(&g.buf, "\t}\n")
+
+		call := fmt.Sprintf("f.%sFunc(%s)", name, g.callArgs(fn, params))
+		if fn.Results == nil {
+			fmt.Fprintf(&g.buf, "\t%s\n", call)
+		} else {
+			fmt.Fprintf(&g.buf, "\treturn %s\n", call)
+		}
+		fmt.Fprintf(&g.buf, "}\n")
+	}
+}
+
+func (g *generator) paramNames(fn *ast.FuncType) []string {
+	var names []string
+	for i, field := range fn.Params.List {
+		if len(field.Names) == 0 {
+			names = append(names, fmt.Sprintf("arg%d", i))
+			continue
+		}
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
+func (g *generator) signature(fn *ast.FuncType, named bool) string {
+	var params []string
+	names := g.paramNames(fn)
+	i := 0
+	for _, field := range fn.Params.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for j := 0; j < count; j++ {
+			if named {
+				params = append(params, names[i]+" "+g.typeString(field.Type))
+			} else {
+				params = append(params, g.typeString(field.Type))
+			}
+			i++
+		}
+	}
+
+	var results []string
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			results = append(results, g.typeString(field.Type))
+		}
+	}
+
+	signature := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		signature += " " + results[0]
+	default:
+		signature += " (" + strings.Join(results, ", ") + ")"
+	}
+	return signature
+}
+
+func (g *generator) callArgs(fn *ast.FuncType, names []string) string {
+	args := append([]string{}, names...)
+	if len(fn.Params.List) > 0 {
+		if _, ok := fn.Params.List[len(fn.Params.List)-1].Type.(*ast.Ellipsis); ok {
+			args[len(args)-1] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+func (g *generator) notImplemented(fn *ast.FuncType, method string) string {
+	if fn.Results == nil {
+		return "return"
+	}
+	var values []string
+	for _, field := range fn.Results.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "error" {
+			values = append(values, fmt.Sprintf("notImplemented(%q)", method))
+			continue
+		}
+		values = append(values, g.zeroValue(field.Type))
+	}
+	return "return " + strings.Join(values, ", ")
+}
+
+func (g *generator) zeroValue(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.InterfaceType, *ast.FuncType, *ast.ChanType:
+		return "nil"
+	case *ast.SelectorExpr:
+		return "*new(" + g.typeString(t) + ")"
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return `""`
+		case "bool":
+			return "false"
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64", "byte", "rune":
+			return "0"
+		}
+		return g.typeString(t) + "{}"
+	}
+	return "*new(" + g.typeString(expr) + ")"
+}
+
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "leaseweb." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + g.typeString(t.Elt)
+		}
+		return "[" + t.Len.(*ast.BasicLit).Value + "]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.FuncType:
+		return "func" + g.signature(t, false)
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
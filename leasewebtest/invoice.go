@@ -0,0 +1,73 @@
+package leasewebtest
+
+import (
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type invoiceState struct {
+	invoice leaseweb.Invoice
+	pdf     []byte
+}
+
+func (s *Server) AddInvoice(invoice leaseweb.Invoice, pdf []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if invoice.Id == "" {
+		invoice.Id = s.nextId()
+	}
+	s.invoices = append(s.invoices, &invoiceState{invoice: invoice, pdf: pdf})
+}
+
+func (s *Server) SetProForma(proForma leaseweb.ProForma) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.proForma = proForma
+}
+
+func (s *Server) handleInvoices(w http.ResponseWriter, r *http.Request, segments []string) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	if len(segments) == 0 {
+		invoices := []leaseweb.Invoice{}
+		for _, state := range s.invoices {
+			invoices = append(invoices, state.invoice)
+		}
+		page, metadata := paginate(r, invoices)
+		writeJson(w, http.StatusOK, leaseweb.Invoices{Invoices: page, Metadata: metadata})
+		return
+	}
+
+	if len(segments) == 1 && segments[0] == "proforma" {
+		proForma := s.proForma
+		page, metadata := paginate(r, proForma.Contracts)
+		proForma.Contracts = page
+		proForma.Metadata = metadata
+		writeJson(w, http.StatusOK, proForma)
+		return
+	}
+
+	var state *invoiceState
+	for _, candidate := range s.invoices {
+		if candidate.invoice.Id == segments[0] {
+			state = candidate
+		}
+	}
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeJson(w, http.StatusOK, state.invoice)
+	case len(segments) == 2 && segments[1] == "pdf" && state.pdf != nil:
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write(state.pdf)
+	default:
+		writeNotFound(w)
+	}
+}
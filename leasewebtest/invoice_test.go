@@ -0,0 +1,45 @@
+package leasewebtest
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestInvoices(t *testing.T) {
+	s := newTestServer(t)
+	s.AddInvoice(leaseweb.Invoice{Id: "00000001", Currency: "EUR", Status: "OPEN", Total: leaseweb.MustMoney("100.50", "EUR")}, []byte("%PDF-1.4"))
+	s.AddInvoice(leaseweb.Invoice{Id: "00000002", Currency: "EUR", Status: "PAID"}, nil)
+	s.SetProForma(leaseweb.ProForma{Currency: "EUR", Total: leaseweb.MustMoney("42", "EUR"), Contracts: []leaseweb.Contract{{ContractId: "12345"}}})
+
+	api := leaseweb.InvoiceApi{}
+	assert := assert.New(t)
+	invoices, err := api.ListInvoices()
+	assert.Nil(err)
+	assert.Equal(invoices.Metadata.TotalCount, 2)
+	assert.Equal(invoices.Invoices[1].Status, "PAID")
+
+	invoice, err := api.GetInvoice("00000001")
+	assert.Nil(err)
+	assert.Equal(invoice.Total, leaseweb.MustMoney("100.50", "EUR"))
+
+	download, err := api.DownloadInvoicePdf(context.Background(), "00000001")
+	assert.Nil(err)
+	pdf, err := io.ReadAll(download)
+	download.Close()
+	assert.Nil(err)
+	assert.Equal(string(pdf), "%PDF-1.4")
+
+	_, err = api.DownloadInvoicePdf(context.Background(), "00000002")
+	assert.Equal(err.Error(), "Resource not found")
+
+	proForma, err := api.GetProForma()
+	assert.Nil(err)
+	assert.Equal(proForma.Total, leaseweb.MustMoney("42", "EUR"))
+	assert.Equal(proForma.Metadata.TotalCount, 1)
+	assert.Equal(proForma.Contracts[0].ContractId, "12345")
+}
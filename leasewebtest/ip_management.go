@@ -0,0 +1,199 @@
+package leasewebtest
+
+import (
+	"fmt"
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func (s *Server) AddIp(ip leaseweb.Ip) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ips = append(s.ips, &ip)
+}
+
+func (s *Server) findIp(address string) *leaseweb.Ip {
+	for _, ip := range s.ips {
+		if ip.Ip == address {
+			return ip
+		}
+	}
+	return nil
+}
+
+func (s *Server) nullRoute(ip *leaseweb.Ip, payload map[string]string) *leaseweb.NullRoute {
+	nullRoute := &leaseweb.NullRoute{
+		Id:               s.nextId(),
+		Ip:               ip.Ip,
+		NulledAt:         now(),
+		NulledBy:         "customer",
+		NullLevel:        parseNullLevel(payload["nullLevel"]),
+		Comment:          payload["comment"],
+		TicketId:         payload["ticketId"],
+		EquipmentId:      ip.EquipmentId,
+		AssignedContract: ip.AssignedContract,
+	}
+	ip.NullRouted = true
+	ip.UnnullingAllowed = true
+	s.nullRoutes = append(s.nullRoutes, nullRoute)
+	return nullRoute
+}
+
+func (s *Server) removeNullRoute(ip *leaseweb.Ip) bool {
+	if !ip.NullRouted {
+		return false
+	}
+	ip.NullRouted = false
+	for _, nullRoute := range s.nullRoutes {
+		if nullRoute.Ip == ip.Ip && nullRoute.UnnulledAt.IsZero() {
+			nullRoute.UnnulledAt = now()
+			nullRoute.UnnulledBy = "customer"
+		}
+	}
+	return true
+}
+
+func (s *Server) handleIpManagement(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		writeNotFound(w)
+		return
+	}
+
+	switch segments[0] {
+	case "ips":
+		s.handleIps(w, r, segments[1:])
+	case "nullRoutes":
+		s.handleNullRoutes(w, r, segments[1:])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) handleIps(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		query := r.URL.Query()
+		ips := []leaseweb.Ip{}
+		for _, ip := range s.ips {
+			if equipmentId := query.Get("equipmentId"); equipmentId != "" && ip.EquipmentId != equipmentId {
+				continue
+			}
+			if nullRouted := query.Get("nullRouted"); nullRouted != "" && fmt.Sprint(ip.NullRouted) != nullRouted {
+				continue
+			}
+			if version := query.Get("version"); version != "" && fmt.Sprint(ip.Version) != version {
+				continue
+			}
+			ips = append(ips, *ip)
+		}
+		page, metadata := paginate(r, ips)
+		writeJson(w, http.StatusOK, leaseweb.Ips{Ips: page, Metadata: metadata})
+		return
+	}
+
+	ip := s.findIp(segments[0])
+	if ip == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, ip)
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			ip.ReverseLookup = payload["reverseLookup"]
+			writeJson(w, http.StatusOK, ip)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	if segments[1] != "nullRoute" {
+		writeNotFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		if ip.NullRouted {
+			writeError(w, http.StatusConflict, "The IP address is already null routed.")
+			return
+		}
+		payload := map[string]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJson(w, http.StatusAccepted, s.nullRoute(ip, payload))
+	case http.MethodDelete:
+		if ip.NullRouted && !ip.UnnullingAllowed {
+			writeError(w, http.StatusForbidden, "Unnulling is not allowed for this IP address.")
+			return
+		}
+		if !s.removeNullRoute(ip) {
+			writeNotFound(w)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleNullRoutes(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		query := r.URL.Query()
+		nullRoutes := []leaseweb.NullRoute{}
+		for _, nullRoute := range s.nullRoutes {
+			if ip := query.Get("ip"); ip != "" && nullRoute.Ip != ip {
+				continue
+			}
+			if equipmentId := query.Get("equipmentId"); equipmentId != "" && nullRoute.EquipmentId != equipmentId {
+				continue
+			}
+			nullRoutes = append(nullRoutes, *nullRoute)
+		}
+		page, metadata := paginate(r, nullRoutes)
+		writeJson(w, http.StatusOK, leaseweb.NullRoutes{NullRoutes: page, Metadata: metadata})
+		return
+	}
+
+	for _, nullRoute := range s.nullRoutes {
+		if nullRoute.Id != segments[0] {
+			continue
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, nullRoute)
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			if comment, ok := payload["comment"]; ok {
+				nullRoute.Comment = comment
+			}
+			if automatedUnnullingAt, ok := payload["automatedUnnullingAt"]; ok {
+				parsed, err := leaseweb.ParseTime(automatedUnnullingAt)
+				if err != nil {
+					writeError(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				nullRoute.AutomatedUnnullingAt = parsed
+			}
+			writeJson(w, http.StatusOK, nullRoute)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
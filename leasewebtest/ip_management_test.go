@@ -0,0 +1,67 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestIpManagementNullRoute(t *testing.T) {
+	s := newTestServer(t)
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.10", EquipmentId: "12345", Version: 4})
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.11", EquipmentId: "67890", Version: 4})
+
+	api := leaseweb.IpManagementApi{}
+	assert := assert.New(t)
+	nullRoute, err := api.NullRouteIp("192.0.2.10", map[string]string{"comment": "abuse report 123", "nullLevel": "2"})
+	assert.Nil(err)
+	assert.Equal(nullRoute.Comment, "abuse report 123")
+	assert.Equal(nullRoute.NullLevel, 2)
+	assert.Equal(nullRoute.EquipmentId, "12345")
+
+	_, err = api.NullRouteIp("192.0.2.10")
+	assert.Equal(err.Error(), "The IP address is already null routed.")
+
+	ips, err := api.ListIps(map[string]interface{}{"nullRouted": true})
+	assert.Nil(err)
+	assert.Equal(len(ips.Ips), 1)
+	assert.Equal(ips.Ips[0].Ip, "192.0.2.10")
+
+	updated, err := api.UpdateNullRouteIp(nullRoute.Id, map[string]string{"comment": "resolved"})
+	assert.Nil(err)
+	assert.Equal(updated.Comment, "resolved")
+
+	assert.Nil(api.RemoveNullRouteIp("192.0.2.10"))
+	ip, err := api.GetIp("192.0.2.10")
+	assert.Nil(err)
+	assert.False(ip.NullRouted)
+
+	history, err := api.GetNullRouteHistory(nullRoute.Id)
+	assert.Nil(err)
+	assert.NotEmpty(history.UnnulledAt)
+	assert.Equal(history.UnnulledBy, "customer")
+}
+
+func TestIpManagementUnnullingNotAllowed(t *testing.T) {
+	s := newTestServer(t)
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.10", NullRouted: true})
+
+	err := leaseweb.IpManagementApi{}.RemoveNullRouteIp("192.0.2.10")
+	assert.Equal(t, err.Error(), "Unnulling is not allowed for this IP address.")
+}
+
+func TestIpManagementUpdateReverseLookup(t *testing.T) {
+	s := newTestServer(t)
+	s.AddIp(leaseweb.Ip{Ip: "192.0.2.10"})
+
+	api := leaseweb.IpManagementApi{}
+	assert := assert.New(t)
+	_, err := api.UpdateIp("192.0.2.10", "mail.example.com")
+	assert.Nil(err)
+
+	ip, err := api.GetIp("192.0.2.10")
+	assert.Nil(err)
+	assert.Equal(ip.ReverseLookup, "mail.example.com")
+}
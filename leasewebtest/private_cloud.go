@@ -0,0 +1,59 @@
+package leasewebtest
+
+import (
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type privateCloudState struct {
+	privateCloud leaseweb.PrivateCloud
+	credentials  []leaseweb.Credential
+}
+
+func (s *Server) AddPrivateCloud(privateCloud leaseweb.PrivateCloud, credentials []leaseweb.Credential) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if privateCloud.Id == "" {
+		privateCloud.Id = s.nextId()
+	}
+	s.privateClouds = append(s.privateClouds, &privateCloudState{privateCloud: privateCloud, credentials: credentials})
+}
+
+func (s *Server) handlePrivateClouds(w http.ResponseWriter, r *http.Request, segments []string) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	if len(segments) == 0 {
+		privateClouds := []leaseweb.PrivateCloud{}
+		for _, state := range s.privateClouds {
+			privateClouds = append(privateClouds, state.privateCloud)
+		}
+		page, metadata := paginate(r, privateClouds)
+		writeJson(w, http.StatusOK, leaseweb.PrivateClouds{PrivateClouds: page, Metadata: metadata})
+		return
+	}
+
+	var state *privateCloudState
+	for _, candidate := range s.privateClouds {
+		if candidate.privateCloud.Id == segments[0] {
+			state = candidate
+		}
+	}
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	switch {
+	case len(segments) == 1:
+		writeJson(w, http.StatusOK, state.privateCloud)
+	case len(segments) == 3 && segments[1] == "credentials":
+		writeCredentials(w, r, state.credentials, segments[2])
+	case len(segments) == 4 && segments[1] == "credentials":
+		writeCredential(w, state.credentials, segments[2], segments[3])
+	default:
+		writeNotFound(w)
+	}
+}
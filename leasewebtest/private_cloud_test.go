@@ -0,0 +1,35 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestPrivateClouds(t *testing.T) {
+	s := newTestServer(t)
+	s.AddPrivateCloud(leaseweb.PrivateCloud{Id: "218030", DataCenter: "AMS-01"}, []leaseweb.Credential{{Type: "REMOTE_MANAGEMENT", Username: "admin", Password: "secret"}})
+
+	api := leaseweb.PrivateCloudApi{}
+	assert := assert.New(t)
+	privateClouds, err := api.ListPrivateClouds()
+	assert.Nil(err)
+	assert.Equal(privateClouds.Metadata.TotalCount, 1)
+
+	privateCloud, err := api.GetPrivateCloud("218030")
+	assert.Nil(err)
+	assert.Equal(privateCloud.DataCenter, "AMS-01")
+
+	credentials, err := api.ListCredentials("218030", "REMOTE_MANAGEMENT")
+	assert.Nil(err)
+	assert.Equal(credentials.Credentials[0].Username, "admin")
+
+	credential, err := api.GetCredentials("218030", "REMOTE_MANAGEMENT", "admin")
+	assert.Nil(err)
+	assert.Equal(credential.Password, "secret")
+
+	_, err = api.GetCredentials("218030", "REMOTE_MANAGEMENT", "root")
+	assert.Equal(err.Error(), "Resource not found")
+}
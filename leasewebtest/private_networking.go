@@ -0,0 +1,88 @@
+package leasewebtest
+
+import (
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func (s *Server) AddPrivateNetwork(privateNetwork leaseweb.PrivateNetwork) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if privateNetwork.Id == "" {
+		privateNetwork.Id = s.nextId()
+	}
+	privateNetwork.EquipmentCount = len(privateNetwork.Servers)
+	s.privateNetworks = append(s.privateNetworks, &privateNetwork)
+}
+
+func (s *Server) findPrivateNetwork(id string) *leaseweb.PrivateNetwork {
+	for _, privateNetwork := range s.privateNetworks {
+		if privateNetwork.Id == id {
+			return privateNetwork
+		}
+	}
+	return nil
+}
+
+func (s *Server) handlePrivateNetworks(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			privateNetworks := []leaseweb.PrivateNetwork{}
+			for _, privateNetwork := range s.privateNetworks {
+				privateNetworks = append(privateNetworks, *privateNetwork)
+			}
+			page, metadata := paginate(r, privateNetworks)
+			writeJson(w, http.StatusOK, leaseweb.PrivateNetworks{PrivateNetworks: page, Metadata: metadata})
+		case http.MethodPost:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			privateNetwork := &leaseweb.PrivateNetwork{
+				Id:        s.nextId(),
+				Name:      payload["name"],
+				CreatedAt: now(),
+				UpdatedAt: now(),
+				Servers:   []string{},
+			}
+			s.privateNetworks = append(s.privateNetworks, privateNetwork)
+			writeJson(w, http.StatusCreated, privateNetwork)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	for i, privateNetwork := range s.privateNetworks {
+		if privateNetwork.Id != segments[0] {
+			continue
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, privateNetwork)
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			privateNetwork.Name = payload["name"]
+			privateNetwork.UpdatedAt = now()
+			writeJson(w, http.StatusOK, privateNetwork)
+		case http.MethodDelete:
+			if len(privateNetwork.Servers) > 0 {
+				writeError(w, http.StatusConflict, "The private network still has servers attached.")
+				return
+			}
+			s.privateNetworks = append(s.privateNetworks[:i], s.privateNetworks[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+	writeNotFound(w)
+}
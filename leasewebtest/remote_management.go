@@ -0,0 +1,72 @@
+package leasewebtest
+
+import (
+	"net/http"
+	"path"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type remoteManagementProfileState struct {
+	profile leaseweb.Profile
+	content []byte
+}
+
+func (s *Server) AddRemoteManagementProfile(profile leaseweb.Profile, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if profile.File == "" {
+		profile.File = "lsw-rmvpn-" + profile.DataCenter + ".ovpn"
+	}
+	s.remoteManagementProfiles = append(s.remoteManagementProfiles, &remoteManagementProfileState{profile: profile, content: content})
+}
+
+func (s *Server) RemoteManagementPassword() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remoteManagementPassword
+}
+
+func (s *Server) handleRemoteManagement(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 1 && segments[0] == "changeCredentials":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		payload := map[string]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if payload["password"] == "" {
+			writeError(w, http.StatusBadRequest, "The password is required.")
+			return
+		}
+		s.remoteManagementPassword = payload["password"]
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 1 && segments[0] == "profiles":
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		profiles := []leaseweb.Profile{}
+		for _, state := range s.remoteManagementProfiles {
+			profiles = append(profiles, state.profile)
+		}
+		page, metadata := paginate(r, profiles)
+		writeJson(w, http.StatusOK, leaseweb.Profiles{Profiles: page, Metadata: metadata})
+	case len(segments) == 2 && segments[0] == "profiles":
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		for _, state := range s.remoteManagementProfiles {
+			if path.Base(state.profile.File) == segments[1] {
+				w.Header().Set("Content-Type", "application/x-openvpn-profile")
+				w.Write(state.content)
+				return
+			}
+		}
+		writeNotFound(w)
+	default:
+		writeNotFound(w)
+	}
+}
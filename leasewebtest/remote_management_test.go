@@ -0,0 +1,32 @@
+package leasewebtest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestRemoteManagementOpenVpnConfig(t *testing.T) {
+	s := newTestServer(t)
+	s.AddRemoteManagementProfile(leaseweb.Profile{DataCenter: "AMS-01", SatelliteDataCenters: []string{"AMS-11"}}, []byte("client\nauth-user-pass\n"))
+
+	api := leaseweb.RemoteManagementApi{}
+	assert := assert.New(t)
+	profiles, err := api.ListProfiles()
+	assert.Nil(err)
+	assert.Equal(profiles.Profiles[0].File, "lsw-rmvpn-AMS-01.ovpn")
+
+	config, err := api.WriteOpenVpnConfig(context.Background(), leaseweb.Location{Site: "AMS-11"}, "user", "secret", t.TempDir())
+	assert.Nil(err)
+	assert.Equal(s.RemoteManagementPassword(), "secret")
+	b, err := os.ReadFile(config.ConfigPath)
+	assert.Nil(err)
+	assert.Contains(string(b), "auth-user-pass "+config.CredentialsPath)
+
+	err = api.ChangeCredentials("")
+	assert.Equal(err.Error(), "The password is required.")
+}
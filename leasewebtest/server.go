@@ -0,0 +1,169 @@
+package leasewebtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+const DEFAULT_LIMIT = 10
+
+type Server struct {
+	URL    string
+	ApiKey string
+
+	JobStepPercentage int
+
+	mu              sync.Mutex
+	ts              *httptest.Server
+	sequence        int
+	servers         []*dedicatedServerState
+	ips             []*leaseweb.Ip
+	nullRoutes      []*leaseweb.NullRoute
+	privateNetworks []*leaseweb.PrivateNetwork
+	floatingRanges  []*floatingIpRangeState
+	abuseReports    []*abuseReportState
+
+	invoices                 []*invoiceState
+	proForma                 leaseweb.ProForma
+	services                 []*leaseweb.Service
+	cancellationReasons      []leaseweb.CancellationReason
+	virtualServers           []*virtualServerState
+	privateClouds            []*privateCloudState
+	customerAccount          leaseweb.CustomerAccount
+	contacts                 []*leaseweb.Contact
+	remoteManagementProfiles []*remoteManagementProfileState
+	remoteManagementPassword string
+}
+
+type route struct {
+	prefix  string
+	handler func(w http.ResponseWriter, r *http.Request, segments []string)
+}
+
+func NewServer() *Server {
+	s := &Server{JobStepPercentage: 50}
+	s.ts = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.ts.URL
+	return s
+}
+
+func (s *Server) Close() {
+	s.ts.Close()
+}
+
+func (s *Server) InitClient(apiKey string) {
+	leaseweb.InitLeasewebClient(apiKey)
+	leaseweb.SetBaseUrl(s.URL)
+}
+
+func (s *Server) routes() []route {
+	return []route{
+		{prefix: "/bareMetals/v2/servers", handler: s.handleDedicatedServers},
+		{prefix: "/bareMetals/v2/privateNetworks", handler: s.handlePrivateNetworks},
+		{prefix: "/ipMgmt/v2", handler: s.handleIpManagement},
+		{prefix: "/floatingIps/v2/ranges", handler: s.handleFloatingIps},
+		{prefix: "/abuse/v1/reports", handler: s.handleAbuseReports},
+		{prefix: "/invoices/v1/invoices", handler: s.handleInvoices},
+		{prefix: "/services/v1/services", handler: s.handleServices},
+		{prefix: "/cloud/v2/virtualServers", handler: s.handleVirtualServers},
+		{prefix: "/cloud/v2/privateClouds", handler: s.handlePrivateClouds},
+		{prefix: "/account/v1", handler: s.handleCustomerAccount},
+		{prefix: "/bareMetals/v2/remoteManagement", handler: s.handleRemoteManagement},
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.ApiKey != "" && r.Header.Get("x-lsw-auth") != s.ApiKey {
+		writeError(w, http.StatusUnauthorized, "You are not authorized to view this resource.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, rt := range s.routes() {
+		if r.URL.Path != rt.prefix && !strings.HasPrefix(r.URL.Path, rt.prefix+"/") {
+			continue
+		}
+		var segments []string
+		if rest := strings.Trim(strings.TrimPrefix(r.URL.Path, rt.prefix), "/"); rest != "" {
+			segments = strings.Split(rest, "/")
+		}
+		rt.handler(w, r, segments)
+		return
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) nextId() string {
+	s.sequence++
+	return strconv.Itoa(s.sequence)
+}
+
+func now() leaseweb.Time {
+	return leaseweb.NewTime(time.Now().UTC().Truncate(time.Second))
+}
+
+func writeJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJson(w, status, leaseweb.LeasewebError{
+		ErrorCode:     strconv.Itoa(status),
+		ErrorMessage:  message,
+		CorrelationId: fmt.Sprintf("fake-%d", time.Now().UnixNano()),
+	})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Resource not found")
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		writeMethodNotAllowed(w)
+		return false
+	}
+	return true
+}
+
+func readPayload(r *http.Request, v interface{}) error {
+	if r.Body == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+func paginate[T any](r *http.Request, items []T) ([]T, leaseweb.Metadata) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = DEFAULT_LIMIT
+	}
+	metadata := leaseweb.Metadata{Limit: limit, Offset: offset, TotalCount: len(items)}
+
+	page := []T{}
+	for i := offset; i < len(items) && i < offset+limit; i++ {
+		page = append(page, items[i])
+	}
+	return page, metadata
+}
@@ -0,0 +1,92 @@
+package leasewebtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+const testApiKey = "test-api-key"
+
+func newTestServer(t *testing.T) *Server {
+	s := NewServer()
+	s.ApiKey = testApiKey
+	s.InitClient(testApiKey)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestServerRejectsInvalidApiKey(t *testing.T) {
+	s := newTestServer(t)
+	s.InitClient("wrong-key")
+
+	_, err := leaseweb.DedicatedServerApi{}.List()
+	assert := assert.New(t)
+	lswErr, ok := err.(*leaseweb.LeasewebError)
+	assert.True(ok)
+	assert.Equal(lswErr.ErrorCode, "401")
+	assert.Equal(lswErr.ErrorMessage, "You are not authorized to view this resource.")
+}
+
+func TestServerUnknownEndpoint(t *testing.T) {
+	newTestServer(t)
+
+	_, err := leaseweb.DedicatedRackApi{}.List()
+	assert := assert.New(t)
+	lswErr, ok := err.(*leaseweb.LeasewebError)
+	assert.True(ok)
+	assert.Equal(lswErr.ErrorCode, "404")
+	assert.Equal(lswErr.ErrorMessage, "Resource not found")
+}
+
+func TestServerPaginates(t *testing.T) {
+	s := newTestServer(t)
+	for i := 0; i < 12; i++ {
+		s.AddDedicatedServer(leaseweb.DedicatedServer{})
+	}
+
+	assert := assert.New(t)
+	firstPage, err := leaseweb.DedicatedServerApi{}.List()
+	assert.Nil(err)
+	assert.Equal(firstPage.Metadata.TotalCount, 12)
+	assert.Equal(firstPage.Metadata.Limit, DEFAULT_LIMIT)
+	assert.Equal(len(firstPage.Servers), 10)
+
+	secondPage, err := leaseweb.DedicatedServerApi{}.List(10, 10)
+	assert.Nil(err)
+	assert.Equal(secondPage.Metadata.Offset, 10)
+	assert.Equal(len(secondPage.Servers), 2)
+	assert.Equal(secondPage.Servers[1].Id, "12")
+}
+
+func TestServerMethodNotAllowed(t *testing.T) {
+	s := newTestServer(t)
+	s.AddDedicatedServer(leaseweb.DedicatedServer{Id: "12345"})
+	s.AddVirtualServer(leaseweb.VirtualServer{Id: "222903"}, nil)
+
+	requests := []struct{ method, path string }{
+		{http.MethodPost, "/bareMetals/v2/servers"},
+		{http.MethodGet, "/bareMetals/v2/servers/12345/powerCycle"},
+		{http.MethodDelete, "/bareMetals/v2/servers/12345/powerOff"},
+		{http.MethodPost, "/bareMetals/v2/servers/12345/powerInfo"},
+		{http.MethodGet, "/bareMetals/v2/servers/12345/cancelActiveJob"},
+		{http.MethodGet, "/cloud/v2/virtualServers/222903/reboot"},
+		{http.MethodPost, "/invoices/v1/invoices"},
+	}
+	for _, request := range requests {
+		req, err := http.NewRequest(request.method, s.URL+request.path, nil)
+		assert.Nil(t, err)
+		req.Header.Set("x-lsw-auth", testApiKey)
+		resp, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, http.StatusMethodNotAllowed, request.method+" "+request.path)
+	}
+
+	status, err := leaseweb.DedicatedServerApi{}.GetPowerStatus("12345")
+	assert.Nil(t, err)
+	assert.Equal(t, status.Ipmi.Status, "on")
+}
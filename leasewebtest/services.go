@@ -0,0 +1,126 @@
+package leasewebtest
+
+import (
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func (s *Server) AddService(service leaseweb.Service) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if service.Id == "" {
+		service.Id = s.nextId()
+	}
+	s.services = append(s.services, &service)
+}
+
+func (s *Server) SetCancellationReasons(reasons []leaseweb.CancellationReason) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancellationReasons = reasons
+}
+
+func (s *Server) handleServices(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		s.listServices(w, r)
+		return
+	}
+
+	if len(segments) == 1 && segments[0] == "cancellationReasons" {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		writeJson(w, http.StatusOK, leaseweb.CancellationReasons{CancellationReasons: s.cancellationReasons})
+		return
+	}
+
+	var service *leaseweb.Service
+	for _, candidate := range s.services {
+		if candidate.Id == segments[0] {
+			service = candidate
+		}
+	}
+	if service == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		writeJson(w, http.StatusOK, service)
+		return
+	}
+
+	switch segments[1] {
+	case "cancel":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		payload := map[string]string{}
+		if err := readPayload(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if !service.Cancellable {
+			writeError(w, http.StatusConflict, "The service cannot be cancelled.")
+			return
+		}
+		if !s.hasCancellationReason(payload["reasonCode"]) {
+			writeError(w, http.StatusBadRequest, "Unknown reason code "+payload["reasonCode"])
+			return
+		}
+		service.Cancellable = false
+		service.Uncancellable = true
+		service.Status = "CANCELLED"
+		w.WriteHeader(http.StatusNoContent)
+	case "uncancel":
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		if !service.Uncancellable {
+			writeError(w, http.StatusConflict, "The service cannot be uncancelled.")
+			return
+		}
+		service.Cancellable = true
+		service.Uncancellable = false
+		service.Status = "ACTIVE"
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) listServices(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	services := []leaseweb.Service{}
+	for _, service := range s.services {
+		if productId := query.Get("productId"); productId != "" && service.ProductId != productId {
+			continue
+		}
+		if equipmentId := query.Get("equipmentId"); equipmentId != "" && service.EquipmentId != equipmentId {
+			continue
+		}
+		if reference := query.Get("reference"); reference != "" && service.Reference != reference {
+			continue
+		}
+		services = append(services, *service)
+	}
+
+	page, metadata := paginate(r, services)
+	writeJson(w, http.StatusOK, leaseweb.Services{Services: page, Metadata: metadata})
+}
+
+func (s *Server) hasCancellationReason(reasonCode string) bool {
+	for _, reason := range s.cancellationReasons {
+		if reason.ReasonCode == reasonCode {
+			return true
+		}
+	}
+	return false
+}
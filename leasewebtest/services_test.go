@@ -0,0 +1,44 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestServicesCancellation(t *testing.T) {
+	s := newTestServer(t)
+	s.AddService(leaseweb.Service{Id: "12345", ProductId: "DEDICATED_SERVER", Reference: "web", Status: "ACTIVE", Cancellable: true})
+	s.AddService(leaseweb.Service{Id: "67890", ProductId: "IP_RANGE", Status: "ACTIVE"})
+	s.SetCancellationReasons([]leaseweb.CancellationReason{{ReasonCode: "CANCEL_COST", Reason: "Too expensive"}})
+
+	api := leaseweb.ServicesApi{}
+	assert := assert.New(t)
+	services, err := api.ListServicesWithFilter(leaseweb.ServiceFilter{ProductId: "DEDICATED_SERVER"})
+	assert.Nil(err)
+	assert.Equal(len(services.Services), 1)
+	assert.Equal(services.Services[0].Id, "12345")
+
+	reasons, err := api.ListCancellationReasons()
+	assert.Nil(err)
+	assert.Equal(reasons.CancellationReasons[0].ReasonCode, "CANCEL_COST")
+
+	err = api.CancelService("12345", "moving", "CANCEL_UNKNOWN")
+	assert.Equal(err.Error(), "Unknown reason code CANCEL_UNKNOWN")
+	assert.Nil(api.CancelService("12345", "moving", "CANCEL_COST"))
+	service, err := api.GetService("12345")
+	assert.Nil(err)
+	assert.Equal(service.Status, "CANCELLED")
+	assert.True(service.Uncancellable)
+
+	assert.Nil(api.UncancelService("12345"))
+	service, err = api.GetService("12345")
+	assert.Nil(err)
+	assert.Equal(service.Status, "ACTIVE")
+	assert.True(service.Cancellable)
+
+	err = api.CancelService("67890", "", "CANCEL_COST")
+	assert.Equal(err.Error(), "The service cannot be cancelled.")
+}
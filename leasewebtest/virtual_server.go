@@ -0,0 +1,163 @@
+package leasewebtest
+
+import (
+	"net/http"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+type virtualServerState struct {
+	server      leaseweb.VirtualServer
+	credentials []leaseweb.Credential
+	templates   []leaseweb.Template
+}
+
+func (s *Server) AddVirtualServer(server leaseweb.VirtualServer, templates []leaseweb.Template) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if server.Id == "" {
+		server.Id = s.nextId()
+	}
+	if server.State == "" {
+		server.State = "RUNNING"
+	}
+	s.virtualServers = append(s.virtualServers, &virtualServerState{server: server, templates: templates})
+}
+
+func (s *Server) handleVirtualServers(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		servers := []leaseweb.VirtualServer{}
+		for _, state := range s.virtualServers {
+			servers = append(servers, state.server)
+		}
+		page, metadata := paginate(r, servers)
+		writeJson(w, http.StatusOK, leaseweb.VirtualServers{VirtualServers: page, Metadata: metadata})
+		return
+	}
+
+	var state *virtualServerState
+	for _, candidate := range s.virtualServers {
+		if candidate.server.Id == segments[0] {
+			state = candidate
+		}
+	}
+	if state == nil {
+		writeNotFound(w)
+		return
+	}
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJson(w, http.StatusOK, state.server)
+		case http.MethodPut:
+			payload := map[string]string{}
+			if err := readPayload(r, &payload); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			state.server.Reference = payload["reference"]
+			writeJson(w, http.StatusOK, state.server)
+		default:
+			writeMethodNotAllowed(w)
+		}
+		return
+	}
+
+	switch segments[1] {
+	case "powerOn", "reboot", "reinstall":
+		s.virtualServerAction(w, r, state, segments[1], "RUNNING")
+	case "powerOff":
+		s.virtualServerAction(w, r, state, segments[1], "STOPPED")
+	case "credentials":
+		s.handleVirtualServerCredentials(w, r, state, segments[2:])
+	case "templates":
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		page, metadata := paginate(r, state.templates)
+		writeJson(w, http.StatusOK, leaseweb.Templates{Templates: page, Metadata: metadata})
+	default:
+		writeNotFound(w)
+	}
+}
+
+func (s *Server) virtualServerAction(w http.ResponseWriter, r *http.Request, state *virtualServerState, action string, serverState string) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	payload := map[string]string{}
+	if err := readPayload(r, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if operatingSystemId, ok := payload["operatingSystemId"]; ok {
+		state.server.Template = operatingSystemId
+	}
+	state.server.State = serverState
+	writeJson(w, http.StatusAccepted, leaseweb.VirtualServerResult{
+		Id:        s.nextId(),
+		Name:      "virtualServers." + action,
+		Status:    "PENDING",
+		CreatedAt: now(),
+	})
+}
+
+func (s *Server) handleVirtualServerCredentials(w http.ResponseWriter, r *http.Request, state *virtualServerState, segments []string) {
+	switch len(segments) {
+	case 0:
+		if !allowMethod(w, r, http.MethodPut) {
+			return
+		}
+		credential := leaseweb.Credential{}
+		if err := readPayload(r, &credential); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for i, existing := range state.credentials {
+			if existing.Type == credential.Type && existing.Username == credential.Username {
+				state.credentials[i].Password = credential.Password
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		state.credentials = append(state.credentials, credential)
+		w.WriteHeader(http.StatusNoContent)
+	case 1:
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		writeCredentials(w, r, state.credentials, segments[0])
+	case 2:
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		writeCredential(w, state.credentials, segments[0], segments[1])
+	default:
+		writeNotFound(w)
+	}
+}
+
+func writeCredentials(w http.ResponseWriter, r *http.Request, credentials []leaseweb.Credential, credentialType string) {
+	result := []leaseweb.Credential{}
+	for _, credential := range credentials {
+		if credential.Type == credentialType {
+			result = append(result, credential)
+		}
+	}
+	page, metadata := paginate(r, result)
+	writeJson(w, http.StatusOK, leaseweb.Credentials{Credentials: page, Metadata: metadata})
+}
+
+func writeCredential(w http.ResponseWriter, credentials []leaseweb.Credential, credentialType, username string) {
+	for _, credential := range credentials {
+		if credential.Type == credentialType && credential.Username == username {
+			writeJson(w, http.StatusOK, credential)
+			return
+		}
+	}
+	writeNotFound(w)
+}
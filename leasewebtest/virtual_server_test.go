@@ -0,0 +1,46 @@
+package leasewebtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	leaseweb "leaseweb-go-sdk"
+)
+
+func TestVirtualServerLifecycle(t *testing.T) {
+	s := newTestServer(t)
+	s.AddVirtualServer(leaseweb.VirtualServer{Id: "222903", Reference: "web01", Template: "Ubuntu 20.04 64-bit"}, []leaseweb.Template{{Id: "UBUNTU_22_04_64BIT", Name: "Ubuntu 22.04 64-bit"}})
+
+	api := leaseweb.VirtualServerApi{}
+	assert := assert.New(t)
+	server, err := api.UpdateVirtualServer("222903", "web02")
+	assert.Nil(err)
+	assert.Equal(server.Reference, "web02")
+
+	result, err := api.PowerOff("222903")
+	assert.Nil(err)
+	assert.Equal(result.Name, "virtualServers.powerOff")
+	server, err = api.GetVirtualServer("222903")
+	assert.Nil(err)
+	assert.Equal(server.State, "STOPPED")
+
+	_, err = api.Reinstall("222903", "UBUNTU_22_04_64BIT")
+	assert.Nil(err)
+	servers, err := api.ListVirtualServers()
+	assert.Nil(err)
+	assert.Equal(servers.VirtualServers[0].State, "RUNNING")
+	assert.Equal(servers.VirtualServers[0].Template, "UBUNTU_22_04_64BIT")
+
+	templates, err := api.ListTemplates("222903")
+	assert.Nil(err)
+	assert.Equal(templates.Templates[0].Name, "Ubuntu 22.04 64-bit")
+
+	assert.Nil(api.UpdateCredential("222903", "root", "OPERATING_SYSTEM", "secret"))
+	credentials, err := api.ListCredentials("222903", "OPERATING_SYSTEM")
+	assert.Nil(err)
+	assert.Equal(credentials.Metadata.TotalCount, 1)
+	credential, err := api.GetCredential("222903", "root", "OPERATING_SYSTEM")
+	assert.Nil(err)
+	assert.Equal(credential.Password, "secret")
+}
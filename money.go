@@ -0,0 +1,232 @@
+package leaseweb
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const MONEY_SCALE = 6
+
+var moneyScaleFactor = big.NewInt(1000000)
+
+type Money struct {
+	units    int64
+	Currency string
+}
+
+type CurrencyMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (cme *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("currency mismatch: %s and %s", cme.Expected, cme.Actual)
+}
+
+func NewMoney(amount string, currency string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	units, ok := ratToUnits(r)
+	if !ok {
+		return Money{}, fmt.Errorf("amount %q out of range", amount)
+	}
+	return Money{units: units, Currency: currency}, nil
+}
+
+func MustMoney(amount string, currency string) Money {
+	m, err := NewMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func Sum(values ...Money) (Money, error) {
+	var result Money
+	for _, value := range values {
+		var err error
+		if result, err = result.Add(value); err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
+func (m Money) WithCurrency(currency string) Money {
+	m.Currency = currency
+	return m
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.commonCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{units: m.units + other.units, Currency: currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+func (m Money) Mul(quantity int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.units), big.NewInt(quantity))
+	if !product.IsInt64() {
+		return Money{}, fmt.Errorf("%s times %d is out of range", m, quantity)
+	}
+	return Money{units: product.Int64(), Currency: m.Currency}, nil
+}
+
+func (m Money) Div(divisor int64) (Money, error) {
+	if divisor == 0 {
+		return Money{}, fmt.Errorf("cannot divide %s by zero", m)
+	}
+	r := new(big.Rat).SetFrac(big.NewInt(m.units), big.NewInt(divisor))
+	units, ok := ratToUnits(r.Quo(r, new(big.Rat).SetInt(moneyScaleFactor)))
+	if !ok {
+		return Money{}, fmt.Errorf("%s divided by %d is out of range", m, divisor)
+	}
+	return Money{units: units, Currency: m.Currency}, nil
+}
+
+func (m Money) Convert(rate *big.Rat, currency string) Money {
+	r := new(big.Rat).SetInt64(m.units)
+	r.Mul(r, rate)
+	units, _ := ratToUnits(new(big.Rat).Quo(r, new(big.Rat).SetInt(moneyScaleFactor)))
+	return Money{units: units, Currency: currency}
+}
+
+func (m Money) Neg() Money {
+	return Money{units: -m.units, Currency: m.Currency}
+}
+
+func (m Money) Abs() Money {
+	if m.units < 0 {
+		return m.Neg()
+	}
+	return m
+}
+
+func (m Money) Round(decimals int) Money {
+	if decimals >= MONEY_SCALE {
+		return m
+	}
+	step := int64(1)
+	for i := decimals; i < MONEY_SCALE; i++ {
+		step *= 10
+	}
+	units := m.units / step * step
+	if remainder := m.units % step; remainder*2 >= step {
+		units += step
+	} else if remainder*2 <= -step {
+		units -= step
+	}
+	return Money{units: units, Currency: m.Currency}
+}
+
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.units < other.units:
+		return -1
+	case m.units > other.units:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.units < 0
+}
+
+func (m Money) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(m.units), moneyScaleFactor).Float64()
+	return f
+}
+
+func (m Money) Format(decimals int) string {
+	if decimals > MONEY_SCALE {
+		decimals = MONEY_SCALE
+	}
+	s := m.Round(decimals).decimal()
+	if decimals == 0 {
+		return s[:strings.Index(s, ".")]
+	}
+	return s[:strings.Index(s, ".")+1+decimals]
+}
+
+func (m Money) Amount() string {
+	s := strings.TrimRight(m.decimal(), "0")
+	if decimals := len(s) - strings.Index(s, ".") - 1; decimals < 2 {
+		s += strings.Repeat("0", 2-decimals)
+	}
+	return s
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount()
+	}
+	return m.Amount() + " " + m.Currency
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Amount()), nil
+}
+
+func (m *Money) UnmarshalJSON(b []byte) error {
+	b = bytes.Trim(bytes.TrimSpace(b), `"`)
+	if len(b) == 0 || string(b) == "null" {
+		m.units = 0
+		return nil
+	}
+	parsed, err := NewMoney(string(b), m.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+func (m Money) commonCurrency(other Money) (string, error) {
+	if m.Currency == "" {
+		return other.Currency, nil
+	}
+	if other.Currency != "" && !strings.EqualFold(m.Currency, other.Currency) {
+		return "", &CurrencyMismatchError{Expected: m.Currency, Actual: other.Currency}
+	}
+	return m.Currency, nil
+}
+
+func (m Money) decimal() string {
+	units := m.units
+	sign := ""
+	if units < 0 {
+		sign = "-"
+	}
+	s := new(big.Int).Abs(big.NewInt(units)).String()
+	if len(s) <= MONEY_SCALE {
+		s = strings.Repeat("0", MONEY_SCALE-len(s)+1) + s
+	}
+	return sign + s[:len(s)-MONEY_SCALE] + "." + s[len(s)-MONEY_SCALE:]
+}
+
+func ratToUnits(r *big.Rat) (int64, bool) {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(moneyScaleFactor))
+	num, denom := scaled.Num(), scaled.Denom()
+	quotient, remainder := new(big.Int).QuoRem(num, denom, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(denom) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(num.Sign())))
+	}
+	if !quotient.IsInt64() {
+		return 0, false
+	}
+	return quotient.Int64(), true
+}
@@ -0,0 +1,134 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMoney(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := NewMoney("396.01", "EUR")
+	assert.Nil(err)
+	assert.Equal(m.Amount(), "396.01")
+	assert.Equal(m.String(), "396.01 EUR")
+	assert.Equal(m.Float64(), 396.01)
+
+	m, err = NewMoney("0.0000005", "EUR")
+	assert.Nil(err)
+	assert.Equal(m.Amount(), "0.000001")
+
+	_, err = NewMoney("abc", "EUR")
+	assert.Equal(err.Error(), `invalid amount "abc"`)
+
+	_, err = NewMoney("1e20", "EUR")
+	assert.Equal(err.Error(), `amount "1e20" out of range`)
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	assert := assert.New(t)
+
+	a := MustMoney("0.1", "EUR")
+	b := MustMoney("0.2", "EUR")
+	sum, err := a.Add(b)
+	assert.Nil(err)
+	assert.Equal(sum, MustMoney("0.3", "EUR"))
+
+	difference, err := a.Sub(b)
+	assert.Nil(err)
+	assert.Equal(difference, MustMoney("-0.1", "EUR"))
+	assert.True(difference.IsNegative())
+	assert.Equal(difference.Abs(), a)
+
+	product, err := MustMoney("33.33", "EUR").Mul(3)
+	assert.Nil(err)
+	assert.Equal(product, MustMoney("99.99", "EUR"))
+	quotient, err := MustMoney("100", "EUR").Div(3)
+	assert.Nil(err)
+	assert.Equal(quotient, MustMoney("33.333333", "EUR"))
+	quotient, err = MustMoney("-0.000002", "EUR").Div(4)
+	assert.Nil(err)
+	assert.Equal(quotient, MustMoney("-0.000001", "EUR"))
+
+	total, err := Sum(a, b, MustMoney("0.7", ""))
+	assert.Nil(err)
+	assert.Equal(total, MustMoney("1", "EUR"))
+
+	assert.Equal(a.Cmp(b), -1)
+	assert.Equal(b.Cmp(a), 1)
+	assert.Equal(a.Cmp(MustMoney("0.10", "USD")), 0)
+	assert.True(Money{}.IsZero())
+}
+
+func TestMoneyMulDivErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := MustMoney("100", "EUR").Div(0)
+	assert.Equal(err.Error(), "cannot divide 100.00 EUR by zero")
+
+	_, err = MustMoney("9000000000000", "EUR").Mul(2)
+	assert.Equal(err.Error(), "9000000000000.00 EUR times 2 is out of range")
+
+	_, err = MustMoney("-9000000000000", "EUR").Div(-1)
+	assert.Nil(err)
+}
+
+func TestMoneyCurrencyMismatch(t *testing.T) {
+	_, err := MustMoney("1", "EUR").Add(MustMoney("1", "USD"))
+	assert := assert.New(t)
+	assert.Equal(err, &CurrencyMismatchError{Expected: "EUR", Actual: "USD"})
+	assert.Equal(err.Error(), "currency mismatch: EUR and USD")
+
+	_, err = Sum(MustMoney("1", "EUR"), MustMoney("1", "USD"))
+	assert.NotNil(err)
+}
+
+func TestMoneyRoundAndFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(MustMoney("1.005", "EUR").Round(2), MustMoney("1.01", "EUR"))
+	assert.Equal(MustMoney("-1.005", "EUR").Round(2), MustMoney("-1.01", "EUR"))
+	assert.Equal(MustMoney("1.004", "EUR").Round(2), MustMoney("1", "EUR"))
+
+	assert.Equal(MustMoney("12", "EUR").Format(2), "12.00")
+	assert.Equal(MustMoney("12.345", "EUR").Format(2), "12.35")
+	assert.Equal(MustMoney("12.5", "EUR").Format(0), "13")
+	assert.Equal(MustMoney("-0.5", "EUR").Format(2), "-0.50")
+
+	assert.Equal(MustMoney("12", "EUR").Amount(), "12.00")
+	assert.Equal(MustMoney("12.3456", "EUR").Amount(), "12.3456")
+	assert.Equal(MustMoney("12", "").String(), "12.00")
+}
+
+func TestMoneyConvert(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(MustMoney("100", "USD").Convert(big.NewRat(9, 10), "EUR"), MustMoney("90", "EUR"))
+	assert.Equal(MustMoney("10.50", "EUR").Convert(big.NewRat(1, 1), "EUR"), MustMoney("10.5", "EUR"))
+	assert.Equal(MustMoney("0.10", "USD").Convert(big.NewRat(3, 1), "EUR"), MustMoney("0.3", "EUR"))
+}
+
+func TestMoneyJson(t *testing.T) {
+	var value struct {
+		Number Money `json:"number"`
+		String Money `json:"string"`
+		Empty  Money `json:"empty"`
+		Null   Money `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"number": 1756.21, "string": "0.10", "empty": "", "null": null}`), &value)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(value.Number, MustMoney("1756.21", ""))
+	assert.Equal(value.String, MustMoney("0.1", ""))
+	assert.True(value.Empty.IsZero())
+	assert.True(value.Null.IsZero())
+
+	b, err := json.Marshal(value)
+	assert.Nil(err)
+	assert.Equal(string(b), `{"number":1756.21,"string":0.10,"empty":0.00,"null":0.00}`)
+
+	err = json.Unmarshal([]byte(`{"number": "abc"}`), &value)
+	assert.NotNil(err)
+}
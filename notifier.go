@@ -0,0 +1,83 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+var smtpSendMail = smtp.SendMail
+
+type Notification interface {
+	Subject() string
+	Body() string
+}
+
+type Notifier interface {
+	Notify(notification Notification) error
+}
+
+type WebhookNotifier struct {
+	Url     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+type SmtpNotifier struct {
+	Addr string
+	Auth smtp.Auth
+	From string
+	To   []string
+}
+
+func (wn WebhookNotifier) Notify(notification Notification) error {
+	payload := map[string]interface{}{
+		"subject": notification.Subject(),
+		"body":    notification.Body(),
+		"event":   notification,
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, wn.Url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range wn.Headers {
+		req.Header.Set(key, value)
+	}
+
+	client := wn.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned %s", wn.Url, resp.Status)
+	}
+	return nil
+}
+
+func (sn SmtpNotifier) Notify(notification Notification) error {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", sn.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(sn.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject()))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(notification.Body(), "\n", "\r\n"))
+	return smtpSendMail(sn.Addr, sn.Auth, sn.From, sn.To, []byte(msg.String()))
+}
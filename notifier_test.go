@@ -0,0 +1,88 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNotification struct {
+	Id string `json:"id"`
+}
+
+func (tn testNotification) Subject() string {
+	return "Test notification " + tn.Id
+}
+
+func (tn testNotification) Body() string {
+	return "first line\nsecond line"
+}
+
+type recordingNotifier struct {
+	notifications []Notification
+	err           error
+}
+
+func (rn *recordingNotifier) Notify(notification Notification) error {
+	if rn.err != nil {
+		return rn.err
+	}
+	rn.notifications = append(rn.notifications, notification)
+	return nil
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		payload := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "Test notification 1", payload["subject"])
+		assert.Equal(t, "first line\nsecond line", payload["body"])
+		assert.Equal(t, map[string]interface{}{"id": "1"}, payload["event"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	notifier := WebhookNotifier{Url: ts.URL, Headers: map[string]string{"Authorization": "Bearer secret"}}
+	assert.Nil(t, notifier.Notify(testNotification{Id: "1"}))
+}
+
+func TestWebhookNotifierError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	err := WebhookNotifier{Url: ts.URL}.Notify(testNotification{Id: "1"})
+	assert.Equal(t, err.Error(), "webhook "+ts.URL+" returned 502 Bad Gateway")
+}
+
+func TestSmtpNotifier(t *testing.T) {
+	oldSendMail := smtpSendMail
+	defer func() { smtpSendMail = oldSendMail }()
+
+	var addr, from string
+	var to []string
+	var msg []byte
+	smtpSendMail = func(a string, auth smtp.Auth, f string, t []string, m []byte) error {
+		addr, from, to, msg = a, f, t, m
+		return nil
+	}
+
+	notifier := SmtpNotifier{Addr: "mail.example.com:587", From: "lsw@example.com", To: []string{"abuse@example.com", "ops@example.com"}}
+	assert := assert.New(t)
+	assert.Nil(notifier.Notify(testNotification{Id: "Ä"}))
+	assert.Equal(addr, "mail.example.com:587")
+	assert.Equal(from, "lsw@example.com")
+	assert.Equal(to, []string{"abuse@example.com", "ops@example.com"})
+	assert.Contains(string(msg), "From: lsw@example.com\r\n")
+	assert.Contains(string(msg), "To: abuse@example.com, ops@example.com\r\n")
+	assert.Contains(string(msg), "Subject: =?utf-8?q?Test_notification_=C3=84?=\r\n")
+	assert.Contains(string(msg), "\r\n\r\nfirst line\r\nsecond line")
+}
@@ -0,0 +1,29 @@
+package leaseweb
+
+func listAllPages[T any](limit int, listPage func(offset int, limit int) ([]T, Metadata, error)) ([]T, error) {
+	var result []T
+	err := forEachPage(limit, listPage, func(items []T) bool {
+		result = append(result, items...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func forEachPage[T any](limit int, listPage func(offset int, limit int) ([]T, Metadata, error), visit func(items []T) bool) error {
+	for offset := 0; ; {
+		items, metadata, err := listPage(offset, limit)
+		if err != nil {
+			return err
+		}
+		if !visit(items) {
+			return nil
+		}
+		offset += len(items)
+		if len(items) == 0 || offset >= metadata.TotalCount {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package leaseweb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPages(items []int, totalCount int, offsets *[]int) func(offset, limit int) ([]int, Metadata, error) {
+	return func(offset, limit int) ([]int, Metadata, error) {
+		*offsets = append(*offsets, offset)
+		end := offset + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		if offset > end {
+			offset = end
+		}
+		return items[offset:end], Metadata{Limit: limit, Offset: offset, TotalCount: totalCount}, nil
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	assert := assert.New(t)
+
+	offsets := []int{}
+	result, err := listAllPages(2, testPages([]int{1, 2, 3, 4, 5}, 5, &offsets))
+	assert.Nil(err)
+	assert.Equal(result, []int{1, 2, 3, 4, 5})
+	assert.Equal(offsets, []int{0, 2, 4})
+
+	offsets = []int{}
+	result, err = listAllPages(2, testPages([]int{1, 2, 3}, 10, &offsets))
+	assert.Nil(err)
+	assert.Equal(result, []int{1, 2, 3})
+	assert.Equal(offsets, []int{0, 2, 3})
+
+	result, err = listAllPages(2, func(offset, limit int) ([]int, Metadata, error) {
+		if offset > 0 {
+			return nil, Metadata{}, errors.New("page failed")
+		}
+		return []int{1, 2}, Metadata{TotalCount: 4}, nil
+	})
+	assert.Nil(result)
+	assert.Equal(err.Error(), "page failed")
+}
+
+func TestForEachPageStops(t *testing.T) {
+	offsets := []int{}
+	visited := []int{}
+	err := forEachPage(2, testPages([]int{1, 2, 3, 4, 5}, 5, &offsets), func(items []int) bool {
+		visited = append(visited, items...)
+		return len(visited) < 3
+	})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(visited, []int{1, 2, 3, 4})
+	assert.Equal(offsets, []int{0, 2})
+}
@@ -1,6 +1,7 @@
 package leaseweb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -28,13 +29,13 @@ type PrivateCloud struct {
 }
 
 type PrivateCloudContract struct {
-	Id                string  `json:"id"`
-	StartsAt          string  `json:"startsAt"`
-	EndsAt            string  `json:"endsAt"`
-	BillingCycle      int     `json:"billingCycle"`
-	BillingFrequency  string  `json:"billingFrequency"`
-	PricePerFrequency float32 `json:"pricePerFrequency"`
-	Currency          string  `json:"currency"`
+	Id                string `json:"id"`
+	StartsAt          Time   `json:"startsAt"`
+	EndsAt            Time   `json:"endsAt"`
+	BillingCycle      int    `json:"billingCycle"`
+	BillingFrequency  string `json:"billingFrequency"`
+	PricePerFrequency Money  `json:"pricePerFrequency"`
+	Currency          string `json:"currency"`
 }
 
 type PrivateCloudIp struct {
@@ -85,6 +86,15 @@ type StorageMetric struct {
 	Storage BasicMetric `json:"STORAGE"`
 }
 
+func (pcc *PrivateCloudContract) UnmarshalJSON(b []byte) error {
+	type contract PrivateCloudContract
+	if err := json.Unmarshal(b, (*contract)(pcc)); err != nil {
+		return err
+	}
+	pcc.PricePerFrequency = pcc.PricePerFrequency.WithCurrency(pcc.Currency)
+	return nil
+}
+
 func (pca PrivateCloudApi) getPath(endpoint string) string {
 	return "/cloud/" + PRIVATE_CLOUD_API_VERSION + endpoint
 }
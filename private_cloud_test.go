@@ -76,11 +76,11 @@ func TestListPrivateClouds(t *testing.T) {
 	assert.Equal(privateCloud1.ServiceOffering, "FLAT_FEE")
 	assert.Equal(privateCloud1.Sla, "Bronze")
 	assert.Equal(privateCloud1.Contract.Id, "30000775")
-	assert.Equal(privateCloud1.Contract.StartsAt, "2015-11-01T00:00:00+02:00")
-	assert.Equal(privateCloud1.Contract.EndsAt, "2016-12-30T10:39:27+01:00")
+	assert.Equal(privateCloud1.Contract.StartsAt, MustTime("2015-11-01T00:00:00+02:00"))
+	assert.Equal(privateCloud1.Contract.EndsAt, MustTime("2016-12-30T10:39:27+01:00"))
 	assert.Equal(privateCloud1.Contract.BillingCycle, 12)
 	assert.Equal(privateCloud1.Contract.BillingFrequency, "MONTH")
-	assert.Equal(privateCloud1.Contract.PricePerFrequency, float32(0))
+	assert.Equal(privateCloud1.Contract.PricePerFrequency, MustMoney("0", "EUR"))
 	assert.Equal(privateCloud1.Contract.Currency, "EUR")
 	assert.Equal(privateCloud1.Hardware.Cpu.Cores, 25)
 	assert.Equal(privateCloud1.Hardware.Memory.Amount, 50)
@@ -250,11 +250,11 @@ func TestGetPrivateCloud(t *testing.T) {
 	assert.Equal(response.ServiceOffering, "FLAT_FEE")
 	assert.Equal(response.Sla, "Bronze")
 	assert.Equal(response.Contract.Id, "30000775")
-	assert.Equal(response.Contract.StartsAt, "2015-11-01T00:00:00+02:00")
-	assert.Equal(response.Contract.EndsAt, "2016-12-30T10:39:27+01:00")
+	assert.Equal(response.Contract.StartsAt, MustTime("2015-11-01T00:00:00+02:00"))
+	assert.Equal(response.Contract.EndsAt, MustTime("2016-12-30T10:39:27+01:00"))
 	assert.Equal(response.Contract.BillingCycle, 12)
 	assert.Equal(response.Contract.BillingFrequency, "MONTH")
-	assert.Equal(response.Contract.PricePerFrequency, float32(0))
+	assert.Equal(response.Contract.PricePerFrequency, MustMoney("0", "EUR"))
 	assert.Equal(response.Contract.Currency, "EUR")
 	assert.Equal(response.Hardware.Cpu.Cores, 25)
 	assert.Equal(response.Hardware.Memory.Amount, 50)
@@ -643,14 +643,14 @@ func TestGetDataTrafficMetrics(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "SUM")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.DataTrafficDown.Unit, "GB")
-	assert.Equal(response.Metric.DataTrafficDown.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficDown.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficDown.Values[0].Value, 90)
-	assert.Equal(response.Metric.DataTrafficDown.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficDown.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficDown.Values[1].Value, 250)
 	assert.Equal(response.Metric.DataTrafficUp.Unit, "GB")
-	assert.Equal(response.Metric.DataTrafficUp.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficUp.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficUp.Values[0].Value, 900)
-	assert.Equal(response.Metric.DataTrafficUp.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficUp.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficUp.Values[1].Value, 2500)
 }
 
@@ -708,14 +708,14 @@ func TestGetDataTrafficMetricsWithFilter(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "SUM")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.DataTrafficDown.Unit, "GB")
-	assert.Equal(response.Metric.DataTrafficDown.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficDown.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficDown.Values[0].Value, 90)
-	assert.Equal(response.Metric.DataTrafficDown.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficDown.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficDown.Values[1].Value, 250)
 	assert.Equal(response.Metric.DataTrafficUp.Unit, "GB")
-	assert.Equal(response.Metric.DataTrafficUp.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficUp.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficUp.Values[0].Value, 900)
-	assert.Equal(response.Metric.DataTrafficUp.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DataTrafficUp.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DataTrafficUp.Values[1].Value, 2500)
 }
 
@@ -844,14 +844,14 @@ func TestGetBandWidthMetrics(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "AVG")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.DownPublic.Unit, "bps")
-	assert.Equal(response.Metric.DownPublic.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DownPublic.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DownPublic.Values[0].Value, 28202556)
-	assert.Equal(response.Metric.DownPublic.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DownPublic.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DownPublic.Values[1].Value, 28202557)
 	assert.Equal(response.Metric.UpPublic.Unit, "bps")
-	assert.Equal(response.Metric.UpPublic.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.UpPublic.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.UpPublic.Values[0].Value, 158317518)
-	assert.Equal(response.Metric.UpPublic.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.UpPublic.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.UpPublic.Values[1].Value, 158317519)
 }
 
@@ -909,14 +909,14 @@ func TestGetBandWidthMetricsWithFilter(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "AVG")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.DownPublic.Unit, "bps")
-	assert.Equal(response.Metric.DownPublic.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.DownPublic.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.DownPublic.Values[0].Value, 28202556)
-	assert.Equal(response.Metric.DownPublic.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.DownPublic.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.DownPublic.Values[1].Value, 28202557)
 	assert.Equal(response.Metric.UpPublic.Unit, "bps")
-	assert.Equal(response.Metric.UpPublic.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.UpPublic.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.UpPublic.Values[0].Value, 158317518)
-	assert.Equal(response.Metric.UpPublic.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.UpPublic.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.UpPublic.Values[1].Value, 158317519)
 }
 
@@ -1032,9 +1032,9 @@ func TestGetCpuMetrics(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Cpu.Unit, "CORES")
-	assert.Equal(response.Metric.Cpu.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Cpu.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Cpu.Values[0].Value, 24)
-	assert.Equal(response.Metric.Cpu.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Cpu.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Cpu.Values[1].Value, 24)
 }
 
@@ -1079,9 +1079,9 @@ func TestGetCpuMetricsWithFilter(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Cpu.Unit, "CORES")
-	assert.Equal(response.Metric.Cpu.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Cpu.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Cpu.Values[0].Value, 24)
-	assert.Equal(response.Metric.Cpu.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Cpu.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Cpu.Values[1].Value, 24)
 }
 
@@ -1197,9 +1197,9 @@ func TestGetMemoryMetrics(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Memory.Unit, "GB")
-	assert.Equal(response.Metric.Memory.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Memory.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Memory.Values[0].Value, 8)
-	assert.Equal(response.Metric.Memory.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Memory.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Memory.Values[1].Value, 16)
 }
 
@@ -1244,9 +1244,9 @@ func TestGetMemoryMetricsWithFilter(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Memory.Unit, "GB")
-	assert.Equal(response.Metric.Memory.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Memory.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Memory.Values[0].Value, 8)
-	assert.Equal(response.Metric.Memory.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Memory.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Memory.Values[1].Value, 16)
 }
 
@@ -1362,9 +1362,9 @@ func TestGetStorageMetrics(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Storage.Unit, "GB")
-	assert.Equal(response.Metric.Storage.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Storage.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Storage.Values[0].Value, 900)
-	assert.Equal(response.Metric.Storage.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Storage.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Storage.Values[1].Value, 2500)
 }
 
@@ -1409,9 +1409,9 @@ func TestGetStorageMetricsWithFilter(t *testing.T) {
 	assert.Equal(response.Metadata.Aggregation, "MAX")
 	assert.Equal(response.Metadata.Granularity, "MONTH")
 	assert.Equal(response.Metric.Storage.Unit, "GB")
-	assert.Equal(response.Metric.Storage.Values[0].Timestamp, "2017-07-01T00:00:00+00:00")
+	assert.Equal(response.Metric.Storage.Values[0].Timestamp, MustTime("2017-07-01T00:00:00+00:00"))
 	assert.Equal(response.Metric.Storage.Values[0].Value, 900)
-	assert.Equal(response.Metric.Storage.Values[1].Timestamp, "2017-07-02T00:00:00+00:00")
+	assert.Equal(response.Metric.Storage.Values[1].Timestamp, MustTime("2017-07-02T00:00:00+00:00"))
 	assert.Equal(response.Metric.Storage.Values[1].Value, 2500)
 }
 
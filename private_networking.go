@@ -19,8 +19,8 @@ type PrivateNetwork struct {
 	EquipmentCount int      `json:"equipmentCount"`
 	Id             string   `json:"id"`
 	Name           string   `json:"name"`
-	CreatedAt      string   `json:"createdAt"`
-	UpdatedAt      string   `json:"updatedAt"`
+	CreatedAt      Time     `json:"createdAt"`
+	UpdatedAt      Time     `json:"updatedAt"`
 	Servers        []string `json:"servers"`
 }
 
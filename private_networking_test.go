@@ -44,8 +44,8 @@ func TestListPrivateNetworks(t *testing.T) {
 	assert.Equal(PrivateNetwork.EquipmentCount, 4)
 	assert.Equal(PrivateNetwork.Id, "811")
 	assert.Equal(PrivateNetwork.Name, "default")
-	assert.Equal(PrivateNetwork.CreatedAt, "2015-07-16T13:06:45+0200")
-	assert.Equal(PrivateNetwork.UpdatedAt, "2015-07-16T13:06:45+0200")
+	assert.Equal(PrivateNetwork.CreatedAt, MustTime("2015-07-16T13:06:45+0200"))
+	assert.Equal(PrivateNetwork.UpdatedAt, MustTime("2015-07-16T13:06:45+0200"))
 }
 
 func TestListPrivateNetworksPaginate(t *testing.T) {
@@ -84,8 +84,8 @@ func TestListPrivateNetworksPaginate(t *testing.T) {
 	assert.Equal(PrivateNetwork.EquipmentCount, 4)
 	assert.Equal(PrivateNetwork.Id, "811")
 	assert.Equal(PrivateNetwork.Name, "default")
-	assert.Equal(PrivateNetwork.CreatedAt, "2015-07-16T13:06:45+0200")
-	assert.Equal(PrivateNetwork.UpdatedAt, "2015-07-16T13:06:45+0200")
+	assert.Equal(PrivateNetwork.CreatedAt, MustTime("2015-07-16T13:06:45+0200"))
+	assert.Equal(PrivateNetwork.UpdatedAt, MustTime("2015-07-16T13:06:45+0200"))
 }
 
 func TestListPrivateNetworksServerErrors(t *testing.T) {
@@ -184,8 +184,8 @@ func TestCreatePrivateNetwork(t *testing.T) {
 	assert.Equal(PrivateNetwork.EquipmentCount, 0)
 	assert.Equal(PrivateNetwork.Id, "12345")
 	assert.Equal(PrivateNetwork.Name, "production")
-	assert.Equal(PrivateNetwork.CreatedAt, "2015-01-21T14:34:12+0000")
-	assert.Equal(PrivateNetwork.UpdatedAt, "2015-01-21T14:34:12+0000")
+	assert.Equal(PrivateNetwork.CreatedAt, MustTime("2015-01-21T14:34:12+0000"))
+	assert.Equal(PrivateNetwork.UpdatedAt, MustTime("2015-01-21T14:34:12+0000"))
 	assert.Equal(len(PrivateNetwork.Servers), 0)
 }
 
@@ -282,8 +282,8 @@ func TestGetPrivateNetwork(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(PrivateNetwork.Id, "12345")
 	assert.Equal(PrivateNetwork.Name, "default")
-	assert.Equal(PrivateNetwork.CreatedAt, "2015-01-21T14:34:12+0000")
-	assert.Equal(PrivateNetwork.UpdatedAt, "2015-01-21T14:34:12+0000")
+	assert.Equal(PrivateNetwork.CreatedAt, MustTime("2015-01-21T14:34:12+0000"))
+	assert.Equal(PrivateNetwork.UpdatedAt, MustTime("2015-01-21T14:34:12+0000"))
 }
 
 func TestGetPrivateNetworkServerErrors(t *testing.T) {
@@ -382,8 +382,8 @@ func TestUpdatePrivateNetwork(t *testing.T) {
 	assert.Equal(PrivateNetwork.EquipmentCount, 0)
 	assert.Equal(PrivateNetwork.Id, "12345")
 	assert.Equal(PrivateNetwork.Name, "production")
-	assert.Equal(PrivateNetwork.CreatedAt, "2015-01-21T14:34:12+0000")
-	assert.Equal(PrivateNetwork.UpdatedAt, "2015-01-21T14:34:12+0000")
+	assert.Equal(PrivateNetwork.CreatedAt, MustTime("2015-01-21T14:34:12+0000"))
+	assert.Equal(PrivateNetwork.UpdatedAt, MustTime("2015-01-21T14:34:12+0000"))
 	assert.Equal(len(PrivateNetwork.Servers), 0)
 }
 
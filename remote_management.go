@@ -1,15 +1,30 @@
 package leaseweb
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 )
 
-const REMOTE_MANAGEMENT_API_VERSION = "v2"
+const (
+	REMOTE_MANAGEMENT_API_VERSION        = "v2"
+	REMOTE_MANAGEMENT_PROFILE_PAGE_LIMIT = 50
+)
 
 type RemoteManagementApi struct{}
 
+type OpenVpnConfig struct {
+	Profile         Profile
+	ConfigPath      string
+	CredentialsPath string
+}
+
 type Profiles struct {
 	Metadata Metadata  `json:"_metadata"`
 	Profiles []Profile `json:"profiles"`
@@ -26,7 +41,7 @@ func (rma RemoteManagementApi) getPath(endpoint string) string {
 }
 
 func (rma RemoteManagementApi) ChangeCredentials(password string) error {
-	payload := map[string]string{password: password}
+	payload := map[string]string{"password": password}
 	path := rma.getPath("/remoteManagement/changeCredentials")
 	return doRequest(http.MethodPost, path, nil, payload)
 }
@@ -40,7 +55,7 @@ func (rma RemoteManagementApi) ListProfiles(args ...int) (*Profiles, error) {
 		v.Add("limit", fmt.Sprint(args[1]))
 	}
 
-	path := rma.getPath("/remoteManagement/profiles" + v.Encode())
+	path := rma.getPath("/remoteManagement/profiles?" + v.Encode())
 	result := &Profiles{}
 	if err := doRequest(http.MethodGet, path, result); err != nil {
 		return nil, err
@@ -48,17 +63,128 @@ func (rma RemoteManagementApi) ListProfiles(args ...int) (*Profiles, error) {
 	return result, nil
 }
 
-// TODO: GetProfile should be tested
-// func (rma RemoteManagementApi) GetProfile(datacenter string) (Profile, error) {
-// 	profile := &Profile{}
-// 	r := leasewebRequest{
-// 		response: profile,
-// 		method:   GET,
-// 		endpoint: rma.GetPath() + "/" + rma.GetVersion() + "/remoteManagement/profiles/lsw-rmvpn-" + datacenter + ".ovpn",
-// 	}
-// 	err := doRequest(r)
-// 	if err != nil {
-// 		return Profile{}, err
-// 	}
-// 	return *profile, nil
-// }
+func (rma RemoteManagementApi) DownloadProfile(ctx context.Context, profile Profile) (*Download, error) {
+	if profile.File == "" {
+		return nil, fmt.Errorf("remote management profile of %s has no file", profile.DataCenter)
+	}
+	path := rma.getPath("/remoteManagement/profiles/" + url.PathEscape(path.Base(profile.File)))
+	return doDownload(ctx, path, 0)
+}
+
+func (rma RemoteManagementApi) GetProfile(ctx context.Context, datacenter string) ([]byte, error) {
+	profile, err := rma.FindProfile(datacenter)
+	if err != nil {
+		return nil, err
+	}
+	download, err := rma.DownloadProfile(ctx, *profile)
+	if err != nil {
+		return nil, err
+	}
+	defer download.Close()
+	return ioutil.ReadAll(download)
+}
+
+func (rma RemoteManagementApi) FindProfile(site string) (*Profile, error) {
+	var result *Profile
+	err := forEachPage(REMOTE_MANAGEMENT_PROFILE_PAGE_LIMIT, func(offset, limit int) ([]Profile, Metadata, error) {
+		profiles, err := rma.ListProfiles(offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return profiles.Profiles, profiles.Metadata, nil
+	}, func(profiles []Profile) bool {
+		for i, profile := range profiles {
+			if strings.EqualFold(profile.DataCenter, site) {
+				result = &profiles[i]
+				return false
+			}
+			for _, satelliteDataCenter := range profile.SatelliteDataCenters {
+				if strings.EqualFold(satelliteDataCenter, site) {
+					result = &profiles[i]
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("no remote management profile found for site %q", site)
+	}
+	return result, nil
+}
+
+func (rma RemoteManagementApi) WriteOpenVpnConfig(ctx context.Context, location Location, username, password, dir string) (*OpenVpnConfig, error) {
+	profile, err := rma.FindProfile(location.Site)
+	if err != nil {
+		return nil, err
+	}
+	download, err := rma.DownloadProfile(ctx, *profile)
+	if err != nil {
+		return nil, err
+	}
+	defer download.Close()
+	data, err := ioutil.ReadAll(download)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSuffix(path.Base(profile.File), ".ovpn")
+	config := &OpenVpnConfig{
+		Profile:         *profile,
+		ConfigPath:      filepath.Join(dir, name+".ovpn"),
+		CredentialsPath: filepath.Join(dir, name+".auth"),
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err = writeFileAtomic(config.CredentialsPath, []byte(username+"\n"+password+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	if err = writeFileAtomic(config.ConfigPath, withOpenVpnCredentials(data, config.CredentialsPath), 0600); err != nil {
+		return nil, err
+	}
+	if err = rma.ChangeCredentials(password); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func withOpenVpnCredentials(profile []byte, credentialsPath string) []byte {
+	authUserPass := "auth-user-pass " + credentialsPath
+	lines := strings.Split(strings.TrimRight(string(profile), "\n"), "\n")
+	found := false
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == "auth-user-pass" {
+			lines[i] = authUserPass
+			found = true
+		}
+	}
+	if !found {
+		lines = append(lines, authUserPass)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+func writeFileAtomic(path string, content []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
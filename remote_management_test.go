@@ -1,8 +1,13 @@
 package leaseweb
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +17,9 @@ func TestChangeCredentials(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
 		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		payload := map[string]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]string{"password": "new password"}, payload)
 		w.WriteHeader(http.StatusNoContent)
 	})
 	defer teardown()
@@ -153,6 +161,8 @@ func TestListProfilesPaginate(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "1", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
 		fmt.Fprintf(w, `{"_metadata":{"limit": 10, "offset": 1, "totalCount": 11}, "profiles": [
 			{
 				"datacenter": "AMS-02",
@@ -167,7 +177,7 @@ func TestListProfilesPaginate(t *testing.T) {
 	defer teardown()
 
 	remoteManagementApi := RemoteManagementApi{}
-	response, err := remoteManagementApi.ListProfiles(1)
+	response, err := remoteManagementApi.ListProfiles(1, 10)
 
 	assert := assert.New(t)
 	assert.Nil(err)
@@ -257,3 +267,298 @@ func TestTestListProfilesServerErrors(t *testing.T) {
 	}
 	assertServerErrorTests(t, serverErrorTests)
 }
+
+const testOpenVpnProfile = `client
+dev tun
+proto udp
+remote rmvpn-ams-01.leaseweb.net 1194
+auth-user-pass
+<ca>
+-----BEGIN CERTIFICATE-----
+-----END CERTIFICATE-----
+</ca>
+`
+
+func TestGetProfile(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		if r.URL.Path == "/bareMetals/v2/remoteManagement/profiles" {
+			fmt.Fprintf(w, `{"_metadata":{"limit": 50, "offset": 0, "totalCount": 1}, "profiles": [
+				{"datacenter": "AMS-01", "satelliteDatacenters": ["AMS-10"], "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn"}
+			]}`)
+			return
+		}
+		assert.Equal(t, "/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn", r.URL.Path)
+		w.Header().Set("Content-Type", "application/x-openvpn-profile")
+		fmt.Fprint(w, testOpenVpnProfile)
+	})
+	defer teardown()
+
+	profile, err := RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(string(profile), testOpenVpnProfile)
+}
+
+func TestGetProfileCanceled(t *testing.T) {
+	downloads := 0
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bareMetals/v2/remoteManagement/profiles" {
+			fmt.Fprintf(w, `{"_metadata":{"limit": 50, "offset": 0, "totalCount": 1}, "profiles": [
+				{"datacenter": "AMS-01", "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn"}
+			]}`)
+			return
+		}
+		downloads++
+		fmt.Fprint(w, testOpenVpnProfile)
+	})
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := RemoteManagementApi{}.GetProfile(ctx, "AMS-01")
+	assert := assert.New(t)
+	assert.ErrorIs(err, context.Canceled)
+	_, err = RemoteManagementApi{}.WriteOpenVpnConfig(ctx, Location{Site: "AMS-01"}, "12345678", "s3cr3t", t.TempDir())
+	assert.ErrorIs(err, context.Canceled)
+	assert.Equal(downloads, 0)
+}
+
+func TestGetProfileServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "403", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "403",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 404",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "404", "errorMessage": "Resource not found"}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "404",
+				ErrorMessage:  "Resource not found",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "500",
+				ErrorMessage:  "The API could not handle your request at this time.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "503", "errorMessage": "The API is not available at the moment."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return RemoteManagementApi{}.GetProfile(context.Background(), "AMS-01")
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "503",
+				ErrorMessage:  "The API is not available at the moment.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
+func TestDownloadProfile(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn", r.URL.Path)
+		w.Header().Set("Content-Type", "application/x-openvpn-profile")
+		w.Header().Set("Content-Disposition", `attachment; filename="lsw-rmvpn-AMS-01.ovpn"`)
+		fmt.Fprint(w, testOpenVpnProfile)
+	})
+	defer teardown()
+
+	profile := Profile{DataCenter: "AMS-01", File: "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn"}
+	download, err := RemoteManagementApi{}.DownloadProfile(context.Background(), profile)
+	assert := assert.New(t)
+	assert.Nil(err)
+	defer download.Close()
+	assert.Equal(download.ContentType, "application/x-openvpn-profile")
+	assert.Equal(download.Filename, "lsw-rmvpn-AMS-01.ovpn")
+	content, err := ioutil.ReadAll(download)
+	assert.Nil(err)
+	assert.Equal(string(content), testOpenVpnProfile)
+
+	download, err = RemoteManagementApi{}.DownloadProfile(context.Background(), Profile{DataCenter: "AMS-01"})
+	assert.Nil(download)
+	assert.Equal(err.Error(), "remote management profile of AMS-01 has no file")
+}
+
+func TestFindProfile(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "/bareMetals/v2/remoteManagement/profiles", r.URL.Path)
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprintf(w, `{"_metadata":{"limit": 1, "offset": 0, "totalCount": 2}, "profiles": [
+				{"datacenter": "AMS-01", "satelliteDatacenters": ["AMS-10", "AMS-11"], "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn"}
+			]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"_metadata":{"limit": 1, "offset": 1, "totalCount": 2}, "profiles": [
+			{"datacenter": "FRA-10", "satelliteDatacenters": ["FRA-11"], "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-FRA-10.ovpn"}
+		]}`)
+	})
+	defer teardown()
+
+	assert := assert.New(t)
+	profile, err := RemoteManagementApi{}.FindProfile("AMS-01")
+	assert.Nil(err)
+	assert.Equal(profile.DataCenter, "AMS-01")
+
+	profile, err = RemoteManagementApi{}.FindProfile("ams-11")
+	assert.Nil(err)
+	assert.Equal(profile.DataCenter, "AMS-01")
+
+	profile, err = RemoteManagementApi{}.FindProfile("FRA-11")
+	assert.Nil(err)
+	assert.Equal(profile.DataCenter, "FRA-10")
+
+	profile, err = RemoteManagementApi{}.FindProfile("SIN-01")
+	assert.Nil(profile)
+	assert.Equal(err.Error(), `no remote management profile found for site "SIN-01"`)
+}
+
+func TestWriteOpenVpnConfig(t *testing.T) {
+	passwordChanged := false
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		switch r.URL.Path {
+		case "/bareMetals/v2/remoteManagement/profiles":
+			fmt.Fprintf(w, `{"_metadata":{"limit": 50, "offset": 0, "totalCount": 1}, "profiles": [
+				{"datacenter": "AMS-01", "satelliteDatacenters": ["AMS-10"], "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01-v2.ovpn"}
+			]}`)
+		case "/bareMetals/v2/remoteManagement/changeCredentials":
+			assert.Equal(t, http.MethodPost, r.Method)
+			passwordChanged = true
+			w.WriteHeader(http.StatusNoContent)
+		case "/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01-v2.ovpn":
+			assert.False(t, passwordChanged)
+			fmt.Fprint(w, testOpenVpnProfile)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer teardown()
+
+	dir := filepath.Join(t.TempDir(), "vpn", "leaseweb")
+	config, err := RemoteManagementApi{}.WriteOpenVpnConfig(context.Background(), Location{Site: "AMS-10"}, "12345678", "s3cr3t", dir)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.True(passwordChanged)
+	assert.Equal(config.Profile.DataCenter, "AMS-01")
+	assert.Equal(config.ConfigPath, filepath.Join(dir, "lsw-rmvpn-AMS-01-v2.ovpn"))
+	assert.Equal(config.CredentialsPath, filepath.Join(dir, "lsw-rmvpn-AMS-01-v2.auth"))
+
+	info, err := os.Stat(dir)
+	assert.Nil(err)
+	assert.Equal(info.Mode().Perm(), os.FileMode(0700))
+
+	credentials, err := os.ReadFile(config.CredentialsPath)
+	assert.Nil(err)
+	assert.Equal(string(credentials), "12345678\ns3cr3t\n")
+	info, err = os.Stat(config.CredentialsPath)
+	assert.Nil(err)
+	assert.Equal(info.Mode().Perm(), os.FileMode(0600))
+
+	ovpn, err := os.ReadFile(config.ConfigPath)
+	assert.Nil(err)
+	assert.Contains(string(ovpn), "\nauth-user-pass "+config.CredentialsPath+"\n")
+	assert.NotContains(string(ovpn), "\nauth-user-pass\n")
+	assert.Contains(string(ovpn), "remote rmvpn-ams-01.leaseweb.net 1194\n")
+
+	entries, err := os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Equal(len(entries), 2)
+}
+
+func TestWriteOpenVpnConfigKeepsPasswordOnDownloadError(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bareMetals/v2/remoteManagement/profiles":
+			fmt.Fprintf(w, `{"_metadata":{"limit": 50, "offset": 0, "totalCount": 1}, "profiles": [
+				{"datacenter": "AMS-01", "satelliteDatacenters": ["AMS-10"], "file": "https://api.leaseweb.com/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn"}
+			]}`)
+		case "/bareMetals/v2/remoteManagement/profiles/lsw-rmvpn-AMS-01.ovpn":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "500", "errorMessage": "The API could not handle your request at this time."}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer teardown()
+
+	dir := t.TempDir()
+	config, err := RemoteManagementApi{}.WriteOpenVpnConfig(context.Background(), Location{Site: "AMS-01"}, "12345678", "s3cr3t", dir)
+	assert := assert.New(t)
+	assert.Nil(config)
+	assert.Equal(err.(*LeasewebError).ErrorCode, "500")
+	entries, err := os.ReadDir(dir)
+	assert.Nil(err)
+	assert.Empty(entries)
+}
+
+func TestWithOpenVpnCredentials(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(string(withOpenVpnCredentials([]byte("client\ndev tun\n"), "/tmp/vpn.auth")), "client\ndev tun\nauth-user-pass /tmp/vpn.auth\n")
+	assert.Equal(string(withOpenVpnCredentials([]byte("client\nauth-user-pass old.auth\n"), "/tmp/vpn.auth")), "client\nauth-user-pass /tmp/vpn.auth\n")
+}
@@ -30,6 +30,11 @@ func (le *LeasewebError) Error() string {
 	return le.ErrorMessage
 }
 
+func isNotFound(err error) bool {
+	lswErr, ok := err.(*LeasewebError)
+	return ok && lswErr.ErrorCode == "404"
+}
+
 func InitLeasewebClient(key string) {
 	lswClient = &leasewebClient{
 		client: &http.Client{},
@@ -37,6 +42,10 @@ func InitLeasewebClient(key string) {
 	}
 }
 
+func SetBaseUrl(baseUrl string) {
+	lswClient.baseUrl = strings.TrimSuffix(baseUrl, "/")
+}
+
 func getBaseUrl() string {
 	if lswClient.baseUrl != "" {
 		return lswClient.baseUrl
@@ -2,17 +2,20 @@ package leaseweb
 
 import (
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 var ctx = ctxT{}
 var testApiKey = "test-api-key"
+var testNow = time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC)
 
 type serverErrorTest struct {
 	Title         string
@@ -69,6 +72,28 @@ func setup(handler http.HandlerFunc) *httptest.Server {
 	return ts
 }
 
+func testClock() time.Time {
+	return testNow
+}
+
+func serveRoutes(t *testing.T, routes map[string]http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		route, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		route(w, r)
+	}
+}
+
+func respondWith(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}
+}
+
 func teardown() {
 	ctx.ts.Close()
 	lswClient.baseUrl = ctx.oldHost
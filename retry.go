@@ -0,0 +1,73 @@
+package leaseweb
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	delay      time.Duration
+	maxDelay   time.Duration
+}
+
+func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	delay := rt.delay
+	if delay <= 0 {
+		delay = DEFAULT_RETRY_DELAY
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := rt.transport.RoundTrip(req)
+		if attempt >= rt.maxRetries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := delay
+		if resp != nil {
+			if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+				wait = time.Duration(retryAfter) * time.Second
+			}
+			resp.Body.Close()
+		}
+		if rt.maxDelay > 0 && wait > rt.maxDelay {
+			wait = rt.maxDelay
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
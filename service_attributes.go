@@ -0,0 +1,198 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ServiceAttributes holds the product specific attributes of a service. The
+// object is split into its fields once when the service is decoded, so a
+// lookup does not parse the whole object again. The accessors still decode
+// the value of the field they are asked for.
+type ServiceAttributes struct {
+	raw    json.RawMessage
+	fields map[string]json.RawMessage
+}
+
+type DedicatedServerServiceAttributes struct {
+	Hostname             string   `json:"hostname"`
+	DataCenter           string   `json:"dataCenter"`
+	Rack                 string   `json:"rack"`
+	Chassis              string   `json:"chassis"`
+	Cpu                  string   `json:"cpu"`
+	Ram                  string   `json:"ram"`
+	Hdd                  string   `json:"hdd"`
+	PrivateVlan          bool     `json:"privateVlan"`
+	IpAddresses          []string `json:"ipAddresses"`
+	DataTrafficCommitted string   `json:"dataTrafficCommitted"`
+}
+
+type VirtualServerServiceAttributes struct {
+	Hostname        string   `json:"hostname"`
+	DataCenter      string   `json:"dataCenter"`
+	Cores           int      `json:"cores"`
+	Ram             string   `json:"ram"`
+	Storage         string   `json:"storage"`
+	OperatingSystem string   `json:"operatingSystem"`
+	IpAddresses     []string `json:"ipAddresses"`
+}
+
+type IpServiceAttributes struct {
+	Ip          string `json:"ip"`
+	Prefix      int    `json:"prefix"`
+	Version     int    `json:"version"`
+	EquipmentId string `json:"equipmentId"`
+	DataCenter  string `json:"dataCenter"`
+}
+
+func NewServiceAttributes(raw json.RawMessage) (ServiceAttributes, error) {
+	if len(raw) == 0 || bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return ServiceAttributes{}, nil
+	}
+	sa := ServiceAttributes{raw: append(json.RawMessage(nil), raw...)}
+	if err := json.Unmarshal(sa.raw, &sa.fields); err != nil {
+		return ServiceAttributes{}, fmt.Errorf("service attributes must be an object: %w", err)
+	}
+	return sa, nil
+}
+
+func (sa ServiceAttributes) MarshalJSON() ([]byte, error) {
+	if len(sa.raw) == 0 {
+		return []byte("{}"), nil
+	}
+	return sa.raw, nil
+}
+
+func (sa *ServiceAttributes) UnmarshalJSON(b []byte) error {
+	attributes, err := NewServiceAttributes(b)
+	if err != nil {
+		return err
+	}
+	*sa = attributes
+	return nil
+}
+
+func (sa ServiceAttributes) Raw() json.RawMessage {
+	return sa.raw
+}
+
+func (sa ServiceAttributes) Decode(v interface{}) error {
+	if len(sa.raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(sa.raw, v)
+}
+
+func (sa ServiceAttributes) Map() (map[string]json.RawMessage, error) {
+	result := make(map[string]json.RawMessage, len(sa.fields))
+	for key, value := range sa.fields {
+		result[key] = value
+	}
+	return result, nil
+}
+
+func (sa ServiceAttributes) Has(key string) bool {
+	_, ok := sa.lookup(key)
+	return ok
+}
+
+func (sa ServiceAttributes) String(key string) string {
+	value, ok := sa.lookup(key)
+	if !ok {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	return string(value)
+}
+
+func (sa ServiceAttributes) Int(key string) (int, error) {
+	value, ok := sa.lookup(key)
+	if !ok {
+		return 0, nil
+	}
+	var i int
+	if err := json.Unmarshal(value, &i); err == nil {
+		return i, nil
+	}
+	i, err := strconv.Atoi(sa.String(key))
+	if err != nil {
+		return 0, fmt.Errorf("service attribute %s is not an integer: %s", key, value)
+	}
+	return i, nil
+}
+
+func (sa ServiceAttributes) Bool(key string) (bool, error) {
+	value, ok := sa.lookup(key)
+	if !ok {
+		return false, nil
+	}
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	b, err := strconv.ParseBool(sa.String(key))
+	if err != nil {
+		return false, fmt.Errorf("service attribute %s is not a boolean: %s", key, value)
+	}
+	return b, nil
+}
+
+func (sa ServiceAttributes) Strings(key string) ([]string, error) {
+	value, ok := sa.lookup(key)
+	if !ok {
+		return nil, nil
+	}
+	var result []string
+	if err := json.Unmarshal(value, &result); err != nil {
+		return []string{sa.String(key)}, nil
+	}
+	return result, nil
+}
+
+func (sa ServiceAttributes) lookup(key string) (json.RawMessage, bool) {
+	value, ok := sa.fields[key]
+	if !ok || bytes.Equal(value, []byte("null")) {
+		return nil, false
+	}
+	return value, true
+}
+
+func (s Service) DedicatedServerAttributes() (*DedicatedServerServiceAttributes, error) {
+	result := &DedicatedServerServiceAttributes{}
+	if err := s.decodeAttributes("DEDICATED_SERVER", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s Service) VirtualServerAttributes() (*VirtualServerServiceAttributes, error) {
+	result := &VirtualServerServiceAttributes{}
+	if err := s.decodeAttributes("VIRTUAL_SERVER", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s Service) IpAttributes() (*IpServiceAttributes, error) {
+	result := &IpServiceAttributes{}
+	if err := s.decodeAttributes("IP", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s Service) decodeAttributes(productType string, v interface{}) error {
+	if !strings.HasPrefix(strings.ToUpper(s.ProductId), productType) {
+		return fmt.Errorf("service %s is a %s, not a %s", s.Id, s.ProductId, productType)
+	}
+	if err := s.Attributes.Decode(v); err != nil {
+		return fmt.Errorf("cannot decode %s attributes of service %s: %w", productType, s.Id, err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAttributes(t *testing.T) {
+	var service Service
+	err := json.Unmarshal([]byte(`{"id": "1001", "attributes": {
+		"hostname": "web-01", "cores": 8, "ram": "32", "managed": true, "backup": "false",
+		"ips": ["192.0.2.1", "192.0.2.2"], "domainName": "example.com", "location": null
+	}}`), &service)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.True(service.Attributes.Has("hostname"))
+	assert.False(service.Attributes.Has("location"))
+	assert.False(service.Attributes.Has("missing"))
+	assert.Equal(service.Attributes.String("hostname"), "web-01")
+	assert.Equal(service.Attributes.String("cores"), "8")
+	assert.Equal(service.Attributes.String("missing"), "")
+
+	cores, err := service.Attributes.Int("cores")
+	assert.Nil(err)
+	assert.Equal(cores, 8)
+	ram, err := service.Attributes.Int("ram")
+	assert.Nil(err)
+	assert.Equal(ram, 32)
+	_, err = service.Attributes.Int("hostname")
+	assert.Equal(err.Error(), `service attribute hostname is not an integer: "web-01"`)
+
+	managed, err := service.Attributes.Bool("managed")
+	assert.Nil(err)
+	assert.True(managed)
+	backup, err := service.Attributes.Bool("backup")
+	assert.Nil(err)
+	assert.False(backup)
+
+	ips, err := service.Attributes.Strings("ips")
+	assert.Nil(err)
+	assert.Equal(ips, []string{"192.0.2.1", "192.0.2.2"})
+	domains, err := service.Attributes.Strings("domainName")
+	assert.Nil(err)
+	assert.Equal(domains, []string{"example.com"})
+
+	var domain struct {
+		DomainName string `json:"domainName"`
+	}
+	assert.Nil(service.Attributes.Decode(&domain))
+	assert.Equal(domain.DomainName, "example.com")
+	assert.Contains(string(service.Attributes.Raw()), `"hostname": "web-01"`)
+}
+
+func TestServiceAttributesEmpty(t *testing.T) {
+	var service Service
+	err := json.Unmarshal([]byte(`{"id": "1001", "attributes": null}`), &service)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Empty(service.Attributes)
+	assert.Equal(service.Attributes.String("hostname"), "")
+	attributes, err := service.Attributes.Map()
+	assert.Nil(err)
+	assert.Empty(attributes)
+
+	b, err := json.Marshal(Service{Id: "1001"})
+	assert.Nil(err)
+	assert.Contains(string(b), `"attributes":{}`)
+
+	service.Attributes, err = NewServiceAttributes(json.RawMessage(`{"hostname":"web-01"}`))
+	assert.Nil(err)
+	b, err = json.Marshal(service)
+	assert.Nil(err)
+	assert.Contains(string(b), `"attributes":{"hostname":"web-01"}`)
+}
+
+func TestServiceAttributesNotAnObject(t *testing.T) {
+	_, err := NewServiceAttributes(json.RawMessage(`["web-01"]`))
+	assert := assert.New(t)
+	assert.Contains(err.Error(), "service attributes must be an object")
+
+	var service Service
+	err = json.Unmarshal([]byte(`{"id": "1001", "attributes": "web-01"}`), &service)
+	assert.Contains(err.Error(), "service attributes must be an object")
+}
+
+func TestServiceTypedAttributes(t *testing.T) {
+	var services Services
+	err := json.Unmarshal([]byte(`{"services": [
+		{"id": "1001", "productId": "DEDICATED_SERVER", "attributes": {
+			"hostname": "web-01.example.com", "dataCenter": "AMS-01", "rack": "22", "chassis": "Dell PowerEdge R210 II",
+			"cpu": "Intel Xeon E3-1220", "ram": "16GB DDR3", "hdd": "2x1TB SATA2", "privateVlan": true,
+			"ipAddresses": ["192.0.2.10", "2001:db8::10"], "dataTrafficCommitted": "100 TB"
+		}},
+		{"id": "1002", "productId": "VIRTUAL_SERVER", "attributes": {
+			"hostname": "vps-01.example.com", "dataCenter": "FRA-10", "cores": 2, "ram": "4096 MB",
+			"storage": "80 GB SSD", "operatingSystem": "UBUNTU_22_04_64BIT", "ipAddresses": ["198.51.100.20"]
+		}},
+		{"id": "1003", "productId": "IP_RANGE", "attributes": {
+			"ip": "203.0.113.0", "prefix": 29, "version": 4, "equipmentId": "12345", "dataCenter": "AMS-01"
+		}}
+	]}`), &services)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	dedicatedServer, err := services.Services[0].DedicatedServerAttributes()
+	assert.Nil(err)
+	assert.Equal(dedicatedServer, &DedicatedServerServiceAttributes{
+		Hostname:             "web-01.example.com",
+		DataCenter:           "AMS-01",
+		Rack:                 "22",
+		Chassis:              "Dell PowerEdge R210 II",
+		Cpu:                  "Intel Xeon E3-1220",
+		Ram:                  "16GB DDR3",
+		Hdd:                  "2x1TB SATA2",
+		PrivateVlan:          true,
+		IpAddresses:          []string{"192.0.2.10", "2001:db8::10"},
+		DataTrafficCommitted: "100 TB",
+	})
+
+	virtualServer, err := services.Services[1].VirtualServerAttributes()
+	assert.Nil(err)
+	assert.Equal(virtualServer, &VirtualServerServiceAttributes{
+		Hostname:        "vps-01.example.com",
+		DataCenter:      "FRA-10",
+		Cores:           2,
+		Ram:             "4096 MB",
+		Storage:         "80 GB SSD",
+		OperatingSystem: "UBUNTU_22_04_64BIT",
+		IpAddresses:     []string{"198.51.100.20"},
+	})
+
+	ip, err := services.Services[2].IpAttributes()
+	assert.Nil(err)
+	assert.Equal(ip, &IpServiceAttributes{Ip: "203.0.113.0", Prefix: 29, Version: 4, EquipmentId: "12345", DataCenter: "AMS-01"})
+
+	_, err = services.Services[1].DedicatedServerAttributes()
+	assert.Equal(err.Error(), "service 1002 is a VIRTUAL_SERVER, not a DEDICATED_SERVER")
+
+	attributes, err := NewServiceAttributes(json.RawMessage(`{"prefix": "29"}`))
+	assert.Nil(err)
+	service := Service{Id: "1004", ProductId: "IP_ADDRESSES", Attributes: attributes}
+	_, err = service.IpAttributes()
+	assert.Contains(err.Error(), "cannot decode IP attributes of service 1004")
+}
@@ -0,0 +1,234 @@
+package leaseweb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	CANCELLATION_ACTION_DRY_RUN      = "DRY_RUN"
+	CANCELLATION_ACTION_CANCEL       = "CANCEL"
+	CANCELLATION_ACTION_REJECTED     = "REJECTED"
+	CANCELLATION_ACTION_UNCANCEL     = "UNCANCEL"
+	DEFAULT_CANCELLATION_UNDO_WINDOW = 24 * time.Hour
+	CANCELLATION_CONFIRMATION_LENGTH = 8
+)
+
+type CancellationOptions struct {
+	Service           ServicesService
+	Reason            string
+	ReasonCode        string
+	DryRun            bool
+	ConfirmationToken string
+	Approve           func(plan *CancellationPlan) (bool, error)
+	Actor             string
+	Audit             io.Writer
+	UndoWindow        time.Duration
+	Now               func() time.Time
+}
+
+type CancellationPlan struct {
+	Service           Service
+	Reason            string
+	ReasonCode        string
+	ReasonDescription string
+	MonthlyPrice      Money
+	ConfirmationToken string
+}
+
+type CancellationRecord struct {
+	Time         Time   `json:"time"`
+	Action       string `json:"action"`
+	Actor        string `json:"actor,omitempty"`
+	ServiceId    string `json:"serviceId"`
+	ContractId   string `json:"contractId"`
+	EquipmentId  string `json:"equipmentId"`
+	ProductId    string `json:"productId"`
+	Reference    string `json:"reference"`
+	ReasonCode   string `json:"reasonCode"`
+	Reason       string `json:"reason"`
+	MonthlyPrice Money  `json:"monthlyPrice"`
+	Currency     string `json:"currency"`
+	UndoUntil    Time   `json:"undoUntil"`
+}
+
+func (co *CancellationOptions) setDefaults() {
+	if co.Service == nil {
+		co.Service = ServicesApi{}
+	}
+	if co.UndoWindow <= 0 {
+		co.UndoWindow = DEFAULT_CANCELLATION_UNDO_WINDOW
+	}
+	if co.Now == nil {
+		co.Now = time.Now
+	}
+}
+
+func PlanCancellation(id string, options CancellationOptions) (*CancellationPlan, error) {
+	options.setDefaults()
+
+	service, err := options.Service.GetService(id)
+	if err != nil {
+		return nil, err
+	}
+	if !service.Cancellable {
+		return nil, fmt.Errorf("service %s cannot be cancelled", service.Id)
+	}
+
+	reasons, err := options.Service.ListCancellationReasons()
+	if err != nil {
+		return nil, err
+	}
+	plan := &CancellationPlan{Service: *service, Reason: options.Reason, ReasonCode: options.ReasonCode}
+	for _, reason := range reasons.CancellationReasons {
+		if reason.ReasonCode == options.ReasonCode {
+			plan.ReasonDescription = reason.Reason
+		}
+	}
+	if plan.ReasonDescription == "" {
+		codes := make([]string, len(reasons.CancellationReasons))
+		for i, reason := range reasons.CancellationReasons {
+			codes[i] = reason.ReasonCode
+		}
+		return nil, fmt.Errorf("unknown cancellation reason code %q, expected one of %s", options.ReasonCode, strings.Join(codes, ", "))
+	}
+
+	months := parseRenewalPeriod(service.BillingCycle)
+	if months <= 0 {
+		months = 1
+	}
+	if plan.MonthlyPrice, err = service.PricePerFrequency.Div(int64(months)); err != nil {
+		return nil, err
+	}
+	plan.ConfirmationToken = cancellationConfirmationToken(service)
+	return plan, nil
+}
+
+func CancelServiceGuarded(id string, options CancellationOptions) (*CancellationRecord, error) {
+	options.setDefaults()
+
+	plan, err := PlanCancellation(id, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.DryRun {
+		record := plan.record(CANCELLATION_ACTION_DRY_RUN, options)
+		return record, writeCancellationAudit(options.Audit, record)
+	}
+
+	switch {
+	case options.ConfirmationToken != "":
+		if options.ConfirmationToken != plan.ConfirmationToken {
+			return nil, fmt.Errorf("confirmation token %q does not match service %s", options.ConfirmationToken, plan.Service.Id)
+		}
+	case options.Approve != nil:
+		approved, err := options.Approve(plan)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			record := plan.record(CANCELLATION_ACTION_REJECTED, options)
+			if err = writeCancellationAudit(options.Audit, record); err != nil {
+				return record, err
+			}
+			return record, fmt.Errorf("cancellation of service %s was not approved", plan.Service.Id)
+		}
+	default:
+		return nil, fmt.Errorf("cancellation of service %s requires a confirmation token or approval", plan.Service.Id)
+	}
+
+	if err = options.Service.CancelService(plan.Service.Id, plan.Reason, plan.ReasonCode); err != nil {
+		return nil, err
+	}
+	record := plan.record(CANCELLATION_ACTION_CANCEL, options)
+	record.UndoUntil = NewTime(record.Time.Add(options.UndoWindow))
+	return record, writeCancellationAudit(options.Audit, record)
+}
+
+func UndoCancellation(record *CancellationRecord, options CancellationOptions) (*CancellationRecord, error) {
+	options.setDefaults()
+
+	if record.Action != CANCELLATION_ACTION_CANCEL {
+		return nil, fmt.Errorf("cannot undo %s of service %s", record.Action, record.ServiceId)
+	}
+	now := options.Now()
+	if now.After(record.UndoUntil.Time) {
+		return nil, fmt.Errorf("undo window for service %s closed at %s", record.ServiceId, record.UndoUntil)
+	}
+	service, err := options.Service.GetService(record.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+	if !service.Uncancellable {
+		return nil, fmt.Errorf("service %s cannot be uncancelled", record.ServiceId)
+	}
+	if err = options.Service.UncancelService(record.ServiceId); err != nil {
+		return nil, err
+	}
+
+	undo := *record
+	undo.Time = NewTime(now)
+	undo.Action = CANCELLATION_ACTION_UNCANCEL
+	undo.Actor = options.Actor
+	undo.UndoUntil = Time{}
+	return &undo, writeCancellationAudit(options.Audit, &undo)
+}
+
+func (cp *CancellationPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service:   %s (%s)\n", cp.Service.Id, cp.Service.ProductId)
+	fmt.Fprintf(&b, "Contract:  %s\n", cp.Service.ContractId)
+	if cp.Service.EquipmentId != "" {
+		fmt.Fprintf(&b, "Equipment: %s\n", cp.Service.EquipmentId)
+	}
+	if cp.Service.Reference != "" {
+		fmt.Fprintf(&b, "Reference: %s\n", cp.Service.Reference)
+	}
+	fmt.Fprintf(&b, "Price:     %s %s per month\n", cp.MonthlyPrice.Format(2), cp.Service.Currency)
+	fmt.Fprintf(&b, "Reason:    %s (%s)", cp.ReasonCode, cp.ReasonDescription)
+	if cp.Reason != "" {
+		fmt.Fprintf(&b, ": %s", cp.Reason)
+	}
+	fmt.Fprintf(&b, "\nToken:     %s\n", cp.ConfirmationToken)
+	return b.String()
+}
+
+func (cp *CancellationPlan) record(action string, options CancellationOptions) *CancellationRecord {
+	return &CancellationRecord{
+		Time:         NewTime(options.Now()),
+		Action:       action,
+		Actor:        options.Actor,
+		ServiceId:    cp.Service.Id,
+		ContractId:   cp.Service.ContractId,
+		EquipmentId:  cp.Service.EquipmentId,
+		ProductId:    cp.Service.ProductId,
+		Reference:    cp.Service.Reference,
+		ReasonCode:   cp.ReasonCode,
+		Reason:       cp.Reason,
+		MonthlyPrice: cp.MonthlyPrice,
+		Currency:     cp.Service.Currency,
+	}
+}
+
+func cancellationConfirmationToken(service *Service) string {
+	sum := sha256.Sum256([]byte(service.Id + "\x00" + service.ContractId + "\x00" + service.EquipmentId))
+	return hex.EncodeToString(sum[:])[:CANCELLATION_CONFIRMATION_LENGTH]
+}
+
+func writeCancellationAudit(w io.Writer, record *CancellationRecord) error {
+	if w == nil {
+		return nil
+	}
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
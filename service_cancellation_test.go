@@ -0,0 +1,214 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingServicesService struct {
+	ServicesApi
+	services    map[string]*Service
+	cancelled   []string
+	uncancelled []string
+}
+
+func newRecordingServicesService() *recordingServicesService {
+	return &recordingServicesService{services: map[string]*Service{
+		"1001": {Id: "1001", ContractId: "C1001", EquipmentId: "12345", ProductId: "DEDICATED_SERVER", Reference: "web-01",
+			BillingCycle: "3 MONTHS", PricePerFrequency: MustMoney("300", "EUR"), Currency: "EUR", Cancellable: true},
+		"1002": {Id: "1002", ContractId: "C1002", ProductId: "IP_ADDRESSES", BillingCycle: "1 MONTH", Cancellable: false, Uncancellable: true},
+	}}
+}
+
+func (rss *recordingServicesService) GetService(id string) (*Service, error) {
+	if service, ok := rss.services[id]; ok {
+		return service, nil
+	}
+	return nil, &LeasewebError{ErrorCode: "404", ErrorMessage: "Resource not found"}
+}
+
+func (rss *recordingServicesService) ListCancellationReasons() (*CancellationReasons, error) {
+	return &CancellationReasons{CancellationReasons: []CancellationReason{
+		{Reason: "I no longer need it", ReasonCode: "CANCEL_NO_NEED"},
+		{Reason: "I was using it for trial only", ReasonCode: "CANCEL_TRIAL_ONLY"},
+	}}, nil
+}
+
+func (rss *recordingServicesService) CancelService(id, reason, reasonCode string) error {
+	rss.cancelled = append(rss.cancelled, id)
+	rss.services[id].Cancellable = false
+	rss.services[id].Uncancellable = true
+	return nil
+}
+
+func (rss *recordingServicesService) UncancelService(id string) error {
+	rss.uncancelled = append(rss.uncancelled, id)
+	rss.services[id].Cancellable = true
+	rss.services[id].Uncancellable = false
+	return nil
+}
+
+func testCancellationOptions(service ServicesService, audit io.Writer) CancellationOptions {
+	return CancellationOptions{
+		Service:    service,
+		Reason:     "Migrated to new rack",
+		ReasonCode: "CANCEL_NO_NEED",
+		Actor:      "finance@example.com",
+		Audit:      audit,
+		Now:        testClock,
+	}
+}
+
+func TestPlanCancellation(t *testing.T) {
+	plan, err := PlanCancellation("1001", testCancellationOptions(newRecordingServicesService(), nil))
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(plan.Service.Id, "1001")
+	assert.Equal(plan.ReasonDescription, "I no longer need it")
+	assert.Equal(plan.MonthlyPrice, MustMoney("100", "EUR"))
+	assert.Equal(len(plan.ConfirmationToken), CANCELLATION_CONFIRMATION_LENGTH)
+	assert.Equal(plan.String(), `Service:   1001 (DEDICATED_SERVER)
+Contract:  C1001
+Equipment: 12345
+Reference: web-01
+Price:     100.00 EUR per month
+Reason:    CANCEL_NO_NEED (I no longer need it): Migrated to new rack
+Token:     `+plan.ConfirmationToken+"\n")
+}
+
+func TestPlanCancellationValidation(t *testing.T) {
+	service := newRecordingServicesService()
+	assert := assert.New(t)
+
+	_, err := PlanCancellation("1002", testCancellationOptions(service, nil))
+	assert.Equal(err.Error(), "service 1002 cannot be cancelled")
+
+	options := testCancellationOptions(service, nil)
+	options.ReasonCode = "CANCEL_NO_NED"
+	_, err = PlanCancellation("1001", options)
+	assert.Equal(err.Error(), `unknown cancellation reason code "CANCEL_NO_NED", expected one of CANCEL_NO_NEED, CANCEL_TRIAL_ONLY`)
+
+	_, err = PlanCancellation("1010", testCancellationOptions(service, nil))
+	assert.True(isNotFound(err))
+	assert.Empty(service.cancelled)
+}
+
+func TestCancelServiceGuardedDryRun(t *testing.T) {
+	service := newRecordingServicesService()
+	var audit bytes.Buffer
+	options := testCancellationOptions(service, &audit)
+	options.DryRun = true
+
+	record, err := CancelServiceGuarded("1001", options)
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(record.Action, CANCELLATION_ACTION_DRY_RUN)
+	assert.True(record.UndoUntil.IsZero())
+	assert.Empty(service.cancelled)
+	assert.Equal(audit.String(), `{"time":"2023-03-10T12:00:00Z","action":"DRY_RUN","actor":"finance@example.com","serviceId":"1001","contractId":"C1001",`+
+		`"equipmentId":"12345","productId":"DEDICATED_SERVER","reference":"web-01","reasonCode":"CANCEL_NO_NEED","reason":"Migrated to new rack",`+
+		`"monthlyPrice":100.00,"currency":"EUR","undoUntil":null}`+"\n")
+}
+
+func TestCancelServiceGuardedRequiresConfirmation(t *testing.T) {
+	service := newRecordingServicesService()
+	assert := assert.New(t)
+
+	_, err := CancelServiceGuarded("1001", testCancellationOptions(service, nil))
+	assert.Equal(err.Error(), "cancellation of service 1001 requires a confirmation token or approval")
+
+	options := testCancellationOptions(service, nil)
+	options.ConfirmationToken = "deadbeef"
+	_, err = CancelServiceGuarded("1001", options)
+	assert.Equal(err.Error(), `confirmation token "deadbeef" does not match service 1001`)
+	assert.Empty(service.cancelled)
+}
+
+func TestCancelServiceGuardedWithToken(t *testing.T) {
+	service := newRecordingServicesService()
+	var audit bytes.Buffer
+	options := testCancellationOptions(service, &audit)
+	plan, err := PlanCancellation("1001", options)
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	options.ConfirmationToken = plan.ConfirmationToken
+	record, err := CancelServiceGuarded("1001", options)
+	assert.Nil(err)
+	assert.Equal(service.cancelled, []string{"1001"})
+	assert.Equal(record.Action, CANCELLATION_ACTION_CANCEL)
+	assert.True(record.UndoUntil.Equal(testNow.Add(DEFAULT_CANCELLATION_UNDO_WINDOW)))
+
+	var logged CancellationRecord
+	assert.Nil(json.Unmarshal(audit.Bytes(), &logged))
+	assert.Equal(logged.Action, CANCELLATION_ACTION_CANCEL)
+	assert.Equal(logged.UndoUntil.String(), "2023-03-11T12:00:00Z")
+}
+
+func TestCancelServiceGuardedWithApproval(t *testing.T) {
+	service := newRecordingServicesService()
+	var audit bytes.Buffer
+	options := testCancellationOptions(service, &audit)
+	var approved *CancellationPlan
+	options.Approve = func(plan *CancellationPlan) (bool, error) {
+		approved = plan
+		return false, nil
+	}
+
+	record, err := CancelServiceGuarded("1001", options)
+	assert := assert.New(t)
+	assert.Equal(err.Error(), "cancellation of service 1001 was not approved")
+	assert.Equal(record.Action, CANCELLATION_ACTION_REJECTED)
+	assert.Equal(approved.Service.EquipmentId, "12345")
+	assert.Empty(service.cancelled)
+	assert.Contains(audit.String(), `"action":"REJECTED"`)
+
+	options.Approve = func(plan *CancellationPlan) (bool, error) { return false, errors.New("prompt closed") }
+	_, err = CancelServiceGuarded("1001", options)
+	assert.Equal(err.Error(), "prompt closed")
+
+	options.Approve = func(plan *CancellationPlan) (bool, error) { return true, nil }
+	record, err = CancelServiceGuarded("1001", options)
+	assert.Nil(err)
+	assert.Equal(record.Action, CANCELLATION_ACTION_CANCEL)
+	assert.Equal(service.cancelled, []string{"1001"})
+}
+
+func TestUndoCancellation(t *testing.T) {
+	service := newRecordingServicesService()
+	var audit bytes.Buffer
+	options := testCancellationOptions(service, &audit)
+	options.Approve = func(plan *CancellationPlan) (bool, error) { return true, nil }
+	options.UndoWindow = time.Hour
+
+	record, err := CancelServiceGuarded("1001", options)
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	options.Now = func() time.Time { return testNow.Add(30 * time.Minute) }
+	undo, err := UndoCancellation(record, options)
+	assert.Nil(err)
+	assert.Equal(undo.Action, CANCELLATION_ACTION_UNCANCEL)
+	assert.Equal(undo.ServiceId, "1001")
+	assert.Equal(service.uncancelled, []string{"1001"})
+	assert.Equal(strings.Count(audit.String(), "\n"), 2)
+
+	_, err = UndoCancellation(undo, options)
+	assert.Equal(err.Error(), "cannot undo UNCANCEL of service 1001")
+
+	_, err = UndoCancellation(record, options)
+	assert.Equal(err.Error(), "service 1001 cannot be uncancelled")
+	assert.Equal(service.uncancelled, []string{"1001"})
+
+	options.Now = func() time.Time { return testNow.Add(2 * time.Hour) }
+	_, err = UndoCancellation(record, options)
+	assert.Equal(err.Error(), "undo window for service 1001 closed at 2023-03-10T13:00:00Z")
+	assert.Equal(service.uncancelled, []string{"1001"})
+}
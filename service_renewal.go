@@ -0,0 +1,223 @@
+package leaseweb
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_RENEWAL_NOTICE_PERIOD = 30 * 24 * time.Hour
+	RENEWAL_DATE_LAYOUT           = "2006-01-02"
+)
+
+var RENEWAL_CSV_HEADER = []string{
+	"serviceId", "contractId", "equipmentId", "productId", "reference", "contractTerm", "billingCycle",
+	"contractTermEndDate", "renewalDate", "cancelBy", "daysLeft", "pastNotice", "pricePerFrequency", "currency",
+}
+
+var renewalPeriodPattern = regexp.MustCompile(`(?i)^\s*(\d+)\s*(MONTH|YEAR)S?\s*$`)
+
+type RenewalPlanOptions struct {
+	Service      ServicesService
+	NoticePeriod time.Duration
+	Horizon      time.Duration
+	Now          func() time.Time
+}
+
+type RenewalPlan struct {
+	GeneratedAt time.Time
+	Renewals    []ServiceRenewal
+}
+
+type ServiceRenewal struct {
+	Service     Service
+	RenewalDate time.Time
+	CancelBy    time.Time
+	DaysLeft    int
+	PastNotice  bool
+}
+
+func PlanRenewals(options RenewalPlanOptions) (*RenewalPlan, error) {
+	if options.Service == nil {
+		options.Service = ServicesApi{}
+	}
+	if options.NoticePeriod <= 0 {
+		options.NoticePeriod = DEFAULT_RENEWAL_NOTICE_PERIOD
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+
+	services, err := listAllServices(options.Service, ServiceFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := options.Now()
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	plan := &RenewalPlan{GeneratedAt: now}
+	for _, service := range services {
+		renewal, ok := planServiceRenewal(service, today, options.NoticePeriod)
+		if !ok {
+			continue
+		}
+		if options.Horizon > 0 && renewal.RenewalDate.Sub(today) > options.Horizon {
+			continue
+		}
+		plan.Renewals = append(plan.Renewals, renewal)
+	}
+	sort.SliceStable(plan.Renewals, func(i, j int) bool {
+		return plan.Renewals[i].CancelBy.Before(plan.Renewals[j].CancelBy)
+	})
+	return plan, nil
+}
+
+func (rp *RenewalPlan) PastNotice() []ServiceRenewal {
+	var result []ServiceRenewal
+	for _, renewal := range rp.Renewals {
+		if renewal.PastNotice {
+			result = append(result, renewal)
+		}
+	}
+	return result
+}
+
+func (rp *RenewalPlan) WriteCsv(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(RENEWAL_CSV_HEADER); err != nil {
+		return err
+	}
+	for _, renewal := range rp.Renewals {
+		service := renewal.Service
+		record := []string{
+			service.Id,
+			service.ContractId,
+			service.EquipmentId,
+			service.ProductId,
+			service.Reference,
+			service.ContractTerm,
+			service.BillingCycle,
+			service.ContractTermEndDate.DateString(),
+			renewal.RenewalDate.Format(RENEWAL_DATE_LAYOUT),
+			renewal.CancelBy.Format(RENEWAL_DATE_LAYOUT),
+			strconv.Itoa(renewal.DaysLeft),
+			strconv.FormatBool(renewal.PastNotice),
+			service.PricePerFrequency.Format(2),
+			service.Currency,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (rp *RenewalPlan) WriteIcs(w io.Writer) error {
+	var b strings.Builder
+	writeIcsLine(&b, "BEGIN:VCALENDAR")
+	writeIcsLine(&b, "VERSION:2.0")
+	writeIcsLine(&b, "PRODID:-//leaseweb-go-sdk//renewal planner//EN")
+	writeIcsLine(&b, "CALSCALE:GREGORIAN")
+	for _, renewal := range rp.Renewals {
+		service := renewal.Service
+		summary := "Last day to cancel service " + service.Id
+		if service.Reference != "" {
+			summary += " (" + service.Reference + ")"
+		}
+		if renewal.PastNotice {
+			summary = "Notice period passed for service " + service.Id
+		}
+		description := fmt.Sprintf("Service %s renews on %s for %s.\nContract: %s\nEquipment: %s\nProduct: %s\nPrice: %s %s",
+			service.Id, renewal.RenewalDate.Format(RENEWAL_DATE_LAYOUT), service.ContractTerm,
+			service.ContractId, service.EquipmentId, service.ProductId, service.PricePerFrequency.Format(2), service.Currency)
+
+		writeIcsLine(&b, "BEGIN:VEVENT")
+		writeIcsLine(&b, "UID:"+service.Id+"-"+renewal.RenewalDate.Format("20060102")+"@leaseweb-go-sdk")
+		writeIcsLine(&b, "DTSTAMP:"+rp.GeneratedAt.UTC().Format("20060102T150405Z"))
+		writeIcsLine(&b, "DTSTART;VALUE=DATE:"+renewal.CancelBy.Format("20060102"))
+		writeIcsLine(&b, "DTEND;VALUE=DATE:"+renewal.CancelBy.AddDate(0, 0, 1).Format("20060102"))
+		writeIcsLine(&b, "SUMMARY:"+escapeIcsText(summary))
+		writeIcsLine(&b, "DESCRIPTION:"+escapeIcsText(description))
+		writeIcsLine(&b, "TRANSP:TRANSPARENT")
+		writeIcsLine(&b, "END:VEVENT")
+	}
+	writeIcsLine(&b, "END:VCALENDAR")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func planServiceRenewal(service Service, today time.Time, noticePeriod time.Duration) (ServiceRenewal, bool) {
+	if service.ContractTermEndDate.IsZero() || service.Uncancellable || !service.EndDate.IsZero() {
+		return ServiceRenewal{}, false
+	}
+
+	year, month, day := service.ContractTermEndDate.Time.Date()
+	termEnd := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	months := parseRenewalPeriod(service.ContractTerm)
+	if months == 0 {
+		months = parseRenewalPeriod(service.BillingCycle)
+	}
+
+	renewalDate := termEnd
+	for i := 1; months > 0 && !renewalDate.After(today); i++ {
+		renewalDate = addMonthsClamped(termEnd, i*months)
+	}
+	if !renewalDate.After(today) {
+		return ServiceRenewal{}, false
+	}
+
+	cancelBy := renewalDate.Add(-noticePeriod)
+	return ServiceRenewal{
+		Service:     service,
+		RenewalDate: renewalDate,
+		CancelBy:    cancelBy,
+		DaysLeft:    int(cancelBy.Sub(today).Hours() / 24),
+		PastNotice:  cancelBy.Before(today),
+	}, true
+}
+
+func parseRenewalPeriod(period string) int {
+	match := renewalPeriodPattern.FindStringSubmatch(period)
+	if match == nil {
+		return 0
+	}
+	count, _ := strconv.Atoi(match[1])
+	if strings.EqualFold(match[2], "YEAR") {
+		return count * 12
+	}
+	return count
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+func escapeIcsText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
+
+func writeIcsLine(b *strings.Builder, line string) {
+	for limit := 75; len(line) > limit; limit = 74 {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+	}
+	b.WriteString(line + "\r\n")
+}
@@ -0,0 +1,203 @@
+package leaseweb
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRenewals(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /services/v1/services": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+			fmt.Fprint(w, `{"metadata": {"limit": 50, "offset": 0, "totalCount": 6}, "services": [
+				{"id": "1001", "contractId": "C1001", "equipmentId": "12345", "productId": "DEDICATED_SERVER", "reference": "web-01",
+					"contractTerm": "1 YEAR", "billingCycle": "1 MONTH", "contractTermEndDate": "2023-04-30T00:00:00+00:00",
+					"pricePerFrequency": 99.5, "currency": "EUR", "cancellable": true, "uncancellable": false},
+				{"id": "1002", "contractId": "C1002", "productId": "DEDICATED_SERVER", "contractTerm": "12 MONTHS", "billingCycle": "1 MONTH",
+					"contractTermEndDate": "2023-03-31T00:00:00+00:00", "pricePerFrequency": 49, "currency": "EUR", "cancellable": true},
+				{"id": "1003", "contractId": "C1003", "productId": "IP_ADDRESSES", "contractTerm": "0 MONTHS", "billingCycle": "3 MONTHS",
+					"contractTermEndDate": "2022-11-30T00:00:00+00:00", "pricePerFrequency": 12, "currency": "EUR", "cancellable": true},
+				{"id": "1004", "contractId": "C1004", "productId": "DEDICATED_SERVER", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2023-04-01T00:00:00+00:00", "endDate": "2023-04-01T00:00:00+00:00", "cancellable": false, "uncancellable": true},
+				{"id": "1005", "contractId": "C1005", "productId": "SUPPORT", "contractTerm": "1 MONTH"},
+				{"id": "1006", "contractId": "C1006", "productId": "COLOCATION", "reference": "rack, row 3", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2024-01-31T00:00:00+00:00", "pricePerFrequency": 500, "currency": "EUR", "cancellable": true}
+			]}`)
+		},
+	}))
+	defer teardown()
+
+	plan, err := PlanRenewals(RenewalPlanOptions{Now: testClock})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(plan.Renewals), 4)
+
+	expected := []struct {
+		id, renewalDate, cancelBy string
+		daysLeft                  int
+		pastNotice                bool
+	}{
+		{"1002", "2023-03-31", "2023-03-01", -9, true},
+		{"1001", "2023-04-30", "2023-03-31", 21, false},
+		{"1003", "2023-05-30", "2023-04-30", 51, false},
+		{"1006", "2024-01-31", "2024-01-01", 297, false},
+	}
+	for i, e := range expected {
+		renewal := plan.Renewals[i]
+		assert.Equal(renewal.Service.Id, e.id)
+		assert.Equal(renewal.RenewalDate.Format(RENEWAL_DATE_LAYOUT), e.renewalDate)
+		assert.Equal(renewal.CancelBy.Format(RENEWAL_DATE_LAYOUT), e.cancelBy)
+		assert.Equal(renewal.DaysLeft, e.daysLeft)
+		assert.Equal(renewal.PastNotice, e.pastNotice)
+	}
+	assert.Equal(len(plan.PastNotice()), 1)
+	assert.Equal(plan.PastNotice()[0].Service.Id, "1002")
+}
+
+func TestPlanRenewalsHorizonAndNoticePeriod(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /services/v1/services": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+			fmt.Fprint(w, `{"metadata": {"limit": 50, "offset": 0, "totalCount": 6}, "services": [
+				{"id": "1001", "contractId": "C1001", "equipmentId": "12345", "productId": "DEDICATED_SERVER", "reference": "web-01",
+					"contractTerm": "1 YEAR", "billingCycle": "1 MONTH", "contractTermEndDate": "2023-04-30T00:00:00+00:00",
+					"pricePerFrequency": 99.5, "currency": "EUR", "cancellable": true, "uncancellable": false},
+				{"id": "1002", "contractId": "C1002", "productId": "DEDICATED_SERVER", "contractTerm": "12 MONTHS", "billingCycle": "1 MONTH",
+					"contractTermEndDate": "2023-03-31T00:00:00+00:00", "pricePerFrequency": 49, "currency": "EUR", "cancellable": true},
+				{"id": "1003", "contractId": "C1003", "productId": "IP_ADDRESSES", "contractTerm": "0 MONTHS", "billingCycle": "3 MONTHS",
+					"contractTermEndDate": "2022-11-30T00:00:00+00:00", "pricePerFrequency": 12, "currency": "EUR", "cancellable": true},
+				{"id": "1004", "contractId": "C1004", "productId": "DEDICATED_SERVER", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2023-04-01T00:00:00+00:00", "endDate": "2023-04-01T00:00:00+00:00", "cancellable": false, "uncancellable": true},
+				{"id": "1005", "contractId": "C1005", "productId": "SUPPORT", "contractTerm": "1 MONTH"},
+				{"id": "1006", "contractId": "C1006", "productId": "COLOCATION", "reference": "rack, row 3", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2024-01-31T00:00:00+00:00", "pricePerFrequency": 500, "currency": "EUR", "cancellable": true}
+			]}`)
+		},
+	}))
+	defer teardown()
+
+	plan, err := PlanRenewals(RenewalPlanOptions{
+		NoticePeriod: 14 * 24 * time.Hour,
+		Horizon:      60 * 24 * time.Hour,
+		Now:          testClock,
+	})
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(plan.Renewals), 2)
+	assert.Equal(plan.Renewals[0].Service.Id, "1002")
+	assert.Equal(plan.Renewals[0].CancelBy.Format(RENEWAL_DATE_LAYOUT), "2023-03-17")
+	assert.False(plan.Renewals[0].PastNotice)
+	assert.Equal(plan.Renewals[1].Service.Id, "1001")
+}
+
+func TestRenewalPlanWriteCsv(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /services/v1/services": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+			fmt.Fprint(w, `{"metadata": {"limit": 50, "offset": 0, "totalCount": 6}, "services": [
+				{"id": "1001", "contractId": "C1001", "equipmentId": "12345", "productId": "DEDICATED_SERVER", "reference": "web-01",
+					"contractTerm": "1 YEAR", "billingCycle": "1 MONTH", "contractTermEndDate": "2023-04-30T00:00:00+00:00",
+					"pricePerFrequency": 99.5, "currency": "EUR", "cancellable": true, "uncancellable": false},
+				{"id": "1002", "contractId": "C1002", "productId": "DEDICATED_SERVER", "contractTerm": "12 MONTHS", "billingCycle": "1 MONTH",
+					"contractTermEndDate": "2023-03-31T00:00:00+00:00", "pricePerFrequency": 49, "currency": "EUR", "cancellable": true},
+				{"id": "1003", "contractId": "C1003", "productId": "IP_ADDRESSES", "contractTerm": "0 MONTHS", "billingCycle": "3 MONTHS",
+					"contractTermEndDate": "2022-11-30T00:00:00+00:00", "pricePerFrequency": 12, "currency": "EUR", "cancellable": true},
+				{"id": "1004", "contractId": "C1004", "productId": "DEDICATED_SERVER", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2023-04-01T00:00:00+00:00", "endDate": "2023-04-01T00:00:00+00:00", "cancellable": false, "uncancellable": true},
+				{"id": "1005", "contractId": "C1005", "productId": "SUPPORT", "contractTerm": "1 MONTH"},
+				{"id": "1006", "contractId": "C1006", "productId": "COLOCATION", "reference": "rack, row 3", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2024-01-31T00:00:00+00:00", "pricePerFrequency": 500, "currency": "EUR", "cancellable": true}
+			]}`)
+		},
+	}))
+	defer teardown()
+
+	plan, err := PlanRenewals(RenewalPlanOptions{Now: testClock})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	var out bytes.Buffer
+	assert.Nil(plan.WriteCsv(&out))
+	rows, err := csv.NewReader(&out).ReadAll()
+	assert.Nil(err)
+	assert.Equal(len(rows), 5)
+	assert.Equal(rows[0], RENEWAL_CSV_HEADER)
+	assert.Equal(rows[1], []string{"1002", "C1002", "", "DEDICATED_SERVER", "", "12 MONTHS", "1 MONTH", "2023-03-31", "2023-03-31", "2023-03-01", "-9", "true", "49.00", "EUR"})
+	assert.Equal(rows[4][4], "rack, row 3")
+}
+
+func TestRenewalPlanWriteIcs(t *testing.T) {
+	setup(serveRoutes(t, map[string]http.HandlerFunc{
+		"GET /services/v1/services": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "50", r.URL.Query().Get("limit"))
+			fmt.Fprint(w, `{"metadata": {"limit": 50, "offset": 0, "totalCount": 6}, "services": [
+				{"id": "1001", "contractId": "C1001", "equipmentId": "12345", "productId": "DEDICATED_SERVER", "reference": "web-01",
+					"contractTerm": "1 YEAR", "billingCycle": "1 MONTH", "contractTermEndDate": "2023-04-30T00:00:00+00:00",
+					"pricePerFrequency": 99.5, "currency": "EUR", "cancellable": true, "uncancellable": false},
+				{"id": "1002", "contractId": "C1002", "productId": "DEDICATED_SERVER", "contractTerm": "12 MONTHS", "billingCycle": "1 MONTH",
+					"contractTermEndDate": "2023-03-31T00:00:00+00:00", "pricePerFrequency": 49, "currency": "EUR", "cancellable": true},
+				{"id": "1003", "contractId": "C1003", "productId": "IP_ADDRESSES", "contractTerm": "0 MONTHS", "billingCycle": "3 MONTHS",
+					"contractTermEndDate": "2022-11-30T00:00:00+00:00", "pricePerFrequency": 12, "currency": "EUR", "cancellable": true},
+				{"id": "1004", "contractId": "C1004", "productId": "DEDICATED_SERVER", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2023-04-01T00:00:00+00:00", "endDate": "2023-04-01T00:00:00+00:00", "cancellable": false, "uncancellable": true},
+				{"id": "1005", "contractId": "C1005", "productId": "SUPPORT", "contractTerm": "1 MONTH"},
+				{"id": "1006", "contractId": "C1006", "productId": "COLOCATION", "reference": "rack, row 3", "contractTerm": "1 YEAR",
+					"contractTermEndDate": "2024-01-31T00:00:00+00:00", "pricePerFrequency": 500, "currency": "EUR", "cancellable": true}
+			]}`)
+		},
+	}))
+	defer teardown()
+
+	plan, err := PlanRenewals(RenewalPlanOptions{Now: testClock})
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	var out bytes.Buffer
+	assert.Nil(plan.WriteIcs(&out))
+	ics := out.String()
+	assert.True(strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(strings.HasSuffix(ics, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Equal(strings.Count(ics, "BEGIN:VEVENT\r\n"), 4)
+	assert.Contains(ics, "UID:1002-20230331@leaseweb-go-sdk\r\n")
+	assert.Contains(ics, "DTSTAMP:20230310T120000Z\r\n")
+	assert.Contains(ics, "DTSTART;VALUE=DATE:20230301\r\nDTEND;VALUE=DATE:20230302\r\n")
+	assert.Contains(ics, "SUMMARY:Notice period passed for service 1002\r\n")
+	assert.Contains(ics, "SUMMARY:Last day to cancel service 1001 (web-01)\r\n")
+	assert.Contains(ics, "SUMMARY:Last day to cancel service 1006 (rack\\, row 3)\r\n")
+
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(len(line), 75)
+	}
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	assert.Contains(unfolded, "DESCRIPTION:Service 1001 renews on 2023-04-30 for 1 YEAR.\\nContract: C1001\\nEquipment: 12345\\nProduct: DEDICATED_SERVER\\nPrice: 99.50 EUR\r\n")
+}
+
+func TestPlanRenewalsServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be","errorCode":"SERVER_ERROR","errorMessage":"The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return PlanRenewals(RenewalPlanOptions{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
@@ -1,12 +1,17 @@
 package leaseweb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
-const SERVICES_API_VERSION = "v1"
+const (
+	SERVICES_API_VERSION = "v1"
+	SERVICES_PAGE_LIMIT  = 50
+)
 
 type ServicesApi struct {
 }
@@ -17,26 +22,34 @@ type Services struct {
 }
 
 type Service struct {
-	// TODO;
-	// "attributes": {}
-	BillingCycle        string  `json:"billingCycle"`
-	Cancellable         bool    `json:"cancellable"`
-	ContractId          string  `json:"contractId"`
-	ContractTerm        string  `json:"contractTerm"`
-	ContractTermEndDate string  `json:"contractTermEndDate"`
-	Currency            string  `json:"currency"`
-	DeliveryDate        string  `json:"deliveryDate"`
-	DeliveryEstimate    string  `json:"deliveryEstimate"`
-	EndDate             string  `json:"endDate"`
-	EquipmentId         string  `json:"equipmentId"`
-	Id                  string  `json:"id"`
-	OrderDate           string  `json:"orderDate"`
-	PricePerFrequency   float32 `json:"pricePerFrequency"`
-	ProductId           string  `json:"productId"`
-	Reference           string  `json:"reference"`
-	StartDate           string  `json:"startDate"`
-	Status              string  `json:"status"`
-	Uncancellable       bool    `json:"uncancellable"`
+	Attributes          ServiceAttributes `json:"attributes"`
+	BillingCycle        string            `json:"billingCycle"`
+	Cancellable         bool              `json:"cancellable"`
+	ContractId          string            `json:"contractId"`
+	ContractTerm        string            `json:"contractTerm"`
+	ContractTermEndDate Time              `json:"contractTermEndDate"`
+	Currency            string            `json:"currency"`
+	DeliveryDate        Time              `json:"deliveryDate"`
+	DeliveryEstimate    string            `json:"deliveryEstimate"`
+	EndDate             Time              `json:"endDate"`
+	EquipmentId         string            `json:"equipmentId"`
+	Id                  string            `json:"id"`
+	OrderDate           Time              `json:"orderDate"`
+	PricePerFrequency   Money             `json:"pricePerFrequency"`
+	ProductId           string            `json:"productId"`
+	Reference           string            `json:"reference"`
+	StartDate           Time              `json:"startDate"`
+	Status              string            `json:"status"`
+	Uncancellable       bool              `json:"uncancellable"`
+}
+
+type ServiceFilter struct {
+	ProductId    string
+	Status       string
+	EquipmentId  string
+	Reference    string
+	ContractTerm string
+	BillingCycle string
 }
 
 type CancellationReasons struct {
@@ -48,12 +61,62 @@ type CancellationReason struct {
 	ReasonCode string `json:"reasonCode"`
 }
 
+func (s *Service) UnmarshalJSON(b []byte) error {
+	type service Service
+	if err := json.Unmarshal(b, (*service)(s)); err != nil {
+		return err
+	}
+	s.PricePerFrequency = s.PricePerFrequency.WithCurrency(s.Currency)
+	return nil
+}
+
 func (sa ServicesApi) getPath(endpoint string) string {
 	return "/services/" + SERVICES_API_VERSION + endpoint
 }
 
 func (sa ServicesApi) ListServices(args ...int) (*Services, error) {
-	v := url.Values{}
+	return sa.listServicesPage(ServiceFilter{}, args...)
+}
+
+// ListServicesWithFilter passes the filters the API supports along with the
+// request. When the filter also selects on fields the API cannot filter on,
+// all matching services are fetched and paginated locally so that the
+// returned metadata describes the filtered result.
+func (sa ServicesApi) ListServicesWithFilter(filter ServiceFilter, args ...int) (*Services, error) {
+	if filter.apiFilter() == filter {
+		return sa.listServicesPage(filter, args...)
+	}
+
+	services, err := sa.ListAllServices(filter)
+	if err != nil {
+		return nil, err
+	}
+	metadata := Metadata{Limit: SERVICES_PAGE_LIMIT, TotalCount: len(services)}
+	if len(args) >= 1 {
+		metadata.Offset = args[0]
+	}
+	if len(args) >= 2 {
+		metadata.Limit = args[1]
+	}
+	start, end := metadata.Offset, metadata.Offset+metadata.Limit
+	if start < 0 || start > len(services) {
+		start = len(services)
+	}
+	if end < start {
+		end = start
+	}
+	if end > len(services) {
+		end = len(services)
+	}
+	return &Services{Services: services[start:end], Metadata: metadata}, nil
+}
+
+func (sa ServicesApi) ListAllServices(filter ServiceFilter) ([]Service, error) {
+	return listAllServices(sa, filter)
+}
+
+func (sa ServicesApi) listServicesPage(filter ServiceFilter, args ...int) (*Services, error) {
+	v := filter.query()
 	if len(args) >= 1 {
 		v.Add("offset", fmt.Sprint(args[0]))
 	}
@@ -100,3 +163,59 @@ func (sa ServicesApi) UncancelService(id string) error {
 	path := sa.getPath("/services/" + id + "/uncancel")
 	return doRequest(http.MethodPost, path)
 }
+
+func listAllServices(service ServicesService, filter ServiceFilter) ([]Service, error) {
+	services, err := listAllPages(SERVICES_PAGE_LIMIT, func(offset, limit int) ([]Service, Metadata, error) {
+		services, err := service.ListServicesWithFilter(filter.apiFilter(), offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return services.Services, services.Metadata, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filter.Filter(services), nil
+}
+
+func (sf ServiceFilter) Matches(service Service) bool {
+	return matchServiceField(sf.ProductId, service.ProductId) &&
+		matchServiceField(sf.Status, service.Status) &&
+		matchServiceField(sf.EquipmentId, service.EquipmentId) &&
+		matchServiceField(sf.Reference, service.Reference) &&
+		matchServiceField(sf.ContractTerm, service.ContractTerm) &&
+		matchServiceField(sf.BillingCycle, service.BillingCycle)
+}
+
+func (sf ServiceFilter) Filter(services []Service) []Service {
+	result := []Service{}
+	for _, service := range services {
+		if sf.Matches(service) {
+			result = append(result, service)
+		}
+	}
+	return result
+}
+
+// apiFilter returns the part of the filter the API can apply itself.
+func (sf ServiceFilter) apiFilter() ServiceFilter {
+	return ServiceFilter{ProductId: sf.ProductId, EquipmentId: sf.EquipmentId, Reference: sf.Reference}
+}
+
+func (sf ServiceFilter) query() url.Values {
+	v := url.Values{}
+	if sf.ProductId != "" {
+		v.Add("productId", sf.ProductId)
+	}
+	if sf.EquipmentId != "" {
+		v.Add("equipmentId", sf.EquipmentId)
+	}
+	if sf.Reference != "" {
+		v.Add("reference", sf.Reference)
+	}
+	return v
+}
+
+func matchServiceField(filter string, value string) bool {
+	return filter == "" || strings.EqualFold(strings.Join(strings.Fields(filter), " "), strings.Join(strings.Fields(value), " "))
+}
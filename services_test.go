@@ -75,18 +75,18 @@ func TestListServices(t *testing.T) {
 	assert.Equal(service1.Cancellable, false)
 	assert.Equal(service1.ContractId, "00000110")
 	assert.Equal(service1.ContractTerm, "1 YEAR")
-	assert.Equal(service1.ContractTermEndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(service1.ContractTermEndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(service1.Currency, "EUR")
-	assert.Equal(service1.DeliveryDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service1.DeliveryDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service1.DeliveryEstimate, "5 - 7 business days")
-	assert.Equal(service1.EndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(service1.EndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(service1.EquipmentId, "12345678")
 	assert.Equal(service1.Id, "10000000000010")
-	assert.Equal(service1.OrderDate, "2019-01-01T00:00:00+00:00")
-	assert.Equal(service1.PricePerFrequency, float32(396.01))
+	assert.Equal(service1.OrderDate, MustTime("2019-01-01T00:00:00+00:00"))
+	assert.Equal(service1.PricePerFrequency, MustMoney("396.01", "EUR"))
 	assert.Equal(service1.ProductId, "DEDICATED_SERVER")
 	assert.Equal(service1.Reference, "this is a reference")
-	assert.Equal(service1.StartDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service1.StartDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service1.Status, "ACTIVE")
 	assert.Equal(service1.Uncancellable, true)
 
@@ -95,15 +95,15 @@ func TestListServices(t *testing.T) {
 	assert.Equal(service2.Cancellable, true)
 	assert.Equal(service2.ContractId, "00000110")
 	assert.Equal(service2.ContractTerm, "1 YEAR")
-	assert.Equal(service2.ContractTermEndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(service2.ContractTermEndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(service2.Currency, "EUR")
-	assert.Equal(service2.DeliveryDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service2.DeliveryDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service2.DeliveryEstimate, "5 - 7 business days")
 	assert.Equal(service2.Id, "10000000000011")
-	assert.Equal(service2.OrderDate, "2019-01-01T00:00:00+00:00")
-	assert.Equal(service2.PricePerFrequency, float32(139.99))
+	assert.Equal(service2.OrderDate, MustTime("2019-01-01T00:00:00+00:00"))
+	assert.Equal(service2.PricePerFrequency, MustMoney("139.99", "EUR"))
 	assert.Equal(service2.ProductId, "DOMAIN")
-	assert.Equal(service2.StartDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service2.StartDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service2.Status, "ACTIVE")
 	assert.Equal(service2.Uncancellable, false)
 }
@@ -158,18 +158,18 @@ func TestListServicesPaginate(t *testing.T) {
 	assert.Equal(service1.Cancellable, false)
 	assert.Equal(service1.ContractId, "00000110")
 	assert.Equal(service1.ContractTerm, "1 YEAR")
-	assert.Equal(service1.ContractTermEndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(service1.ContractTermEndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(service1.Currency, "EUR")
-	assert.Equal(service1.DeliveryDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service1.DeliveryDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service1.DeliveryEstimate, "5 - 7 business days")
-	assert.Equal(service1.EndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(service1.EndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(service1.EquipmentId, "12345678")
 	assert.Equal(service1.Id, "10000000000010")
-	assert.Equal(service1.OrderDate, "2019-01-01T00:00:00+00:00")
-	assert.Equal(service1.PricePerFrequency, float32(396.01))
+	assert.Equal(service1.OrderDate, MustTime("2019-01-01T00:00:00+00:00"))
+	assert.Equal(service1.PricePerFrequency, MustMoney("396.01", "EUR"))
 	assert.Equal(service1.ProductId, "DEDICATED_SERVER")
 	assert.Equal(service1.Reference, "this is a reference")
-	assert.Equal(service1.StartDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(service1.StartDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(service1.Status, "ACTIVE")
 	assert.Equal(service1.Uncancellable, true)
 }
@@ -248,6 +248,182 @@ func TestListServicesServerErrors(t *testing.T) {
 	assertServerErrorTests(t, serverErrorTests)
 }
 
+func TestListServicesWithFilter(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "DEDICATED_SERVER", r.URL.Query().Get("productId"))
+		assert.Equal(t, "", r.URL.Query().Get("status"))
+		assert.Equal(t, "", r.URL.Query().Get("billingCycle"))
+		assert.Equal(t, "0", r.URL.Query().Get("offset"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		fmt.Fprintf(w, `{
+			"metadata": {"limit": 50, "offset": 0, "totalCount": 3},
+			"services": [
+				{"id": "1001", "productId": "DEDICATED_SERVER", "status": "ACTIVE", "billingCycle": "1 MONTH", "attributes": {"hostname": "web-01"}},
+				{"id": "1002", "productId": "DEDICATED_SERVER", "status": "ACTIVE", "billingCycle": "1 YEAR"},
+				{"id": "1003", "productId": "DEDICATED_SERVER", "status": "CANCELLED", "billingCycle": "1 MONTH"}
+			]
+		}`)
+	})
+	defer teardown()
+
+	filter := ServiceFilter{ProductId: "DEDICATED_SERVER", Status: "active", BillingCycle: "1  month"}
+	response, err := ServicesApi{}.ListServicesWithFilter(filter, 0, 10)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata, Metadata{Limit: 10, Offset: 0, TotalCount: 1})
+	assert.Equal(len(response.Services), 1)
+	assert.Equal(response.Services[0].Id, "1001")
+	assert.Equal(response.Services[0].Attributes.String("hostname"), "web-01")
+
+	response, err = ServicesApi{}.ListServicesWithFilter(filter, 1, 10)
+	assert.Nil(err)
+	assert.Equal(response.Metadata, Metadata{Limit: 10, Offset: 1, TotalCount: 1})
+	assert.Empty(response.Services)
+}
+
+func TestListServicesWithApiFilter(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "DEDICATED_SERVER", r.URL.Query().Get("productId"))
+		assert.Equal(t, "12345", r.URL.Query().Get("equipmentId"))
+		assert.Equal(t, "20", r.URL.Query().Get("offset"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		fmt.Fprintf(w, `{
+			"metadata": {"limit": 10, "offset": 20, "totalCount": 21},
+			"services": [{"id": "1001", "productId": "DEDICATED_SERVER", "equipmentId": "12345"}]
+		}`)
+	})
+	defer teardown()
+
+	response, err := ServicesApi{}.ListServicesWithFilter(ServiceFilter{ProductId: "DEDICATED_SERVER", EquipmentId: "12345"}, 20, 10)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(response.Metadata, Metadata{Limit: 10, Offset: 20, TotalCount: 21})
+	assert.Equal(len(response.Services), 1)
+	assert.Equal(response.Services[0].Id, "1001")
+}
+
+func TestListAllServices(t *testing.T) {
+	setup(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+		assert.Equal(t, "web-01", r.URL.Query().Get("reference"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprintf(w, `{"metadata": {"limit": 50, "offset": 0, "totalCount": 3}, "services": [
+				{"id": "1001", "reference": "web-01", "contractTerm": "1 YEAR"},
+				{"id": "1002", "reference": "web-01", "contractTerm": "1 MONTH"}
+			]}`)
+		case "2":
+			fmt.Fprintf(w, `{"metadata": {"limit": 50, "offset": 2, "totalCount": 3}, "services": [
+				{"id": "1003", "reference": "web-01", "contractTerm": "1 YEAR"}
+			]}`)
+		default:
+			t.Errorf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	})
+	defer teardown()
+
+	services, err := ServicesApi{}.ListAllServices(ServiceFilter{Reference: "web-01", ContractTerm: "1 YEAR"})
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(len(services), 2)
+	assert.Equal(services[0].Id, "1001")
+	assert.Equal(services[1].Id, "1003")
+}
+
+func TestServiceFilterMatches(t *testing.T) {
+	service := Service{ProductId: "DEDICATED_SERVER", Status: "ACTIVE", EquipmentId: "12345", Reference: "web-01", ContractTerm: "1 YEAR", BillingCycle: "1 MONTH"}
+
+	assert := assert.New(t)
+	assert.True(ServiceFilter{}.Matches(service))
+	assert.True(ServiceFilter{ProductId: "dedicated_server", EquipmentId: "12345", ContractTerm: "1 year"}.Matches(service))
+	assert.False(ServiceFilter{EquipmentId: "1234"}.Matches(service))
+	assert.False(ServiceFilter{Status: "ACTIVE", Reference: "web-02"}.Matches(service))
+	assert.Equal(ServiceFilter{Status: "CANCELLED"}.Filter([]Service{service}), []Service{})
+}
+
+func TestListAllServicesServerErrors(t *testing.T) {
+	serverErrorTests := []serverErrorTest{
+		{
+			Title: "error 401",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "401", "errorMessage": "You are not authorized to view this resource."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ServicesApi{}.ListAllServices(ServiceFilter{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "401",
+				ErrorMessage:  "You are not authorized to view this resource.",
+			},
+		},
+		{
+			Title: "error 403",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "ACCESS_DENIED", "errorMessage": "The access token is expired or invalid."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ServicesApi{}.ListAllServices(ServiceFilter{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "ACCESS_DENIED",
+				ErrorMessage:  "The access token is expired or invalid.",
+			},
+		},
+		{
+			Title: "error 500",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "SERVER_ERROR", "errorMessage": "The server encountered an unexpected condition that prevented it from fulfilling the request."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ServicesApi{}.ListAllServices(ServiceFilter{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "SERVER_ERROR",
+				ErrorMessage:  "The server encountered an unexpected condition that prevented it from fulfilling the request.",
+			},
+		},
+		{
+			Title: "error 503",
+			MockServer: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, testApiKey, r.Header.Get("x-lsw-auth"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprintf(w, `{"correlationId":"289346a1-3eaf-4da4-b707-62ef12eb08be", "errorCode": "TEMPORARILY_UNAVAILABLE", "errorMessage": "The server is currently unable to handle the request due to a temporary overloading or maintenance of the server."}`)
+			},
+			FunctionCall: func() (interface{}, error) {
+				return ServicesApi{}.ListAllServices(ServiceFilter{})
+			},
+			ExpectedError: LeasewebError{
+				CorrelationId: "289346a1-3eaf-4da4-b707-62ef12eb08be",
+				ErrorCode:     "TEMPORARILY_UNAVAILABLE",
+				ErrorMessage:  "The server is currently unable to handle the request due to a temporary overloading or maintenance of the server.",
+			},
+		},
+	}
+	assertServerErrorTests(t, serverErrorTests)
+}
+
 func TestListCancellationReasons(t *testing.T) {
 	setup(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
@@ -396,18 +572,18 @@ func TestGetService(t *testing.T) {
 	assert.Equal(Service.Cancellable, false)
 	assert.Equal(Service.ContractId, "00000110")
 	assert.Equal(Service.ContractTerm, "1 YEAR")
-	assert.Equal(Service.ContractTermEndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(Service.ContractTermEndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(Service.Currency, "EUR")
-	assert.Equal(Service.DeliveryDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(Service.DeliveryDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(Service.DeliveryEstimate, "5 - 7 business days")
-	assert.Equal(Service.EndDate, "2020-01-31T00:00:00+00:00")
+	assert.Equal(Service.EndDate, MustTime("2020-01-31T00:00:00+00:00"))
 	assert.Equal(Service.EquipmentId, "12345678")
 	assert.Equal(Service.Id, "10000000000010")
-	assert.Equal(Service.OrderDate, "2019-01-01T00:00:00+00:00")
-	assert.Equal(Service.PricePerFrequency, float32(396.01))
+	assert.Equal(Service.OrderDate, MustTime("2019-01-01T00:00:00+00:00"))
+	assert.Equal(Service.PricePerFrequency, MustMoney("396.01", "EUR"))
 	assert.Equal(Service.ProductId, "DEDICATED_SERVER")
 	assert.Equal(Service.Reference, "this is a reference")
-	assert.Equal(Service.StartDate, "2019-01-01T00:00:00+00:00")
+	assert.Equal(Service.StartDate, MustTime("2019-01-01T00:00:00+00:00"))
 	assert.Equal(Service.Status, "ACTIVE")
 	assert.Equal(Service.Uncancellable, true)
 }
@@ -7,8 +7,8 @@ type Metadata struct {
 }
 
 type TimestampValuePair struct {
-	Timestamp string `json:"timestamp"`
-	Value     int    `json:"value"`
+	Timestamp Time `json:"timestamp"`
+	Value     int  `json:"value"`
 }
 
 type BasicMetric struct {
@@ -0,0 +1,80 @@
+package leaseweb
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var TIME_LAYOUTS = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999-0700",
+	"2006-01-02T15:04:05.999999999-07",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+type Time struct {
+	time.Time
+}
+
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+func ParseTime(value string) (Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Time{}, nil
+	}
+	for _, layout := range TIME_LAYOUTS {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("invalid time %q", value)
+}
+
+func (t Time) DateString() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	if string(bytes.TrimSpace(b)) == "null" {
+		*t = Time{}
+		return nil
+	}
+	parsed, err := ParseTime(string(bytes.Trim(bytes.TrimSpace(b), `"`)))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+func MustTime(value string) Time {
+	t, err := ParseTime(value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,73 @@
+package leaseweb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	assert := assert.New(t)
+	expected := time.Date(2023, 3, 11, 7, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{
+		"2023-03-11T08:00:00+0100",
+		"2023-03-11T08:00:00+01:00",
+		"2023-03-11T07:00:00Z",
+		"2023-03-11T07:00:00.000Z",
+		"2023-03-11 07:00:00",
+	} {
+		parsed, err := ParseTime(value)
+		assert.Nil(err, value)
+		assert.True(parsed.Equal(expected), value)
+	}
+
+	parsed, err := ParseTime("2023-03-11")
+	assert.Nil(err)
+	assert.True(parsed.Equal(time.Date(2023, 3, 11, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(parsed.DateString(), "2023-03-11")
+
+	parsed, err = ParseTime("")
+	assert.Nil(err)
+	assert.True(parsed.IsZero())
+	assert.Equal(parsed.String(), "")
+	assert.Equal(parsed.DateString(), "")
+
+	_, err = ParseTime("tomorrow")
+	assert.Equal(err.Error(), `invalid time "tomorrow"`)
+}
+
+func TestTimeCompare(t *testing.T) {
+	deadline := MustTime("2023-03-11T08:00:00+0100")
+	now := time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	assert := assert.New(t)
+	assert.True(deadline.After(now))
+	assert.Equal(deadline.Sub(now), 19*time.Hour)
+	assert.True(NewTime(now).Before(deadline.Time))
+	assert.Equal(deadline.String(), "2023-03-11T08:00:00+01:00")
+}
+
+func TestTimeJson(t *testing.T) {
+	var value struct {
+		CreatedAt Time `json:"createdAt"`
+		NulledAt  Time `json:"nulledAt"`
+		EndsAt    Time `json:"endsAt"`
+	}
+	err := json.Unmarshal([]byte(`{"createdAt": "2017-10-01T01:00:00+0100", "nulledAt": null, "endsAt": ""}`), &value)
+
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(value.CreatedAt, MustTime("2017-10-01T01:00:00+0100"))
+	assert.True(value.NulledAt.IsZero())
+	assert.True(value.EndsAt.IsZero())
+
+	b, err := json.Marshal(value)
+	assert.Nil(err)
+	assert.Equal(string(b), `{"createdAt":"2017-10-01T01:00:00+01:00","nulledAt":null,"endsAt":null}`)
+
+	err = json.Unmarshal([]byte(`{"createdAt": "yesterday"}`), &value)
+	assert.Equal(err.Error(), `invalid time "yesterday"`)
+}
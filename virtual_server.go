@@ -1,6 +1,7 @@
 package leaseweb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -33,13 +34,13 @@ type VirtualServer struct {
 }
 
 type VirtualServerContract struct {
-	Id                string  `json:"id"`
-	StartsAt          string  `json:"startsAt"`
-	EndsAt            string  `json:"endsAt"`
-	BillingCycle      int     `json:"billingCycle"`
-	BillingFrequency  string  `json:"billingFrequency"`
-	Currency          string  `json:"currency"`
-	PricePerFrequency float32 `json:"pricePerFrequency"`
+	Id                string `json:"id"`
+	StartsAt          Time   `json:"startsAt"`
+	EndsAt            Time   `json:"endsAt"`
+	BillingCycle      int    `json:"billingCycle"`
+	BillingFrequency  string `json:"billingFrequency"`
+	Currency          string `json:"currency"`
+	PricePerFrequency Money  `json:"pricePerFrequency"`
 }
 
 type VirtualServerIp struct {
@@ -74,7 +75,7 @@ type VirtualServerResult struct {
 	Id        string `json:"id"`
 	Name      string `json:"name"`
 	Status    string `json:"status"`
-	CreatedAt string `json:"createdAt"`
+	CreatedAt Time   `json:"createdAt"`
 }
 
 type Templates struct {
@@ -87,6 +88,15 @@ type Template struct {
 	Name string `json:"name"`
 }
 
+func (vsc *VirtualServerContract) UnmarshalJSON(b []byte) error {
+	type contract VirtualServerContract
+	if err := json.Unmarshal(b, (*contract)(vsc)); err != nil {
+		return err
+	}
+	vsc.PricePerFrequency = vsc.PricePerFrequency.WithCurrency(vsc.Currency)
+	return nil
+}
+
 func (vsa VirtualServerApi) getPath(endpoint string) string {
 	return "/cloud/" + VIRTUAL_SERVER_API_VERSION + endpoint
 }
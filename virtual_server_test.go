@@ -134,10 +134,10 @@ func TestListVirtualServers(t *testing.T) {
 	assert.Equal(virtualServer1.Contract.BillingCycle, 12)
 	assert.Equal(virtualServer1.Contract.BillingFrequency, "MONTH")
 	assert.Equal(virtualServer1.Contract.Currency, "EUR")
-	assert.Equal(virtualServer1.Contract.EndsAt, "2017-01-31T00:00:00+0200")
+	assert.Equal(virtualServer1.Contract.EndsAt, MustTime("2017-01-31T00:00:00+0200"))
 	assert.Equal(virtualServer1.Contract.Id, "30000778")
-	assert.Equal(virtualServer1.Contract.PricePerFrequency, float32(4.7))
-	assert.Equal(virtualServer1.Contract.StartsAt, "2016-02-01T00:00:00+0200")
+	assert.Equal(virtualServer1.Contract.PricePerFrequency, MustMoney("4.7", "EUR"))
+	assert.Equal(virtualServer1.Contract.StartsAt, MustTime("2016-02-01T00:00:00+0200"))
 	assert.Equal(virtualServer1.Hardware.Cpu.Cores, 1)
 	assert.Equal(virtualServer1.Hardware.Memory.Amount, 1024)
 	assert.Equal(virtualServer1.Hardware.Memory.Unit, "MB")
@@ -169,10 +169,10 @@ func TestListVirtualServers(t *testing.T) {
 	assert.Equal(virtualServer2.Contract.BillingCycle, 12)
 	assert.Equal(virtualServer2.Contract.BillingFrequency, "MONTH")
 	assert.Equal(virtualServer2.Contract.Currency, "EUR")
-	assert.Equal(virtualServer2.Contract.EndsAt, "2017-01-31T00:00:00+0200")
+	assert.Equal(virtualServer2.Contract.EndsAt, MustTime("2017-01-31T00:00:00+0200"))
 	assert.Equal(virtualServer2.Contract.Id, "30000779")
-	assert.Equal(virtualServer2.Contract.PricePerFrequency, float32(4.7))
-	assert.Equal(virtualServer2.Contract.StartsAt, "2016-02-01T00:00:00+0200")
+	assert.Equal(virtualServer2.Contract.PricePerFrequency, MustMoney("4.7", "EUR"))
+	assert.Equal(virtualServer2.Contract.StartsAt, MustTime("2016-02-01T00:00:00+0200"))
 	assert.Equal(virtualServer2.Hardware.Cpu.Cores, 2)
 	assert.Equal(virtualServer2.Hardware.Memory.Amount, 2048)
 	assert.Equal(virtualServer2.Hardware.Memory.Unit, "MB")
@@ -260,10 +260,10 @@ func TestListVirtualServersPaginate(t *testing.T) {
 	assert.Equal(virtualServer1.Contract.BillingCycle, 12)
 	assert.Equal(virtualServer1.Contract.BillingFrequency, "MONTH")
 	assert.Equal(virtualServer1.Contract.Currency, "EUR")
-	assert.Equal(virtualServer1.Contract.EndsAt, "2017-01-31T00:00:00+0200")
+	assert.Equal(virtualServer1.Contract.EndsAt, MustTime("2017-01-31T00:00:00+0200"))
 	assert.Equal(virtualServer1.Contract.Id, "30000778")
-	assert.Equal(virtualServer1.Contract.PricePerFrequency, float32(4.7))
-	assert.Equal(virtualServer1.Contract.StartsAt, "2016-02-01T00:00:00+0200")
+	assert.Equal(virtualServer1.Contract.PricePerFrequency, MustMoney("4.7", "EUR"))
+	assert.Equal(virtualServer1.Contract.StartsAt, MustTime("2016-02-01T00:00:00+0200"))
 	assert.Equal(virtualServer1.Hardware.Cpu.Cores, 1)
 	assert.Equal(virtualServer1.Hardware.Memory.Amount, 1024)
 	assert.Equal(virtualServer1.Hardware.Memory.Unit, "MB")
@@ -415,10 +415,10 @@ func TestGetVirtualServer(t *testing.T) {
 	assert.Equal(virtualServer.Contract.BillingCycle, 12)
 	assert.Equal(virtualServer.Contract.BillingFrequency, "MONTH")
 	assert.Equal(virtualServer.Contract.Currency, "EUR")
-	assert.Equal(virtualServer.Contract.EndsAt, "2017-01-31T00:00:00+0200")
+	assert.Equal(virtualServer.Contract.EndsAt, MustTime("2017-01-31T00:00:00+0200"))
 	assert.Equal(virtualServer.Contract.Id, "30000778")
-	assert.Equal(virtualServer.Contract.PricePerFrequency, float32(4.7))
-	assert.Equal(virtualServer.Contract.StartsAt, "2016-02-01T00:00:00+0200")
+	assert.Equal(virtualServer.Contract.PricePerFrequency, MustMoney("4.7", "EUR"))
+	assert.Equal(virtualServer.Contract.StartsAt, MustTime("2016-02-01T00:00:00+0200"))
 	assert.Equal(virtualServer.Hardware.Cpu.Cores, 1)
 	assert.Equal(virtualServer.Hardware.Memory.Amount, 1024)
 	assert.Equal(virtualServer.Hardware.Memory.Unit, "MB")
@@ -587,10 +587,10 @@ func TestUpdateVirtualServer(t *testing.T) {
 	assert.Equal(virtualServer.Contract.BillingCycle, 12)
 	assert.Equal(virtualServer.Contract.BillingFrequency, "MONTH")
 	assert.Equal(virtualServer.Contract.Currency, "EUR")
-	assert.Equal(virtualServer.Contract.EndsAt, "2017-01-31T00:00:00+0200")
+	assert.Equal(virtualServer.Contract.EndsAt, MustTime("2017-01-31T00:00:00+0200"))
 	assert.Equal(virtualServer.Contract.Id, "30000778")
-	assert.Equal(virtualServer.Contract.PricePerFrequency, float32(4.7))
-	assert.Equal(virtualServer.Contract.StartsAt, "2016-02-01T00:00:00+0200")
+	assert.Equal(virtualServer.Contract.PricePerFrequency, MustMoney("4.7", "EUR"))
+	assert.Equal(virtualServer.Contract.StartsAt, MustTime("2016-02-01T00:00:00+0200"))
 	assert.Equal(virtualServer.Hardware.Cpu.Cores, 1)
 	assert.Equal(virtualServer.Hardware.Memory.Amount, 1024)
 	assert.Equal(virtualServer.Hardware.Memory.Unit, "MB")
@@ -714,7 +714,7 @@ func TestPowerOff(t *testing.T) {
 	assert.Equal(resp.Id, "cs01.237daad0-2aed-4260-b0e4-488d9cd55607")
 	assert.Equal(resp.Name, "virtualServers.powerOff")
 	assert.Equal(resp.Status, "PENDING")
-	assert.Equal(resp.CreatedAt, "2016-12-31T01:00:59+00:00")
+	assert.Equal(resp.CreatedAt, MustTime("2016-12-31T01:00:59+00:00"))
 }
 
 func TestPowerOffServerErrors(t *testing.T) {
@@ -828,7 +828,7 @@ func TestPowerOn(t *testing.T) {
 	assert.Equal(resp.Id, "cs01.237daad0-2aed-4260-b0e4-488d9cd55607")
 	assert.Equal(resp.Name, "virtualServers.powerOn")
 	assert.Equal(resp.Status, "PENDING")
-	assert.Equal(resp.CreatedAt, "2016-12-31T01:00:59+00:00")
+	assert.Equal(resp.CreatedAt, MustTime("2016-12-31T01:00:59+00:00"))
 }
 
 func TestPowerOnServerErrors(t *testing.T) {
@@ -942,7 +942,7 @@ func TestReboot(t *testing.T) {
 	assert.Equal(resp.Id, "cs01.237daad0-2aed-4260-b0e4-488d9cd55607")
 	assert.Equal(resp.Name, "virtualServers.reboot")
 	assert.Equal(resp.Status, "PENDING")
-	assert.Equal(resp.CreatedAt, "2016-12-31T01:00:59+00:00")
+	assert.Equal(resp.CreatedAt, MustTime("2016-12-31T01:00:59+00:00"))
 }
 
 func TestRebootServerErrors(t *testing.T) {
@@ -1056,7 +1056,7 @@ func TestReinstall(t *testing.T) {
 	assert.Equal(resp.Id, "cs01.237daad0-2aed-4260-b0e4-488d9cd55607")
 	assert.Equal(resp.Name, "virtualServers.reinstall")
 	assert.Equal(resp.Status, "PENDING")
-	assert.Equal(resp.CreatedAt, "2016-12-31T01:00:59+00:00")
+	assert.Equal(resp.CreatedAt, MustTime("2016-12-31T01:00:59+00:00"))
 }
 
 func TestReinstallServerErrors(t *testing.T) {
@@ -1548,15 +1548,15 @@ func TestVirtualServerGetDataTrafficMetrics(t *testing.T) {
 	assert.Equal(Metric.Metadata.Granularity, "DAY")
 	assert.Equal(Metric.Metric.DataTrafficDown.Unit, "B")
 	assert.Equal(Metric.Metric.DataTrafficDown.Values[0].Value, 900)
-	assert.Equal(Metric.Metric.DataTrafficDown.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.DataTrafficDown.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.DataTrafficDown.Values[1].Value, 2500)
-	assert.Equal(Metric.Metric.DataTrafficDown.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.DataTrafficDown.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 
 	assert.Equal(Metric.Metric.DataTrafficUp.Unit, "B")
 	assert.Equal(Metric.Metric.DataTrafficUp.Values[0].Value, 90)
-	assert.Equal(Metric.Metric.DataTrafficUp.Values[0].Timestamp, "2016-10-20T09:00:00Z")
+	assert.Equal(Metric.Metric.DataTrafficUp.Values[0].Timestamp, MustTime("2016-10-20T09:00:00Z"))
 	assert.Equal(Metric.Metric.DataTrafficUp.Values[1].Value, 250)
-	assert.Equal(Metric.Metric.DataTrafficUp.Values[1].Timestamp, "2016-10-20T10:00:00Z")
+	assert.Equal(Metric.Metric.DataTrafficUp.Values[1].Timestamp, MustTime("2016-10-20T10:00:00Z"))
 }
 
 func TestVirtualServerGetDataTrafficMetricsServerErrors(t *testing.T) {
@@ -0,0 +1,382 @@
+package leaseweb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const ZONE_PAGE_LIMIT = 50
+
+type Zone struct {
+	Origin             string
+	Ttl                int
+	ResourceRecordSets []ResourceRecordSet
+}
+
+type ZoneParseError struct {
+	Line   int
+	Reason string
+}
+
+func (e *ZoneParseError) Error() string {
+	return fmt.Sprintf("zone line %d: %s", e.Line, e.Reason)
+}
+
+func ParseZone(r io.Reader, origin string) (*Zone, error) {
+	zone := &Zone{Origin: qualifyName(origin, "")}
+	hasZoneTtl := false
+	index := map[string]int{}
+	ttlLines := map[string]int{}
+	owner := ""
+
+	lines, err := readZoneLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		fail := func(format string, args ...interface{}) error {
+			return &ZoneParseError{Line: line.number, Reason: fmt.Sprintf(format, args...)}
+		}
+		tokens := line.tokens
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fail("$ORIGIN expects one domain name")
+			}
+			zone.Origin = qualifyName(tokens[1], zone.Origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fail("$TTL expects one value")
+			}
+			ttl, ok := parseZoneTtl(tokens[1])
+			if !ok {
+				return nil, fail("invalid TTL %q", tokens[1])
+			}
+			zone.Ttl = ttl
+			hasZoneTtl = true
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fail("%s is not supported", tokens[0])
+		}
+
+		if !line.continued {
+			owner = tokens[0]
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fail("record without owner name")
+		}
+		if zone.Origin == "" && owner == "@" {
+			return nil, fail("@ used without $ORIGIN")
+		}
+
+		ttl, hasTtl := zone.Ttl, hasZoneTtl
+		for len(tokens) > 0 {
+			if value, ok := parseZoneTtl(tokens[0]); ok {
+				ttl, hasTtl = value, true
+			} else if isZoneClass(tokens[0]) {
+				if strings.ToUpper(tokens[0]) != "IN" {
+					return nil, fail("class %s is not supported", tokens[0])
+				}
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, fail("record needs a type and data")
+		}
+		if !hasTtl {
+			return nil, fail("no TTL given and no $TTL set")
+		}
+
+		recordType := ResourceRecordSetType(strings.ToUpper(tokens[0]))
+		content := qualifyRecordContent(recordType, tokens[1:], zone.Origin)
+		name := qualifyName(owner, zone.Origin)
+
+		key := resourceRecordSetKey(name, recordType)
+		if i, ok := index[key]; ok {
+			if zone.ResourceRecordSets[i].Ttl != ttl {
+				return nil, fail("TTL %d of %s %s differs from TTL %d on line %d", ttl, name, recordType, zone.ResourceRecordSets[i].Ttl, ttlLines[key])
+			}
+			zone.ResourceRecordSets[i].Content = append(zone.ResourceRecordSets[i].Content, content)
+			continue
+		}
+		index[key] = len(zone.ResourceRecordSets)
+		ttlLines[key] = line.number
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets, ResourceRecordSet{
+			Name:    name,
+			Type:    recordType,
+			Content: []string{content},
+			Ttl:     ttl,
+		})
+	}
+	return zone, nil
+}
+
+func (z *Zone) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	if z.Origin != "" {
+		fmt.Fprintf(&b, "$ORIGIN %s\n", z.Origin)
+	}
+	if z.Ttl > 0 {
+		fmt.Fprintf(&b, "$TTL %d\n", z.Ttl)
+	}
+
+	for _, resourceRecordSet := range sortedResourceRecordSets(z.ResourceRecordSets) {
+		name := relativeName(resourceRecordSet.Name, z.Origin)
+		for _, content := range resourceRecordSet.Content {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", name, resourceRecordSet.Ttl, resourceRecordSet.Type, content)
+		}
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func (z *Zone) String() string {
+	var b strings.Builder
+	z.WriteTo(&b)
+	return b.String()
+}
+
+func FetchZone(service HostingService, domainName string) (*Zone, error) {
+	resourceRecordSets, err := listAllResourceRecordSets(service, domainName)
+	if err != nil {
+		return nil, err
+	}
+	return &Zone{Origin: qualifyName(domainName, ""), ResourceRecordSets: resourceRecordSets}, nil
+}
+
+type zoneLine struct {
+	number    int
+	continued bool
+	tokens    []string
+}
+
+func readZoneLines(r io.Reader) ([]zoneLine, error) {
+	var lines []zoneLine
+	var current *zoneLine
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := scanner.Text()
+		tokens, delta, err := tokenizeZoneLine(text)
+		if err != nil {
+			return nil, &ZoneParseError{Line: number, Reason: err.Error()}
+		}
+
+		if current == nil {
+			if len(tokens) == 0 {
+				continue
+			}
+			current = &zoneLine{
+				number:    number,
+				continued: text[0] == ' ' || text[0] == '\t',
+			}
+		}
+		current.tokens = append(current.tokens, tokens...)
+		depth += delta
+		if depth < 0 {
+			return nil, &ZoneParseError{Line: number, Reason: "unbalanced parentheses"}
+		}
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return nil, &ZoneParseError{Line: current.number, Reason: "unbalanced parentheses"}
+	}
+	return lines, nil
+}
+
+func tokenizeZoneLine(text string) ([]string, int, error) {
+	var tokens []string
+	var token strings.Builder
+	inQuotes := false
+	depth := 0
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case inQuotes:
+			token.WriteByte(c)
+			if c == '\\' && i+1 < len(text) {
+				i++
+				token.WriteByte(text[i])
+			} else if c == '"' {
+				inQuotes = false
+			}
+		case c == '"':
+			inQuotes = true
+			token.WriteByte(c)
+		case c == ';':
+			i = len(text)
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			depth--
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			token.WriteByte(c)
+		}
+	}
+	if inQuotes {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+	return tokens, depth, nil
+}
+
+func parseZoneTtl(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, ttl >= 0
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		unit, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, false
+		}
+		n, _ := strconv.Atoi(number)
+		ttl += n * unit
+		number = ""
+	}
+	if number != "" {
+		return 0, false
+	}
+	return ttl, true
+}
+
+func isZoneClass(value string) bool {
+	switch strings.ToUpper(value) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func qualifyRecordContent(recordType ResourceRecordSetType, tokens []string, origin string) string {
+	names := []int{}
+	switch recordType {
+	case RESOURCE_RECORD_TYPE_CNAME, RESOURCE_RECORD_TYPE_NS:
+		names = []int{0}
+	case RESOURCE_RECORD_TYPE_MX:
+		names = []int{1}
+	case RESOURCE_RECORD_TYPE_SRV:
+		names = []int{3}
+	case RESOURCE_RECORD_TYPE_SOA:
+		names = []int{0, 1}
+	}
+
+	qualified := append([]string{}, tokens...)
+	for _, i := range names {
+		if i < len(qualified) && qualified[i] != "." {
+			qualified[i] = qualifyName(qualified[i], origin)
+		}
+	}
+	return strings.Join(qualified, " ")
+}
+
+func qualifyName(name, origin string) string {
+	if name == "" {
+		return ""
+	}
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	if origin == "" {
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+func relativeName(name, origin string) string {
+	if origin == "" {
+		return name
+	}
+	if strings.EqualFold(name, origin) {
+		return "@"
+	}
+	if suffix := "." + origin; len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+	return name
+}
+
+func resourceRecordSetKey(name string, recordType ResourceRecordSetType) string {
+	return strings.ToLower(qualifyName(name, "")) + " " + string(recordType)
+}
+
+func sortedResourceRecordSets(resourceRecordSets []ResourceRecordSet) []ResourceRecordSet {
+	sorted := append([]ResourceRecordSet{}, resourceRecordSets...)
+	rank := func(recordType ResourceRecordSetType) int {
+		switch recordType {
+		case RESOURCE_RECORD_TYPE_SOA:
+			return 0
+		case RESOURCE_RECORD_TYPE_NS:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if rank(a.Type) != rank(b.Type) {
+			return rank(a.Type) < rank(b.Type)
+		}
+		if an, bn := strings.ToLower(a.Name), strings.ToLower(b.Name); an != bn {
+			return an < bn
+		}
+		return a.Type < b.Type
+	})
+	return sorted
+}
+
+func listAllResourceRecordSets(service HostingService, domainName string) ([]ResourceRecordSet, error) {
+	return listAllPages(ZONE_PAGE_LIMIT, func(offset, limit int) ([]ResourceRecordSet, Metadata, error) {
+		page, err := service.ListResourceRecordSets(domainName, offset, limit)
+		if err != nil {
+			return nil, Metadata{}, err
+		}
+		return page.ResourceRecordSets, page.Metadata, nil
+	})
+}
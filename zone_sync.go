@@ -0,0 +1,215 @@
+package leaseweb
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+type SyncZoneOptions struct {
+	Service               HostingService
+	AllowProtectedDeletes bool
+	DryRun                bool
+	Output                io.Writer
+}
+
+type ZonePlan struct {
+	Domain           string
+	Creates          []ResourceRecordSet
+	Updates          []ResourceRecordSetUpdate
+	Deletes          []ResourceRecordSet
+	Protected        []ResourceRecordSet
+	ProtectedUpdates []ResourceRecordSetUpdate
+	NotEditable      []ResourceRecordSet
+}
+
+type ResourceRecordSetUpdate struct {
+	Current ResourceRecordSet
+	Desired ResourceRecordSet
+}
+
+func SyncZone(domainName string, desired *Zone, options SyncZoneOptions) (*ZonePlan, error) {
+	if options.Service == nil {
+		options.Service = HostingApi{}
+	}
+
+	plan, err := PlanZoneSync(options.Service, domainName, desired, options.AllowProtectedDeletes)
+	if err != nil {
+		return nil, err
+	}
+	if options.Output != nil {
+		if _, err := io.WriteString(options.Output, plan.String()); err != nil {
+			return nil, err
+		}
+	}
+	if options.DryRun {
+		return plan, nil
+	}
+	return plan, plan.Apply(options.Service)
+}
+
+func PlanZoneSync(service HostingService, domainName string, desired *Zone, allowProtectedDeletes bool) (*ZonePlan, error) {
+	current, err := listAllResourceRecordSets(service, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ZonePlan{Domain: domainName}
+	currentSets := map[string]ResourceRecordSet{}
+	for _, resourceRecordSet := range current {
+		currentSets[resourceRecordSetKey(resourceRecordSet.Name, resourceRecordSet.Type)] = resourceRecordSet
+	}
+
+	desiredKeys := map[string]bool{}
+	for _, resourceRecordSet := range desired.ResourceRecordSets {
+		resourceRecordSet.Name = qualifyName(resourceRecordSet.Name, desired.Origin)
+		key := resourceRecordSetKey(resourceRecordSet.Name, resourceRecordSet.Type)
+		if desiredKeys[key] {
+			return nil, fmt.Errorf("%s %s is defined more than once", resourceRecordSet.Name, resourceRecordSet.Type)
+		}
+		desiredKeys[key] = true
+		if err := resourceRecordSet.Validate(); err != nil {
+			return nil, err
+		}
+
+		currentSet, ok := currentSets[key]
+		if !ok {
+			plan.Creates = append(plan.Creates, resourceRecordSet)
+			continue
+		}
+		if equalResourceRecordSets(currentSet, resourceRecordSet) {
+			continue
+		}
+		resourceRecordSet.Name = currentSet.Name
+		update := ResourceRecordSetUpdate{Current: currentSet, Desired: resourceRecordSet}
+		switch {
+		case !currentSet.Editable:
+			plan.NotEditable = append(plan.NotEditable, currentSet)
+		case isProtectedResourceRecordSet(currentSet) && !allowProtectedDeletes && len(update.RemovedContent()) > 0:
+			plan.ProtectedUpdates = append(plan.ProtectedUpdates, update)
+		default:
+			plan.Updates = append(plan.Updates, update)
+		}
+	}
+
+	for _, resourceRecordSet := range current {
+		if desiredKeys[resourceRecordSetKey(resourceRecordSet.Name, resourceRecordSet.Type)] {
+			continue
+		}
+		if !resourceRecordSet.Editable {
+			plan.NotEditable = append(plan.NotEditable, resourceRecordSet)
+			continue
+		}
+		if isProtectedResourceRecordSet(resourceRecordSet) && !allowProtectedDeletes {
+			plan.Protected = append(plan.Protected, resourceRecordSet)
+			continue
+		}
+		plan.Deletes = append(plan.Deletes, resourceRecordSet)
+	}
+
+	plan.Creates = sortedResourceRecordSets(plan.Creates)
+	plan.Deletes = sortedResourceRecordSets(plan.Deletes)
+	sort.SliceStable(plan.Updates, func(i, j int) bool {
+		return resourceRecordSetKey(plan.Updates[i].Desired.Name, plan.Updates[i].Desired.Type) < resourceRecordSetKey(plan.Updates[j].Desired.Name, plan.Updates[j].Desired.Type)
+	})
+	return plan, nil
+}
+
+func (p *ZonePlan) Empty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.Deletes) == 0
+}
+
+func (p *ZonePlan) Apply(service HostingService) error {
+	for _, resourceRecordSet := range p.Deletes {
+		if err := service.DeleteResourceRecordSet(p.Domain, resourceRecordSet.Name, resourceRecordSet.Type); err != nil {
+			return fmt.Errorf("delete %s %s: %w", resourceRecordSet.Name, resourceRecordSet.Type, err)
+		}
+	}
+	for _, update := range p.Updates {
+		if _, err := service.UpdateResourceRecordSet(p.Domain, update.Desired); err != nil {
+			return fmt.Errorf("update %s %s: %w", update.Desired.Name, update.Desired.Type, err)
+		}
+	}
+	for _, resourceRecordSet := range p.Creates {
+		if _, err := service.CreateResourceRecordSet(p.Domain, resourceRecordSet); err != nil {
+			return fmt.Errorf("create %s %s: %w", resourceRecordSet.Name, resourceRecordSet.Type, err)
+		}
+	}
+	return nil
+}
+
+func (p *ZonePlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Zone %s: %d to create, %d to update, %d to delete\n", p.Domain, len(p.Creates), len(p.Updates), len(p.Deletes))
+	for _, resourceRecordSet := range p.Creates {
+		fmt.Fprintf(&b, "+ %s %s ttl=%d %s\n", resourceRecordSet.Name, resourceRecordSet.Type, resourceRecordSet.Ttl, strings.Join(resourceRecordSet.Content, ", "))
+	}
+	for _, update := range p.Updates {
+		fmt.Fprintf(&b, "~ %s %s", update.Desired.Name, update.Desired.Type)
+		if update.Current.Ttl != update.Desired.Ttl {
+			fmt.Fprintf(&b, " ttl=%d->%d", update.Current.Ttl, update.Desired.Ttl)
+		}
+		fmt.Fprintf(&b, " %s -> %s\n", strings.Join(update.Current.Content, ", "), strings.Join(update.Desired.Content, ", "))
+	}
+	for _, resourceRecordSet := range p.Deletes {
+		fmt.Fprintf(&b, "- %s %s ttl=%d %s\n", resourceRecordSet.Name, resourceRecordSet.Type, resourceRecordSet.Ttl, strings.Join(resourceRecordSet.Content, ", "))
+	}
+	for _, resourceRecordSet := range p.Protected {
+		fmt.Fprintf(&b, "! %s %s kept, deleting %s records is not allowed\n", resourceRecordSet.Name, resourceRecordSet.Type, resourceRecordSet.Type)
+	}
+	for _, update := range p.ProtectedUpdates {
+		fmt.Fprintf(&b, "! %s %s kept, removing %s values is not allowed: %s\n", update.Current.Name, update.Current.Type, update.Current.Type, strings.Join(update.RemovedContent(), ", "))
+	}
+	for _, resourceRecordSet := range p.NotEditable {
+		fmt.Fprintf(&b, "! %s %s kept, the record set is not editable\n", resourceRecordSet.Name, resourceRecordSet.Type)
+	}
+	return b.String()
+}
+
+func (u ResourceRecordSetUpdate) RemovedContent() []string {
+	desired := map[string]bool{}
+	for _, content := range u.Desired.Content {
+		desired[normalizeRecordContent(u.Desired.Type, content)] = true
+	}
+	var removed []string
+	for _, content := range u.Current.Content {
+		if !desired[normalizeRecordContent(u.Current.Type, content)] {
+			removed = append(removed, content)
+		}
+	}
+	return removed
+}
+
+func isProtectedResourceRecordSet(resourceRecordSet ResourceRecordSet) bool {
+	return resourceRecordSet.Type == RESOURCE_RECORD_TYPE_SOA || resourceRecordSet.Type == RESOURCE_RECORD_TYPE_NS
+}
+
+func equalResourceRecordSets(a, b ResourceRecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Content) != len(b.Content) {
+		return false
+	}
+	normalize := func(resourceRecordSet ResourceRecordSet) []string {
+		contents := []string{}
+		for _, content := range resourceRecordSet.Content {
+			contents = append(contents, normalizeRecordContent(resourceRecordSet.Type, content))
+		}
+		sort.Strings(contents)
+		return contents
+	}
+	as, bs := normalize(a), normalize(b)
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeRecordContent(recordType ResourceRecordSetType, content string) string {
+	switch recordType {
+	case RESOURCE_RECORD_TYPE_TXT, RESOURCE_RECORD_TYPE_CAA:
+		return content
+	}
+	return strings.ToLower(strings.Join(strings.Fields(content), " "))
+}
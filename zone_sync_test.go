@@ -0,0 +1,209 @@
+package leaseweb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type zoneHostingService struct {
+	HostingService
+	resourceRecordSets []ResourceRecordSet
+	calls              []string
+}
+
+func (s *zoneHostingService) ListResourceRecordSets(domainName string, args ...interface{}) (*ResourceRecordSets, error) {
+	offset, limit := args[0].(int), args[1].(int)
+	end := offset + limit
+	if end > len(s.resourceRecordSets) {
+		end = len(s.resourceRecordSets)
+	}
+	return &ResourceRecordSets{
+		ResourceRecordSets: s.resourceRecordSets[offset:end],
+		Metadata:           Metadata{Offset: offset, Limit: limit, TotalCount: len(s.resourceRecordSets)},
+	}, nil
+}
+
+func (s *zoneHostingService) CreateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error) {
+	s.calls = append(s.calls, "create "+resourceRecordSet.Name+" "+string(resourceRecordSet.Type))
+	return &resourceRecordSet, nil
+}
+
+func (s *zoneHostingService) UpdateResourceRecordSet(domainName string, resourceRecordSet ResourceRecordSet) (*ResourceRecordSet, error) {
+	s.calls = append(s.calls, "update "+resourceRecordSet.Name+" "+string(resourceRecordSet.Type))
+	return &resourceRecordSet, nil
+}
+
+func (s *zoneHostingService) DeleteResourceRecordSet(domainName, name string, recordType ResourceRecordSetType) error {
+	s.calls = append(s.calls, "delete "+name+" "+string(recordType))
+	return nil
+}
+
+func newZoneHostingService() *zoneHostingService {
+	return &zoneHostingService{resourceRecordSets: []ResourceRecordSet{
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_SOA, Content: []string{"ns.leaseweb.nl. postmaster.leaseweb.nl. 2019010101 10800 3600 604800 3600"}, Ttl: 86400, Editable: true},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_NS, Content: []string{"ns1.leaseweb.nl.", "ns2.leaseweb.nl."}, Ttl: 86400, Editable: true},
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.2", "192.0.2.1"}, Ttl: 300, Editable: true},
+		{Name: "mail.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.25"}, Ttl: 3600, Editable: true},
+		{Name: "old.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600, Editable: true},
+	}}
+}
+
+const desiredZoneFile = `$ORIGIN example.com.
+$TTL 3600
+www  300 IN A     192.0.2.1
+www  300 IN A     192.0.2.2
+mail 300 IN A     192.0.2.25
+api      IN CNAME www
+`
+
+func TestPlanZoneSync(t *testing.T) {
+	desired, err := ParseZone(strings.NewReader(desiredZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	service := newZoneHostingService()
+	plan, err := PlanZoneSync(service, "example.com", desired, false)
+	assert.Nil(err)
+	assert.False(plan.Empty())
+	assert.Equal(plan.Creates, []ResourceRecordSet{
+		{Name: "api.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600},
+	})
+	assert.Equal(plan.Updates, []ResourceRecordSetUpdate{{
+		Current: ResourceRecordSet{Name: "mail.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.25"}, Ttl: 3600, Editable: true},
+		Desired: ResourceRecordSet{Name: "mail.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.25"}, Ttl: 300},
+	}})
+	assert.Equal(plan.Deletes, []ResourceRecordSet{
+		{Name: "old.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600, Editable: true},
+	})
+	assert.Equal(len(plan.Protected), 2)
+	assert.Equal(plan.String(), "Zone example.com: 1 to create, 1 to update, 1 to delete\n"+
+		"+ api.example.com. CNAME ttl=3600 www.example.com.\n"+
+		"~ mail.example.com. A ttl=3600->300 192.0.2.25 -> 192.0.2.25\n"+
+		"- old.example.com. CNAME ttl=3600 www.example.com.\n"+
+		"! example.com. SOA kept, deleting SOA records is not allowed\n"+
+		"! example.com. NS kept, deleting NS records is not allowed\n")
+	assert.Empty(service.calls)
+}
+
+func TestPlanZoneSyncAllowProtectedDeletes(t *testing.T) {
+	desired, err := ParseZone(strings.NewReader(desiredZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	plan, err := PlanZoneSync(newZoneHostingService(), "example.com", desired, true)
+	assert.Nil(err)
+	assert.Empty(plan.Protected)
+	assert.Equal(len(plan.Deletes), 3)
+	assert.Equal(plan.Deletes[0].Type, RESOURCE_RECORD_TYPE_SOA)
+	assert.Equal(plan.Deletes[1].Type, RESOURCE_RECORD_TYPE_NS)
+}
+
+func TestPlanZoneSyncInvalidDesired(t *testing.T) {
+	desired := &Zone{Origin: "example.com.", ResourceRecordSets: []ResourceRecordSet{
+		{Name: "www", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1"}, Ttl: 300},
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.2"}, Ttl: 300},
+	}}
+	_, err := PlanZoneSync(newZoneHostingService(), "example.com", desired, false)
+	assert.Equal(t, err.Error(), "www.example.com. A is defined more than once")
+
+	desired.ResourceRecordSets = desired.ResourceRecordSets[:1]
+	desired.ResourceRecordSets[0].Ttl = 120
+	_, err = PlanZoneSync(newZoneHostingService(), "example.com", desired, false)
+	assert.Contains(t, err.Error(), "ttl 120 is not one of")
+
+	desired, err = ParseZone(strings.NewReader("$ORIGIN example.com.\nwww 0 IN A 192.0.2.1\n"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, desired.ResourceRecordSets[0].Ttl, 0)
+	_, err = PlanZoneSync(newZoneHostingService(), "example.com", desired, false)
+	assert.Contains(t, err.Error(), "ttl 0 is below the minimum of 60")
+}
+
+func TestPlanZoneSyncProtectedUpdates(t *testing.T) {
+	service := newZoneHostingService()
+	current, err := FetchZone(service, "example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	current.ResourceRecordSets[1].Content = []string{"ns1.leaseweb.nl.", "ns3.leaseweb.nl."}
+
+	plan, err := PlanZoneSync(service, "example.com", current, false)
+	assert.Nil(err)
+	assert.True(plan.Empty())
+	assert.Equal(len(plan.ProtectedUpdates), 1)
+	assert.Equal(plan.ProtectedUpdates[0].RemovedContent(), []string{"ns2.leaseweb.nl."})
+	assert.Equal(plan.String(), "Zone example.com: 0 to create, 0 to update, 0 to delete\n"+
+		"! example.com. NS kept, removing NS values is not allowed: ns2.leaseweb.nl.\n")
+
+	current.ResourceRecordSets[1].Content = []string{"ns1.leaseweb.nl.", "ns2.leaseweb.nl.", "ns3.leaseweb.nl."}
+	plan, err = PlanZoneSync(service, "example.com", current, false)
+	assert.Nil(err)
+	assert.Equal(len(plan.Updates), 1)
+	assert.Empty(plan.ProtectedUpdates)
+
+	current.ResourceRecordSets[1].Content = []string{"ns3.leaseweb.nl."}
+	plan, err = PlanZoneSync(service, "example.com", current, true)
+	assert.Nil(err)
+	assert.Equal(len(plan.Updates), 1)
+	assert.Empty(plan.ProtectedUpdates)
+}
+
+func TestPlanZoneSyncNotEditable(t *testing.T) {
+	desired, err := ParseZone(strings.NewReader(desiredZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	service := newZoneHostingService()
+	service.resourceRecordSets[3].Editable = false
+	service.resourceRecordSets[4].Editable = false
+	plan, err := PlanZoneSync(service, "example.com", desired, true)
+	assert.Nil(err)
+	assert.Empty(plan.Updates)
+	assert.Equal(len(plan.Deletes), 2)
+	assert.Equal(len(plan.NotEditable), 2)
+	assert.Contains(plan.String(), "! mail.example.com. A kept, the record set is not editable\n"+
+		"! old.example.com. CNAME kept, the record set is not editable\n")
+}
+
+func TestSyncZone(t *testing.T) {
+	desired, err := ParseZone(strings.NewReader(desiredZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	service := newZoneHostingService()
+	var output strings.Builder
+	plan, err := SyncZone("example.com", desired, SyncZoneOptions{Service: service, Output: &output})
+	assert.Nil(err)
+	assert.Equal(output.String(), plan.String())
+	assert.Equal(service.calls, []string{
+		"delete old.example.com. CNAME",
+		"update mail.example.com. A",
+		"create api.example.com. CNAME",
+	})
+}
+
+func TestSyncZoneDryRun(t *testing.T) {
+	desired, err := ParseZone(strings.NewReader(desiredZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	service := newZoneHostingService()
+	plan, err := SyncZone("example.com", desired, SyncZoneOptions{Service: service, DryRun: true})
+	assert.Nil(err)
+	assert.Equal(len(plan.Creates), 1)
+	assert.Empty(service.calls)
+}
+
+func TestSyncZoneNoChanges(t *testing.T) {
+	service := newZoneHostingService()
+	current, err := FetchZone(service, "example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(current.Origin, "example.com.")
+	assert.Equal(len(current.ResourceRecordSets), 5)
+
+	plan, err := SyncZone("example.com", current, SyncZoneOptions{Service: service})
+	assert.Nil(err)
+	assert.True(plan.Empty())
+	assert.Empty(service.calls)
+}
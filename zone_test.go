@@ -0,0 +1,117 @@
+package leaseweb
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@       86400 IN SOA ns.leaseweb.nl. postmaster.leaseweb.nl. (
+                2019010101 ; serial
+                10800      ; refresh
+                3600       ; retry
+                604800     ; expire
+                3600 )     ; minimum
+        86400 IN NS  ns1.leaseweb.nl.
+        86400 IN NS  ns2.leaseweb.nl.
+@             IN MX  10 mail
+@             IN MX  20 backup.example.net.
+@             IN TXT "v=spf1 include:_spf.example.com; -all"
+www       300 IN A   192.0.2.1
+www       300    A   192.0.2.2
+www       IN  300 AAAA 2001:db8::1
+ftp           IN CNAME www
+_sip._tcp     IN SRV 10 60 5060 sip
+@             IN CAA 0 issue "letsencrypt.org"
+`
+
+func TestParseZone(t *testing.T) {
+	zone, err := ParseZone(strings.NewReader(testZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(zone.Origin, "example.com.")
+	assert.Equal(zone.Ttl, 3600)
+	assert.Equal(zone.ResourceRecordSets, []ResourceRecordSet{
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_SOA, Content: []string{"ns.leaseweb.nl. postmaster.leaseweb.nl. 2019010101 10800 3600 604800 3600"}, Ttl: 86400},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_NS, Content: []string{"ns1.leaseweb.nl.", "ns2.leaseweb.nl."}, Ttl: 86400},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_MX, Content: []string{"10 mail.example.com.", "20 backup.example.net."}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_TXT, Content: []string{"\"v=spf1 include:_spf.example.com; -all\""}, Ttl: 3600},
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1", "192.0.2.2"}, Ttl: 300},
+		{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_AAAA, Content: []string{"2001:db8::1"}, Ttl: 300},
+		{Name: "ftp.example.com.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600},
+		{Name: "_sip._tcp.example.com.", Type: RESOURCE_RECORD_TYPE_SRV, Content: []string{"10 60 5060 sip.example.com."}, Ttl: 3600},
+		{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_CAA, Content: []string{"0 issue \"letsencrypt.org\""}, Ttl: 3600},
+	})
+	for _, resourceRecordSet := range zone.ResourceRecordSets {
+		assert.Nil(resourceRecordSet.Validate())
+	}
+}
+
+func TestParseZoneOriginArgument(t *testing.T) {
+	zone, err := ParseZone(strings.NewReader("www 300 IN A 192.0.2.1\n"), "example.com")
+	assert := assert.New(t)
+	assert.Nil(err)
+	assert.Equal(zone.Origin, "example.com.")
+	assert.Equal(zone.ResourceRecordSets[0].Name, "www.example.com.")
+}
+
+func TestParseZoneErrors(t *testing.T) {
+	tests := []struct {
+		Zone   string
+		Line   int
+		Reason string
+	}{
+		{"$TTL 3600\n@ IN A 192.0.2.1\n", 2, "@ used without $ORIGIN"},
+		{"$ORIGIN example.com.\nwww IN A 192.0.2.1\n", 2, "no TTL given and no $TTL set"},
+		{"$ORIGIN example.com.\n$TTL 3600\nwww 300 IN A 192.0.2.1\nwww 60 IN A 192.0.2.2\n", 4, "TTL 60 of www.example.com. A differs from TTL 300 on line 3"},
+		{"$ORIGIN example.com.\n$TTL 3600\nwww CH A 192.0.2.1\n", 3, "class CH is not supported"},
+		{"$ORIGIN example.com.\n$TTL 3600\nwww IN TXT \"open\n", 3, "unterminated quoted string"},
+		{"$ORIGIN example.com.\n$TTL 3600\n@ IN SOA ns. host. ( 1 2 3 4 5\n", 3, "unbalanced parentheses"},
+		{"$INCLUDE other.zone\n", 1, "$INCLUDE is not supported"},
+		{"$ORIGIN example.com.\n$TTL 3600\nwww IN A\n", 3, "record needs a type and data"},
+	}
+	for _, test := range tests {
+		_, err := ParseZone(strings.NewReader(test.Zone), "")
+		parseErr := &ZoneParseError{}
+		if assert.True(t, errors.As(err, &parseErr), test.Reason) {
+			assert.Equal(t, test.Line, parseErr.Line)
+			assert.Equal(t, test.Reason, parseErr.Reason)
+		}
+	}
+}
+
+func TestZoneWriteTo(t *testing.T) {
+	zone := &Zone{
+		Origin: "example.com.",
+		Ttl:    3600,
+		ResourceRecordSets: []ResourceRecordSet{
+			{Name: "www.example.com.", Type: RESOURCE_RECORD_TYPE_A, Content: []string{"192.0.2.1", "192.0.2.2"}, Ttl: 300},
+			{Name: "example.com.", Type: RESOURCE_RECORD_TYPE_NS, Content: []string{"ns1.leaseweb.nl."}, Ttl: 86400},
+			{Name: "mail.example.net.", Type: RESOURCE_RECORD_TYPE_CNAME, Content: []string{"www.example.com."}, Ttl: 3600},
+		},
+	}
+
+	assert := assert.New(t)
+	assert.Equal(zone.String(), "$ORIGIN example.com.\n"+
+		"$TTL 3600\n"+
+		"@\t86400\tIN\tNS\tns1.leaseweb.nl.\n"+
+		"mail.example.net.\t3600\tIN\tCNAME\twww.example.com.\n"+
+		"www\t300\tIN\tA\t192.0.2.1\n"+
+		"www\t300\tIN\tA\t192.0.2.2\n")
+}
+
+func TestZoneRoundTrip(t *testing.T) {
+	zone, err := ParseZone(strings.NewReader(testZoneFile), "")
+	assert := assert.New(t)
+	assert.Nil(err)
+
+	parsed, err := ParseZone(strings.NewReader(zone.String()), "")
+	assert.Nil(err)
+	assert.Equal(parsed.Origin, zone.Origin)
+	assert.Equal(parsed.Ttl, zone.Ttl)
+	assert.ElementsMatch(parsed.ResourceRecordSets, zone.ResourceRecordSets)
+}